@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS auctions (
+  id VARCHAR(32) PRIMARY KEY,
+  vin VARCHAR(17) NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  recommended_price BIGINT NOT NULL,
+  start_price BIGINT NOT NULL,
+  increment BIGINT NOT NULL,
+  high_bid BIGINT NOT NULL DEFAULT 0,
+  leader VARCHAR(100) NOT NULL DEFAULT '',
+  bid_count INT NOT NULL DEFAULT 0,
+  winner VARCHAR(100) NOT NULL DEFAULT '',
+  starts_at TIMESTAMPTZ NOT NULL,
+  ends_at TIMESTAMPTZ NOT NULL,
+  closed_at TIMESTAMPTZ,
+  version BIGINT NOT NULL DEFAULT 1,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- A vehicle can have one open auction only
+CREATE UNIQUE INDEX IF NOT EXISTS auctions_open_vin_idx ON auctions (vin) WHERE status = 'open';
+
+-- Open auctions are closed in the order of their end time
+CREATE INDEX IF NOT EXISTS auctions_due_idx ON auctions (ends_at) WHERE status = 'open';
+
+CREATE TABLE IF NOT EXISTS bids (
+  id BIGSERIAL PRIMARY KEY,
+  auction_id VARCHAR(32) NOT NULL REFERENCES auctions (id),
+  bidder VARCHAR(100) NOT NULL,
+  amount BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS bids_auction_idx ON bids (auction_id, id);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS auctions_vin_idx;
@@ -0,0 +1,2 @@
+-- The latest auction of a vehicle is looked up for its event feed
+CREATE INDEX IF NOT EXISTS auctions_vin_idx ON auctions (vin, created_at DESC);
@@ -0,0 +1,3 @@
+ALTER TABLE bids DROP COLUMN IF EXISTS auto;
+
+ALTER TABLE auctions DROP COLUMN IF EXISTS leader_max;
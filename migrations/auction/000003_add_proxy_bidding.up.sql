@@ -0,0 +1,4 @@
+-- Hidden maximum of the leading bidder, bids placed on behalf of maximums are automatic
+ALTER TABLE auctions ADD COLUMN IF NOT EXISTS leader_max BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE bids ADD COLUMN IF NOT EXISTS auto BOOLEAN NOT NULL DEFAULT false;
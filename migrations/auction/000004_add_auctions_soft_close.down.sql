@@ -0,0 +1,5 @@
+ALTER TABLE auctions
+  DROP COLUMN IF EXISTS extensions,
+  DROP COLUMN IF EXISTS soft_close_max_extensions,
+  DROP COLUMN IF EXISTS soft_close_extension,
+  DROP COLUMN IF EXISTS soft_close_window;
@@ -0,0 +1,6 @@
+-- Anti-sniping policy of each auction and the extensions it got
+ALTER TABLE auctions
+  ADD COLUMN IF NOT EXISTS soft_close_window INTERVAL NOT NULL DEFAULT '0',
+  ADD COLUMN IF NOT EXISTS soft_close_extension INTERVAL NOT NULL DEFAULT '0',
+  ADD COLUMN IF NOT EXISTS soft_close_max_extensions INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS extensions INT NOT NULL DEFAULT 0;
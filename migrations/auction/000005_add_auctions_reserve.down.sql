@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS auctions_open_vin_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS auctions_open_vin_idx ON auctions (vin) WHERE status = 'open';
+
+ALTER TABLE auctions
+  DROP COLUMN IF EXISTS sale_price,
+  DROP COLUMN IF EXISTS counter_offer,
+  DROP COLUMN IF EXISTS reserve_met,
+  DROP COLUMN IF EXISTS reserve_price;
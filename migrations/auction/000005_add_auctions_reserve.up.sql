@@ -0,0 +1,12 @@
+-- Hidden reserve price of each auction and the if-sale negotiation of sales closing below it
+ALTER TABLE auctions
+  ADD COLUMN IF NOT EXISTS reserve_price BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS reserve_met BOOLEAN NOT NULL DEFAULT true,
+  ADD COLUMN IF NOT EXISTS counter_offer BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS sale_price BIGINT NOT NULL DEFAULT 0;
+
+UPDATE auctions SET sale_price = high_bid WHERE status = 'closed' AND winner <> '';
+
+-- A vehicle in if-sale negotiation cannot be auctioned again either
+DROP INDEX IF EXISTS auctions_open_vin_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS auctions_open_vin_idx ON auctions (vin) WHERE status <> 'closed';
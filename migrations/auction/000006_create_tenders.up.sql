@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS tenders (
+  id VARCHAR(32) PRIMARY KEY,
+  vin VARCHAR(17) NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  settlement VARCHAR(16) NOT NULL,
+  recommended_price BIGINT NOT NULL,
+  min_price BIGINT NOT NULL,
+  bid_count INT NOT NULL DEFAULT 0,
+  winner VARCHAR(100) NOT NULL DEFAULT '',
+  sale_price BIGINT NOT NULL DEFAULT 0,
+  starts_at TIMESTAMPTZ NOT NULL,
+  ends_at TIMESTAMPTZ NOT NULL,
+  closed_at TIMESTAMPTZ,
+  version BIGINT NOT NULL DEFAULT 1,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- A vehicle can have one open tender only
+CREATE UNIQUE INDEX IF NOT EXISTS tenders_open_vin_idx ON tenders (vin) WHERE status = 'open';
+
+-- Open tenders are closed in the order of their end time
+CREATE INDEX IF NOT EXISTS tenders_due_idx ON tenders (ends_at) WHERE status = 'open';
+
+CREATE TABLE IF NOT EXISTS tender_bids (
+  id BIGSERIAL PRIMARY KEY,
+  tender_id VARCHAR(32) NOT NULL REFERENCES tenders (id),
+  bidder VARCHAR(100) NOT NULL,
+  amount BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Every buyer submits one sealed bid per tender
+CREATE UNIQUE INDEX IF NOT EXISTS tender_bids_bidder_idx ON tender_bids (tender_id, bidder);
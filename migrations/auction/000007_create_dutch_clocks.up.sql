@@ -0,0 +1,24 @@
+-- Descending-price sales, the current price is derived from the start time and is not stored
+CREATE TABLE IF NOT EXISTS dutch_clocks (
+  id VARCHAR(32) PRIMARY KEY,
+  vin VARCHAR(17) NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  recommended_price BIGINT NOT NULL,
+  start_price BIGINT NOT NULL,
+  floor_price BIGINT NOT NULL,
+  step BIGINT NOT NULL,
+  step_seconds BIGINT NOT NULL,
+  buyer VARCHAR(100) NOT NULL DEFAULT '',
+  sale_price BIGINT NOT NULL DEFAULT 0,
+  starts_at TIMESTAMPTZ NOT NULL,
+  ends_at TIMESTAMPTZ NOT NULL,
+  closed_at TIMESTAMPTZ,
+  version BIGINT NOT NULL DEFAULT 1,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- A vehicle can have one running clock only
+CREATE UNIQUE INDEX IF NOT EXISTS dutch_clocks_running_vin_idx ON dutch_clocks (vin) WHERE status = 'running';
+
+-- Running clocks are expired in the order of their end time
+CREATE INDEX IF NOT EXISTS dutch_clocks_due_idx ON dutch_clocks (ends_at) WHERE status = 'running';
@@ -0,0 +1,18 @@
+-- A vehicle is on sale in one mode at a time, every open auction, tender or dutch clock claims its vehicle here
+-- and a sale that ends with a buyer keeps the claim so that the vehicle is not sold twice
+CREATE TABLE IF NOT EXISTS active_sales (
+  vin VARCHAR(17) PRIMARY KEY,
+  mode VARCHAR(16) NOT NULL,
+  sale_id VARCHAR(32) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Claim the vehicles of the sales already open or sold, the oldest sale of a vehicle wins
+INSERT INTO active_sales (vin, mode, sale_id, created_at)
+SELECT vin, mode, id, created_at FROM (
+  SELECT vin, 'auction' AS mode, id, created_at FROM auctions WHERE status <> 'closed' OR winner <> ''
+  UNION ALL SELECT vin, 'tender', id, created_at FROM tenders WHERE status = 'open' OR winner <> ''
+  UNION ALL SELECT vin, 'dutch', id, created_at FROM dutch_clocks WHERE status <> 'expired'
+) open_sales
+ORDER BY created_at
+ON CONFLICT (vin) DO NOTHING;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS auctions_if_sale_due_idx;
+
+ALTER TABLE auctions
+  DROP COLUMN IF EXISTS if_sale_ends_at;
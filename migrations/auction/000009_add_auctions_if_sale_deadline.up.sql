@@ -0,0 +1,8 @@
+-- Deadline of the if-sale negotiation, the counter offer is declined automatically once it passes
+ALTER TABLE auctions
+  ADD COLUMN IF NOT EXISTS if_sale_ends_at TIMESTAMPTZ;
+
+-- Negotiations already running get the full window from now on
+UPDATE auctions SET if_sale_ends_at = now() + INTERVAL '48 hours' WHERE status = 'if_sale';
+
+CREATE INDEX IF NOT EXISTS auctions_if_sale_due_idx ON auctions (if_sale_ends_at) WHERE status = 'if_sale';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS active_sales_unsettled_idx;
+ALTER TABLE active_sales DROP COLUMN IF EXISTS settled_at;
@@ -0,0 +1,6 @@
+-- A sale is settled once the vehicle service has recorded its outcome, the claims of sold vehicles are kept
+-- and marked settled; the claims left unsettled, including those of the sales sold before, are settled
+-- by the auction service in the background
+ALTER TABLE active_sales ADD COLUMN IF NOT EXISTS settled_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS active_sales_unsettled_idx ON active_sales (created_at) WHERE settled_at IS NULL;
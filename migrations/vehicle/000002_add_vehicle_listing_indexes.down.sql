@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS vehicles_exterior_color_idx;
+DROP INDEX IF EXISTS vehicles_brand_idx;
+DROP INDEX IF EXISTS vehicles_msrp_vin_idx;
+DROP INDEX IF EXISTS vehicles_price_vin_idx;
+DROP INDEX IF EXISTS vehicles_grade_vin_idx;
+DROP INDEX IF EXISTS vehicles_odometer_vin_idx;
+DROP INDEX IF EXISTS vehicles_year_vin_idx;
\ No newline at end of file
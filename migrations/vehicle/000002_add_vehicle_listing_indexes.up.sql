@@ -0,0 +1,7 @@
+CREATE INDEX IF NOT EXISTS vehicles_year_vin_idx ON vehicles (year, vin);
+CREATE INDEX IF NOT EXISTS vehicles_odometer_vin_idx ON vehicles (odometer, vin);
+CREATE INDEX IF NOT EXISTS vehicles_grade_vin_idx ON vehicles (grade, vin);
+CREATE INDEX IF NOT EXISTS vehicles_price_vin_idx ON vehicles (price, vin);
+CREATE INDEX IF NOT EXISTS vehicles_msrp_vin_idx ON vehicles (msrp, vin);
+CREATE INDEX IF NOT EXISTS vehicles_brand_idx ON vehicles (lower(brand), vin);
+CREATE INDEX IF NOT EXISTS vehicles_exterior_color_idx ON vehicles (lower(exterior_color));
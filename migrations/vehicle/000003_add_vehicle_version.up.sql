@@ -0,0 +1 @@
+ALTER TABLE vehicles ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS bulk_jobs (
+  id VARCHAR(32) PRIMARY KEY,
+  operation VARCHAR(16) NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  total INT NOT NULL,
+  processed INT NOT NULL DEFAULT 0,
+  succeeded INT NOT NULL DEFAULT 0,
+  failed INT NOT NULL DEFAULT 0,
+  vehicles JSONB NOT NULL,
+  results JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS bulk_jobs_status_idx ON bulk_jobs (status);
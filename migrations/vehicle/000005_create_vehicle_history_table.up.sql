@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS vehicle_history (
+  id BIGSERIAL PRIMARY KEY,
+  vin VARCHAR(17) NOT NULL,
+  action VARCHAR(16) NOT NULL,
+  actor VARCHAR(100) NOT NULL,
+  version BIGINT NOT NULL,
+  changes JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS vehicle_history_vin_idx ON vehicle_history (vin, id);
+
+CREATE OR REPLACE FUNCTION vehicle_history_immutable() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'vehicle history is immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER vehicle_history_immutable
+  BEFORE UPDATE OR DELETE ON vehicle_history
+  FOR EACH ROW EXECUTE FUNCTION vehicle_history_immutable();
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS vehicles_deleted_at_idx;
+ALTER TABLE vehicles DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,3 @@
+ALTER TABLE vehicles ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS vehicles_deleted_at_idx ON vehicles (deleted_at) WHERE deleted_at IS NOT NULL;
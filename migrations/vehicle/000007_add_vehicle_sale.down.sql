@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS vehicle_offers;
+DROP INDEX IF EXISTS vehicles_sale_status_idx;
+ALTER TABLE vehicles
+  DROP COLUMN IF EXISTS sale_status,
+  DROP COLUMN IF EXISTS buy_now_price,
+  DROP COLUMN IF EXISTS buyer,
+  DROP COLUMN IF EXISTS sale_price;
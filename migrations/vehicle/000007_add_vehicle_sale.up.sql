@@ -0,0 +1,21 @@
+ALTER TABLE vehicles
+  ADD COLUMN IF NOT EXISTS sale_status VARCHAR(16) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS buy_now_price BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS buyer VARCHAR(100) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS sale_price BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS vehicles_sale_status_idx ON vehicles (sale_status) WHERE sale_status <> '';
+
+CREATE TABLE IF NOT EXISTS vehicle_offers (
+  id BIGSERIAL PRIMARY KEY,
+  vin VARCHAR(17) NOT NULL REFERENCES vehicles (vin) ON DELETE CASCADE,
+  buyer VARCHAR(100) NOT NULL,
+  amount BIGINT NOT NULL,
+  counter BIGINT NOT NULL DEFAULT 0,
+  status VARCHAR(16) NOT NULL,
+  version BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS vehicle_offers_vin_idx ON vehicle_offers (vin, id);
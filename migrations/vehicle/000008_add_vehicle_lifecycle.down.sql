@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS vehicles_lifecycle_idx;
+ALTER TABLE vehicles
+  DROP CONSTRAINT IF EXISTS vehicles_lifecycle_check,
+  DROP COLUMN IF EXISTS lifecycle;
@@ -0,0 +1,9 @@
+-- Remarketing pipeline status of each vehicle, existing vehicles start as drafts
+ALTER TABLE vehicles
+  ADD COLUMN IF NOT EXISTS lifecycle VARCHAR(16) NOT NULL DEFAULT 'draft';
+
+ALTER TABLE vehicles
+  ADD CONSTRAINT vehicles_lifecycle_check
+  CHECK (lifecycle IN ('draft', 'inspected', 'priced', 'listed', 'sold', 'unsold', 'delivered', 'withdrawn'));
+
+CREATE INDEX IF NOT EXISTS vehicles_lifecycle_idx ON vehicles (lifecycle) WHERE deleted_at IS NULL;
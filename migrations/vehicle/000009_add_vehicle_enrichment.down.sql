@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS vehicles_enrichment_retry_at_idx;
+ALTER TABLE vehicles
+  DROP COLUMN IF EXISTS enrichment_error,
+  DROP COLUMN IF EXISTS enrichment_retry_at,
+  DROP COLUMN IF EXISTS enrichment_attempts;
@@ -0,0 +1,9 @@
+-- Vehicles saved while the inspection or pricing service was unavailable are pending enrichment
+-- as long as enrichment_retry_at is set
+ALTER TABLE vehicles
+  ADD COLUMN IF NOT EXISTS enrichment_attempts INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS enrichment_retry_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS enrichment_error TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS vehicles_enrichment_retry_at_idx ON vehicles (enrichment_retry_at, vin)
+  WHERE enrichment_retry_at IS NOT NULL AND deleted_at IS NULL;
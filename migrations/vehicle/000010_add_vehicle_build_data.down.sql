@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS vehicles_fuel_type_idx;
+DROP INDEX IF EXISTS vehicles_body_class_idx;
+ALTER TABLE vehicles
+  DROP COLUMN IF EXISTS decode_quality,
+  DROP COLUMN IF EXISTS electrification_level,
+  DROP COLUMN IF EXISTS doors,
+  DROP COLUMN IF EXISTS cylinders,
+  DROP COLUMN IF EXISTS displacement,
+  DROP COLUMN IF EXISTS fuel_type,
+  DROP COLUMN IF EXISTS drive_type,
+  DROP COLUMN IF EXISTS body_class,
+  DROP COLUMN IF EXISTS trim,
+  DROP COLUMN IF EXISTS model;
@@ -0,0 +1,15 @@
+-- Build data decoded by NHTSA besides brand, engine and transmission, decode_quality holds the NHTSA error codes
+ALTER TABLE vehicles
+  ADD COLUMN IF NOT EXISTS model VARCHAR(100) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS trim VARCHAR(100) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS body_class VARCHAR(100) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS drive_type VARCHAR(50) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS fuel_type VARCHAR(50) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS displacement DOUBLE PRECISION NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS cylinders INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS doors INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS electrification_level VARCHAR(100) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS decode_quality JSONB;
+
+CREATE INDEX IF NOT EXISTS vehicles_body_class_idx ON vehicles (lower(body_class)) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS vehicles_fuel_type_idx ON vehicles (lower(fuel_type)) WHERE deleted_at IS NULL;
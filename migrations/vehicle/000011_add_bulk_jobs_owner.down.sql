@@ -0,0 +1,3 @@
+ALTER TABLE bulk_jobs
+  DROP COLUMN IF EXISTS heartbeat_at,
+  DROP COLUMN IF EXISTS owner;
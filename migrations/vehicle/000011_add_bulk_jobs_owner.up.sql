@@ -0,0 +1,5 @@
+-- An unfinished job is processed by the instance that owns it, another instance takes it over
+-- once the owner has not renewed its heartbeat for the lease
+ALTER TABLE bulk_jobs
+  ADD COLUMN IF NOT EXISTS owner VARCHAR(32),
+  ADD COLUMN IF NOT EXISTS heartbeat_at TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+ALTER TABLE vehicles
+  DROP COLUMN IF EXISTS sale_channel,
+  DROP COLUMN IF EXISTS sale_id;
@@ -0,0 +1,4 @@
+-- A vehicle on sale through another channel, e.g. an auction, is claimed by that sale until it is settled
+ALTER TABLE vehicles
+  ADD COLUMN IF NOT EXISTS sale_channel VARCHAR(16) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS sale_id VARCHAR(64) NOT NULL DEFAULT '';
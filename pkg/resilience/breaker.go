@@ -0,0 +1,205 @@
+package resilience
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Circuit breaker states
+const (
+	StateClosed   = "closed"
+	StateOpen     = "open"
+	StateHalfOpen = "half_open"
+)
+
+// ErrOpen is returned without calling the dependency while its circuit breaker is open
+var ErrOpen = status.Error(codes.Unavailable, "circuit breaker is open")
+
+// BreakerConfig holds the settings of a circuit breaker
+type BreakerConfig struct {
+	FailureThreshold int           // consecutive failures that open the breaker
+	OpenTimeout      time.Duration // time the breaker stays open before it lets a probe through
+	HalfOpenProbes   int           // successful probes in a row that close the breaker again
+	Codes            []codes.Code  // status codes counted as failures of the dependency
+
+	// OnStateChange is called outside the lock after every state change
+	OnStateChange func(name, from, to string)
+
+	// Now returns the current time, time.Now by default
+	Now func() time.Time
+}
+
+// DefaultBreakerConfig returns the circuit breaker settings used when nothing else is configured
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+		HalfOpenProbes:   1,
+		Codes:            []codes.Code{codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown},
+	}
+}
+
+// BreakerStats represents the metrics of a circuit breaker
+type BreakerStats struct {
+	Name           string    `json:"name"`
+	State          string    `json:"state"`
+	Requests       uint64    `json:"requests"`
+	Successes      uint64    `json:"successes"`
+	Failures       uint64    `json:"failures"`
+	Rejected       uint64    `json:"rejected"`
+	Opened         uint64    `json:"opened"`
+	StateChangedAt time.Time `json:"state_changed_at"`
+}
+
+// Breaker is a circuit breaker of a single dependency, it opens after consecutive failures,
+// rejects the calls while open and lets a single probe at a time through once the open timeout has passed
+type Breaker struct {
+	cfg      BreakerConfig
+	mu       sync.Mutex
+	failures int  // consecutive failures while closed
+	probes   int  // successful probes while half-open
+	probing  bool // a probe is in flight
+	openedAt time.Time
+	stats    BreakerStats
+}
+
+// NewBreaker creates a new closed circuit breaker and registers it for the metrics under the name
+func NewBreaker(name string, cfg BreakerConfig) *Breaker {
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	cfg.FailureThreshold = max(cfg.FailureThreshold, 1)
+	cfg.HalfOpenProbes = max(cfg.HalfOpenProbes, 1)
+	b := &Breaker{
+		cfg:   cfg,
+		stats: BreakerStats{Name: name, State: StateClosed, StateChangedAt: cfg.Now().UTC()},
+	}
+	register(b)
+	return b
+}
+
+// Name returns the name of the dependency guarded by the breaker
+func (b *Breaker) Name() string {
+	return b.stats.Name
+}
+
+// State returns the current state of the breaker
+func (b *Breaker) State() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.stats.State
+}
+
+// Stats returns a snapshot of the breaker metrics
+func (b *Breaker) Stats() BreakerStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.stats
+}
+
+// Do calls fn unless the breaker rejects it and records the outcome
+func (b *Breaker) Do(fn func() error) error {
+	probe, err := b.allow()
+	if err != nil {
+		return err
+	}
+	err = fn()
+	b.record(probe, err)
+	return err
+}
+
+// UnaryClientInterceptor guards every call of the client with the breaker
+func (b *Breaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return b.Do(func() error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+	}
+}
+
+// allow admits the call, it reports whether the call is the probe of a half-open breaker
+func (b *Breaker) allow() (bool, error) {
+	b.mu.Lock()
+	var from string
+	defer func() {
+		b.mu.Unlock()
+		b.notify(from, StateHalfOpen)
+	}()
+
+	// An open breaker becomes half-open once the timeout has passed
+	if b.stats.State == StateOpen && b.cfg.Now().Sub(b.openedAt) >= b.cfg.OpenTimeout {
+		from = b.stats.State
+		b.setState(StateHalfOpen)
+	}
+	switch {
+	case b.stats.State == StateClosed:
+		b.stats.Requests++
+		return false, nil
+	case b.stats.State == StateHalfOpen && !b.probing:
+		b.stats.Requests++
+		b.probing = true
+		return true, nil
+	}
+	b.stats.Rejected++
+	return false, ErrOpen
+}
+
+// record counts the outcome of an admitted call and moves the breaker to its next state,
+// calls cancelled by the caller say nothing about the dependency and are not counted
+func (b *Breaker) record(probe bool, err error) {
+	b.mu.Lock()
+	from := b.stats.State
+	code := status.Code(err)
+	switch {
+	case code == codes.Canceled:
+	case slices.Contains(b.cfg.Codes, code):
+		b.stats.Failures++
+		b.failures++
+		if probe || b.stats.State == StateClosed && b.failures >= b.cfg.FailureThreshold {
+			b.open()
+		}
+	default:
+		b.stats.Successes++
+		b.failures = 0
+		if probe {
+			b.probes++
+			if b.probes >= b.cfg.HalfOpenProbes {
+				b.setState(StateClosed)
+			}
+		}
+	}
+	if probe {
+		b.probing = false
+	}
+	to := b.stats.State
+	b.mu.Unlock()
+	b.notify(from, to)
+}
+
+// open opens the breaker, the caller holds the lock
+func (b *Breaker) open() {
+	b.openedAt = b.cfg.Now()
+	b.stats.Opened++
+	b.setState(StateOpen)
+}
+
+// setState moves the breaker to the state and resets its counters, the caller holds the lock
+func (b *Breaker) setState(state string) {
+	b.stats.State = state
+	b.stats.StateChangedAt = b.cfg.Now().UTC()
+	b.failures = 0
+	b.probes = 0
+}
+
+// notify reports a state change to the configured callback
+func (b *Breaker) notify(from, to string) {
+	if from != "" && from != to && b.cfg.OnStateChange != nil {
+		b.cfg.OnStateChange(b.stats.Name, from, to)
+	}
+}
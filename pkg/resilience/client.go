@@ -0,0 +1,13 @@
+package resilience
+
+import "google.golang.org/grpc"
+
+// DialOption chains the circuit breaker and the retry policy into the unary calls of a client,
+// the breaker sees every call once no matter how many attempts the policy makes, a nil breaker is skipped
+func DialOption(p RetryPolicy, b *Breaker, idempotent ...string) grpc.DialOption {
+	interceptors := []grpc.UnaryClientInterceptor{UnaryClientInterceptor(p, idempotent...)}
+	if b != nil {
+		interceptors = append([]grpc.UnaryClientInterceptor{b.UnaryClientInterceptor()}, interceptors...)
+	}
+	return grpc.WithChainUnaryInterceptor(interceptors...)
+}
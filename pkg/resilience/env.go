@@ -0,0 +1,42 @@
+package resilience
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// RetryPolicyFromEnv returns the default retry policy overridden by the environment variables of the dependency,
+// <PREFIX>_MAX_ATTEMPTS, <PREFIX>_RETRY_BACKOFF, <PREFIX>_RETRY_MAX_BACKOFF, <PREFIX>_ATTEMPT_TIMEOUT and <PREFIX>_HEDGE_DELAY
+func RetryPolicyFromEnv(prefix string) RetryPolicy {
+	p := DefaultRetryPolicy()
+	if n, err := strconv.Atoi(os.Getenv(prefix + "_MAX_ATTEMPTS")); err == nil && n > 0 {
+		p.MaxAttempts = n
+	}
+	if d, err := time.ParseDuration(os.Getenv(prefix + "_RETRY_BACKOFF")); err == nil && d > 0 {
+		p.InitialBackoff = d
+	}
+	if d, err := time.ParseDuration(os.Getenv(prefix + "_RETRY_MAX_BACKOFF")); err == nil && d > 0 {
+		p.MaxBackoff = d
+	}
+	if d, err := time.ParseDuration(os.Getenv(prefix + "_ATTEMPT_TIMEOUT")); err == nil && d > 0 {
+		p.AttemptTimeout = d
+	}
+	if d, err := time.ParseDuration(os.Getenv(prefix + "_HEDGE_DELAY")); err == nil && d > 0 {
+		p.HedgeDelay = d
+	}
+	return p
+}
+
+// BreakerConfigFromEnv returns the default circuit breaker settings overridden by the environment variables
+// of the dependency, <PREFIX>_BREAKER_THRESHOLD and <PREFIX>_BREAKER_OPEN_TIMEOUT
+func BreakerConfigFromEnv(prefix string) BreakerConfig {
+	cfg := DefaultBreakerConfig()
+	if n, err := strconv.Atoi(os.Getenv(prefix + "_BREAKER_THRESHOLD")); err == nil && n > 0 {
+		cfg.FailureThreshold = n
+	}
+	if d, err := time.ParseDuration(os.Getenv(prefix + "_BREAKER_OPEN_TIMEOUT")); err == nil && d > 0 {
+		cfg.OpenTimeout = d
+	}
+	return cfg
+}
@@ -0,0 +1,32 @@
+package resilience
+
+import (
+	"expvar"
+	"sync"
+)
+
+// breakers holds the registered circuit breakers by name, a newer breaker replaces an older one of the same name
+var breakers sync.Map
+
+// init publishes the metrics of all registered circuit breakers as the circuit_breakers expvar
+func init() {
+	expvar.Publish("circuit_breakers", expvar.Func(func() any {
+		return Metrics()
+	}))
+}
+
+// register adds the breaker to the published metrics
+func register(b *Breaker) {
+	breakers.Store(b.Name(), b)
+}
+
+// Metrics returns the stats of all registered circuit breakers by name
+func Metrics() map[string]BreakerStats {
+	stats := make(map[string]BreakerStats)
+	breakers.Range(func(_, v any) bool {
+		b := v.(*Breaker)
+		stats[b.Name()] = b.Stats()
+		return true
+	})
+	return stats
+}
@@ -0,0 +1,305 @@
+package resilience_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/alechekz/online-car-auction/pkg/resilience"
+	pb "github.com/alechekz/online-car-auction/services/inspection/delivery/grpc/proto"
+)
+
+// fault is the behaviour injected into a single call, a delay first and then the status code
+type fault struct {
+	code  codes.Code
+	delay time.Duration
+}
+
+// faultServer is an inspection server that plays the injected faults in order and answers normally afterwards
+type faultServer struct {
+	pb.UnimplementedInspectionServiceServer
+	mu     sync.Mutex
+	faults []fault
+	calls  atomic.Int32
+}
+
+// next returns the fault of the next call
+func (s *faultServer) next() fault {
+	s.calls.Add(1)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.faults) == 0 {
+		return fault{}
+	}
+	f := s.faults[0]
+	s.faults = s.faults[1:]
+	return f
+}
+
+// inject replaces the faults of the next calls
+func (s *faultServer) inject(faults ...fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = faults
+	s.calls.Store(0)
+}
+
+// play applies the fault of the next call
+func (s *faultServer) play(ctx context.Context) error {
+	f := s.next()
+	if f.delay > 0 {
+		select {
+		case <-time.After(f.delay):
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if f.code != codes.OK {
+		return status.Error(f.code, "injected fault")
+	}
+	return nil
+}
+
+// GetBuildData answers with the build data of a Honda
+func (s *faultServer) GetBuildData(ctx context.Context, req *pb.GetBuildDataRequest) (*pb.BuildDataResponse, error) {
+	if err := s.play(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.BuildDataResponse{Vin: req.Vin, Brand: "Honda"}, nil
+}
+
+// InspectVehicle answers with grade 42
+func (s *faultServer) InspectVehicle(ctx context.Context, req *pb.InspectVehicleRequest) (*pb.InspectVehicleResponse, error) {
+	if err := s.play(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.InspectVehicleResponse{Vin: req.Vin, Grade: 42}, nil
+}
+
+// newFaultClient starts an in-process fault server and returns a client dialed with the given option,
+// only GetBuildData is treated as idempotent
+func newFaultClient(t *testing.T, opt grpc.DialOption) (pb.InspectionServiceClient, *faultServer) {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	fs := &faultServer{}
+	pb.RegisterInspectionServiceServer(srv, fs)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		opt,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewInspectionServiceClient(conn), fs
+}
+
+// testPolicy is a fast retry policy for tests
+func testPolicy() resilience.RetryPolicy {
+	p := resilience.DefaultRetryPolicy()
+	p.InitialBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return p
+}
+
+// TestRetryPolicy_Backoff tests the exponential growth, the cap and the jitter of the backoff
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := resilience.RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, p.Backoff(1))
+	assert.Equal(t, 400*time.Millisecond, p.Backoff(3))
+	assert.Equal(t, time.Second, p.Backoff(10), "capped")
+
+	p.Jitter = 0.5
+	for range 100 {
+		d := p.Backoff(2)
+		assert.GreaterOrEqual(t, d, 100*time.Millisecond)
+		assert.LessOrEqual(t, d, 300*time.Millisecond)
+	}
+}
+
+// TestUnaryClientInterceptor_Retry tests the retries against injected faults
+func TestUnaryClientInterceptor_Retry(t *testing.T) {
+	p := testPolicy()
+	p.AttemptTimeout = 50 * time.Millisecond
+	client, fs := newFaultClient(t, resilience.DialOption(p, nil, pb.InspectionService_GetBuildData_FullMethodName))
+
+	tests := []struct {
+		name    string
+		faults  []fault
+		inspect bool
+		code    codes.Code
+		calls   int32
+	}{
+		{name: "no fault", calls: 1},
+		{name: "recovers from unavailable", faults: []fault{{code: codes.Unavailable}, {code: codes.Unavailable}}, calls: 3},
+		{name: "recovers from a slow attempt", faults: []fault{{delay: time.Second}}, calls: 2},
+		{name: "gives up after max attempts", faults: []fault{{code: codes.Unavailable}, {code: codes.Unavailable}, {code: codes.Unavailable}}, code: codes.Unavailable, calls: 3},
+		{name: "does not retry client errors", faults: []fault{{code: codes.InvalidArgument}}, code: codes.InvalidArgument, calls: 1},
+		{name: "does not retry non-idempotent methods", faults: []fault{{code: codes.Unavailable}}, inspect: true, code: codes.Unavailable, calls: 1},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs.inject(test.faults...)
+			var err error
+			if test.inspect {
+				_, err = client.InspectVehicle(t.Context(), &pb.InspectVehicleRequest{Vin: "1HGCM82633A123456"})
+			} else {
+				var resp *pb.BuildDataResponse
+				resp, err = client.GetBuildData(t.Context(), &pb.GetBuildDataRequest{Vin: "1HGCM82633A123456"})
+				if err == nil {
+					assert.Equal(t, "Honda", resp.Brand)
+				}
+			}
+			assert.Equal(t, test.code, status.Code(err))
+			assert.Equal(t, test.calls, fs.calls.Load())
+		})
+	}
+
+	t.Run("stops when the call context is done", func(t *testing.T) {
+		fs.inject(fault{delay: time.Second}, fault{delay: time.Second}, fault{delay: time.Second})
+		ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+		defer cancel()
+		_, err := client.GetBuildData(ctx, &pb.GetBuildDataRequest{Vin: "1HGCM82633A123456"})
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		assert.Equal(t, int32(1), fs.calls.Load())
+	})
+}
+
+// TestUnaryClientInterceptor_Hedge tests that a slow attempt is hedged by another one
+func TestUnaryClientInterceptor_Hedge(t *testing.T) {
+	p := testPolicy()
+	p.HedgeDelay = 20 * time.Millisecond
+	client, fs := newFaultClient(t, resilience.DialOption(p, nil, pb.InspectionService_GetBuildData_FullMethodName))
+
+	t.Run("second attempt wins", func(t *testing.T) {
+		fs.inject(fault{delay: 5 * time.Second})
+		start := time.Now()
+		resp, err := client.GetBuildData(t.Context(), &pb.GetBuildDataRequest{Vin: "1HGCM82633A123456"})
+		assert.NoError(t, err)
+		assert.Equal(t, "Honda", resp.Brand)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Equal(t, int32(2), fs.calls.Load())
+	})
+
+	t.Run("failures are replaced", func(t *testing.T) {
+		fs.inject(fault{code: codes.Unavailable}, fault{code: codes.Unavailable})
+		_, err := client.GetBuildData(t.Context(), &pb.GetBuildDataRequest{Vin: "1HGCM82633A123456"})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), fs.calls.Load())
+	})
+
+	t.Run("all attempts fail", func(t *testing.T) {
+		fs.inject(fault{code: codes.Unavailable}, fault{code: codes.Unavailable}, fault{code: codes.Unavailable})
+		_, err := client.GetBuildData(t.Context(), &pb.GetBuildDataRequest{Vin: "1HGCM82633A123456"})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int32(3), fs.calls.Load())
+	})
+}
+
+// TestBreaker tests opening, half-open probing and closing of the breaker against injected faults
+func TestBreaker(t *testing.T) {
+
+	// Prepare a breaker with a manual clock in front of a single-attempt policy
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var transitions []string
+	cfg := resilience.DefaultBreakerConfig()
+	cfg.FailureThreshold = 2
+	cfg.OpenTimeout = time.Minute
+	cfg.Now = func() time.Time { return now }
+	cfg.OnStateChange = func(_, from, to string) { transitions = append(transitions, from+"->"+to) }
+	b := resilience.NewBreaker("inspection-test", cfg)
+	p := testPolicy()
+	p.MaxAttempts = 1
+	client, fs := newFaultClient(t, resilience.DialOption(p, b, pb.InspectionService_GetBuildData_FullMethodName))
+	call := func() error {
+		_, err := client.GetBuildData(t.Context(), &pb.GetBuildDataRequest{Vin: "1HGCM82633A123456"})
+		return err
+	}
+
+	t.Run("client errors keep it closed", func(t *testing.T) {
+		fs.inject(fault{code: codes.Unavailable}, fault{code: codes.NotFound}, fault{code: codes.NotFound})
+		for range 3 {
+			_ = call()
+		}
+		assert.Equal(t, resilience.StateClosed, b.State())
+	})
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		fs.inject(fault{code: codes.Unavailable}, fault{code: codes.Unavailable})
+		_ = call()
+		_ = call()
+		assert.Equal(t, resilience.StateOpen, b.State())
+
+		err := call()
+		assert.ErrorIs(t, err, resilience.ErrOpen)
+		assert.Equal(t, int32(2), fs.calls.Load(), "rejected without calling the dependency")
+	})
+
+	t.Run("failed probe opens it again", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		fs.inject(fault{code: codes.Unavailable})
+		assert.Equal(t, codes.Unavailable, status.Code(call()))
+		assert.Equal(t, resilience.StateOpen, b.State())
+		assert.ErrorIs(t, call(), resilience.ErrOpen)
+	})
+
+	t.Run("successful probe closes it", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		fs.inject()
+		assert.NoError(t, call())
+		assert.Equal(t, resilience.StateClosed, b.State())
+		assert.NoError(t, call())
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		stats := resilience.Metrics()["inspection-test"]
+		assert.Equal(t, resilience.StateClosed, stats.State)
+		assert.Equal(t, uint64(2), stats.Opened)
+		assert.Equal(t, uint64(2), stats.Rejected)
+		assert.Equal(t, uint64(8), stats.Requests)
+		assert.Equal(t, uint64(4), stats.Failures)
+		assert.Equal(t, []string{"closed->open", "open->half_open", "half_open->open", "open->half_open", "half_open->closed"}, transitions)
+	})
+}
+
+// TestBreaker_SingleProbe tests that a half-open breaker lets only one probe through at a time
+func TestBreaker_SingleProbe(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cfg := resilience.DefaultBreakerConfig()
+	cfg.FailureThreshold = 1
+	cfg.Now = func() time.Time { return now }
+	b := resilience.NewBreaker("single-probe-test", cfg)
+	assert.Error(t, b.Do(func() error { return status.Error(codes.Unavailable, "down") }))
+	now = now.Add(cfg.OpenTimeout)
+
+	// The probe is held until a second call has been rejected
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- b.Do(func() error {
+			<-release
+			return nil
+		})
+	}()
+	assert.Eventually(t, func() bool { return b.Stats().Requests == 2 }, time.Second, time.Millisecond)
+	assert.ErrorIs(t, b.Do(func() error { return nil }), resilience.ErrOpen)
+	close(release)
+	assert.NoError(t, <-done)
+	assert.Equal(t, resilience.StateClosed, b.State())
+}
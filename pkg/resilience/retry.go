@@ -0,0 +1,158 @@
+package resilience
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// RetryPolicy describes how the failed calls of idempotent gRPC methods are retried
+type RetryPolicy struct {
+	MaxAttempts    int           // attempts of a call including the first one, 1 disables retries
+	InitialBackoff time.Duration // pause before the first retry
+	MaxBackoff     time.Duration // upper limit of the pause between attempts
+	Multiplier     float64       // growth of the pause after every attempt
+	Jitter         float64       // fraction of the pause randomised in both directions
+	AttemptTimeout time.Duration // deadline of each attempt, zero leaves only the deadline of the call
+	HedgeDelay     time.Duration // starts another attempt when the previous ones have not answered in time, zero disables hedging
+	Codes          []codes.Code  // retryable status codes
+}
+
+// DefaultRetryPolicy returns the policy used when nothing else is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		Codes:          []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded},
+	}
+}
+
+// Backoff returns the pause before the given retry, counted from 1, growing exponentially with a random jitter
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(max(p.Multiplier, 1), float64(retry-1))
+	d = min(d, float64(p.MaxBackoff))
+	if p.Jitter > 0 {
+		d *= 1 + p.Jitter*(2*rand.Float64()-1) // nolint:gosec
+	}
+	return time.Duration(d)
+}
+
+// retryable reports whether the failed attempt may be repeated within the call context
+func (p RetryPolicy) retryable(ctx context.Context, err error) bool {
+	return ctx.Err() == nil && slices.Contains(p.Codes, status.Code(err))
+}
+
+// UnaryClientInterceptor retries or hedges the calls of the idempotent methods given by their full names,
+// the calls of any other method are made once
+func UnaryClientInterceptor(p RetryPolicy, idempotent ...string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if p.MaxAttempts <= 1 || !slices.Contains(idempotent, method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		if msg, ok := reply.(proto.Message); ok && p.HedgeDelay > 0 {
+			return p.hedge(ctx, method, req, msg, cc, invoker, opts)
+		}
+		return p.retry(ctx, method, req, reply, cc, invoker, opts)
+	}
+}
+
+// retry makes the attempts one after another, pausing between them
+func (p RetryPolicy) retry(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts []grpc.CallOption) error {
+	var err error
+	for attempt := range p.MaxAttempts {
+		if attempt > 0 && !sleep(ctx, p.Backoff(attempt)) {
+			return err
+		}
+		err = p.attempt(ctx, method, req, reply, cc, invoker, opts)
+		if err == nil || !p.retryable(ctx, err) {
+			return err
+		}
+	}
+	return err
+}
+
+// hedge starts another attempt whenever the running ones have not answered within the hedge delay
+// and takes the first successful reply, the outstanding attempts are cancelled
+func (p RetryPolicy) hedge(ctx context.Context, method string, req any, reply proto.Message, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts []grpc.CallOption) error {
+
+	// Every attempt decodes into its own reply, the buffer lets the losing attempts finish after the return
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type result struct {
+		reply proto.Message
+		err   error
+	}
+	results := make(chan result, p.MaxAttempts)
+	launched, pending := 0, 0
+	launch := func() {
+		r := reply.ProtoReflect().New().Interface()
+		launched++
+		pending++
+		go func() {
+			results <- result{reply: r, err: p.attempt(ctx, method, req, r, cc, invoker, opts)}
+		}()
+	}
+	launch()
+	timer := time.NewTimer(p.HedgeDelay)
+	defer timer.Stop()
+
+	// Wait for the first success, a failed attempt is replaced on the next tick
+	var err error
+	for {
+		select {
+		case <-timer.C:
+			if launched < p.MaxAttempts {
+				launch()
+				timer.Reset(p.HedgeDelay)
+			}
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				proto.Reset(reply)
+				proto.Merge(reply, res.reply)
+				return nil
+			}
+			err = res.err
+			if !p.retryable(ctx, err) || pending == 0 && launched == p.MaxAttempts {
+				return err
+			}
+		case <-ctx.Done():
+			if err == nil {
+				err = status.FromContextError(ctx.Err()).Err()
+			}
+			return err
+		}
+	}
+}
+
+// attempt makes a single attempt of the call within the attempt timeout
+func (p RetryPolicy) attempt(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts []grpc.CallOption) error {
+	if p.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.AttemptTimeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// sleep pauses for the given duration, it reports false if the context is done first
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,121 @@
+package vin
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"strings"
+)
+
+// Info holds the data decoded from a VIN
+type Info struct {
+	VIN          string `json:"vin"`
+	WMI          string `json:"wmi"`
+	Manufacturer string `json:"manufacturer"`
+	Country      string `json:"country"`
+	ModelYear    int    `json:"model_year"`
+}
+
+// wmiCSV is the embedded table of world manufacturer identifiers
+//
+//go:embed wmi.csv
+var wmiCSV []byte
+
+// countriesCSV is the embedded table of the countries of the first two VIN characters
+//
+//go:embed countries.csv
+var countriesCSV []byte
+
+// wmi is a world manufacturer identifier of the embedded table
+type wmi struct {
+	manufacturer, country string
+}
+
+// countryRange is a range of the first two VIN characters assigned to a country,
+// a single character range covers every second character
+type countryRange struct {
+	from, to, country string
+}
+
+var (
+	wmis      = make(map[string]wmi)
+	countries []countryRange
+)
+
+// rangeOrder is the order of the VIN characters in the country ranges
+const rangeOrder = "ABCDEFGHJKLMNPRSTUVWXYZ1234567890"
+
+// init loads the embedded tables
+func init() {
+	for _, r := range readTable(wmiCSV) {
+		wmis[r[0]] = wmi{manufacturer: r[1], country: r[2]}
+	}
+	for _, r := range readTable(countriesCSV) {
+		countries = append(countries, countryRange{from: r[0], to: r[1], country: r[2]})
+	}
+}
+
+// readTable reads an embedded CSV table without its header
+func readTable(b []byte) [][]string {
+	rows, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	if err != nil {
+		panic("vin: broken embedded table: " + err.Error())
+	}
+	return rows[1:]
+}
+
+// Manufacturer returns the manufacturer of the world manufacturer identifier of the VIN
+func Manufacturer(vin string) (string, bool) {
+	vin = Normalize(vin)
+	if len(vin) < 3 {
+		return "", false
+	}
+	w, ok := wmis[vin[:3]]
+	return w.manufacturer, ok
+}
+
+// Country returns the country the VIN was assigned in
+func Country(vin string) (string, bool) {
+	vin = Normalize(vin)
+	if len(vin) < 2 {
+		return "", false
+	}
+	if w, ok := wmis[vin[:min(3, len(vin))]]; ok {
+		return w.country, true
+	}
+	second := strings.IndexByte(rangeOrder, vin[1])
+	for _, c := range countries {
+		if c.from[0] != vin[0] {
+			continue
+		}
+		if len(c.from) == 1 {
+			return c.country, true
+		}
+		if second >= strings.IndexByte(rangeOrder, c.from[1]) && second <= strings.IndexByte(rangeOrder, c.to[1]) {
+			return c.country, true
+		}
+	}
+	return "", false
+}
+
+// Decode validates the VIN and decodes its manufacturer, country and model year,
+// the manufacturer and country are empty when the tables do not know the VIN,
+// the model year is zero for non-North American VINs without a model year code
+func Decode(vin string) (*Info, error) {
+	vin = Normalize(vin)
+	if err := Validate(vin); err != nil {
+		return nil, err
+	}
+	year, err := ModelYear(vin)
+	if errors.Is(err, ErrYearCode) && !NorthAmerican(vin) {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	info := &Info{VIN: vin, WMI: vin[:3], ModelYear: year}
+	info.Manufacturer, _ = Manufacturer(vin)
+	info.Country, _ = Country(vin)
+	return info, nil
+}
@@ -0,0 +1,36 @@
+package vin
+
+import "github.com/go-ozzo/ozzo-validation/v4"
+
+// Rule validates a VIN string field, an empty VIN is left to validation.Required
+var Rule = validation.By(func(value any) error {
+	s, _ := value.(string)
+	if s == "" {
+		return nil
+	}
+	return Validate(s)
+})
+
+// YearRule cross-checks a model year field with the VIN, it is skipped while the VIN itself is invalid
+func YearRule(vin string) validation.Rule {
+	return validation.By(func(value any) error {
+		year, ok := toInt(value)
+		if !ok || year == 0 || Validate(vin) != nil {
+			return nil
+		}
+		return CheckYear(vin, year)
+	})
+}
+
+// toInt converts the integer field values used for years
+func toInt(value any) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,80 @@
+// Package vin validates and decodes vehicle identification numbers offline
+package vin
+
+import (
+	"errors"
+	"strings"
+)
+
+// Length is the length of a VIN
+const Length = 17
+
+var (
+	ErrLength     = errors.New("must be exactly 17 characters long")
+	ErrCharacter  = errors.New("must contain only digits and letters other than I, O and Q")
+	ErrCheckDigit = errors.New("has an invalid check digit")
+)
+
+// transliteration holds the check digit values of the VIN characters, I, O and Q are not allowed
+var transliteration = map[rune]int{
+	'0': 0, '1': 1, '2': 2, '3': 3, '4': 4, '5': 5, '6': 6, '7': 7, '8': 8, '9': 9,
+	'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+	'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+	'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+}
+
+// weights holds the check digit weights of the VIN positions
+var weights = [Length]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// Normalize returns the VIN in upper case without surrounding spaces
+func Normalize(vin string) string {
+	return strings.ToUpper(strings.TrimSpace(vin))
+}
+
+// NorthAmerican reports whether the VIN was assigned in North America, where the check digit is mandatory
+func NorthAmerican(vin string) bool {
+	return vin != "" && vin[0] >= '1' && vin[0] <= '5'
+}
+
+// CheckDigit computes the check digit of the VIN, the character at position 9 is ignored
+func CheckDigit(vin string) (byte, error) {
+	vin = Normalize(vin)
+	if len(vin) != Length {
+		return 0, ErrLength
+	}
+	sum := 0
+	for i, r := range vin {
+		n, ok := transliteration[r]
+		if !ok {
+			return 0, ErrCharacter
+		}
+		sum += n * weights[i]
+	}
+	if d := sum % 11; d < 10 {
+		return byte('0' + d), nil
+	}
+	return 'X', nil
+}
+
+// Validate checks the length and characters of the VIN, and the check digit of North American VINs
+func Validate(vin string) error {
+	vin = Normalize(vin)
+	d, err := CheckDigit(vin)
+	if err != nil {
+		return err
+	}
+	if NorthAmerican(vin) && vin[8] != d {
+		return ErrCheckDigit
+	}
+	return nil
+}
+
+// WithCheckDigit returns the VIN with its check digit at position 9 computed, the VIN is returned unchanged if malformed
+func WithCheckDigit(vin string) string {
+	d, err := CheckDigit(vin)
+	if err != nil {
+		return vin
+	}
+	vin = Normalize(vin)
+	return vin[:8] + string(d) + vin[9:]
+}
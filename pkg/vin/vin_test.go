@@ -0,0 +1,112 @@
+package vin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/pkg/vin"
+)
+
+// TestValidate tests the Validate function
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		vin  string
+		err  error
+	}{
+		{name: "valid", vin: "1HGCM82633A004352"},
+		{name: "valid with X check digit", vin: "1M8GDM9AXKP042788"},
+		{name: "valid lower case", vin: "5yjsa1e22mf168123"},
+		{name: "non North American without check digit", vin: "WBS8M9C59J5G12345"},
+		{name: "too short", vin: "1HGCM82633A00435", err: vin.ErrLength},
+		{name: "letter O", vin: "1HGCM82633AO04352", err: vin.ErrCharacter},
+		{name: "letter I", vin: "IHGCM82633A004352", err: vin.ErrCharacter},
+		{name: "letter Q", vin: "1HGCM82633A00435Q", err: vin.ErrCharacter},
+		{name: "wrong check digit", vin: "1HGCM82643A004352", err: vin.ErrCheckDigit},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorIs(t, vin.Validate(test.vin), test.err)
+		})
+	}
+}
+
+// TestWithCheckDigit tests the WithCheckDigit function
+func TestWithCheckDigit(t *testing.T) {
+	assert.Equal(t, "1HGCM82633A004352", vin.WithCheckDigit("1hgcm826x3a004352"))
+	assert.Equal(t, "123", vin.WithCheckDigit("123"))
+}
+
+// TestModelYear tests the ModelYear and CheckYear functions
+func TestModelYear(t *testing.T) {
+	tests := []struct {
+		name string
+		vin  string
+		year int
+		err  error
+	}{
+		{name: "1980 cycle", vin: "1HGCM82633A004352", year: 2003},
+		{name: "2010 cycle", vin: "5YJSA1E22MF168123", year: 2021},
+		{name: "invalid code", vin: "1HGCM8263UA004352", err: vin.ErrYearCode},
+		{name: "too short", vin: "1HGCM", err: vin.ErrLength},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			year, err := vin.ModelYear(test.vin)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.year, year)
+			if test.err == nil {
+				assert.NoError(t, vin.CheckYear(test.vin, test.year))
+				assert.NoError(t, vin.CheckYear(test.vin, test.year+30))
+				assert.ErrorIs(t, vin.CheckYear(test.vin, test.year+1), vin.ErrModelYear)
+			}
+		})
+	}
+}
+
+// TestCheckYear_NoYearCode tests that non-North American VINs without a model year code skip the year check
+func TestCheckYear_NoYearCode(t *testing.T) {
+	for _, v := range []string{"SHHFK2760ZU000001", "SHHFK27600U000001", "SHHFK2760UU000001"} {
+		assert.NoError(t, vin.CheckYear(v, 2018), v)
+	}
+	assert.ErrorIs(t, vin.CheckYear("1HGCM8263UA004352", 2018), vin.ErrYearCode, "mandatory in North America")
+}
+
+// TestDecode tests the Decode function
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		vin  string
+		want *vin.Info
+		err  error
+	}{
+		{
+			name: "known manufacturer",
+			vin:  "5YJSA1E22MF168123",
+			want: &vin.Info{VIN: "5YJSA1E22MF168123", WMI: "5YJ", Manufacturer: "Tesla", Country: "United States", ModelYear: 2021},
+		},
+		{
+			name: "unknown manufacturer of a known country",
+			vin:  "SHHFK2760JU000001",
+			want: &vin.Info{VIN: "SHHFK2760JU000001", WMI: "SHH", Country: "United Kingdom", ModelYear: 2018},
+		},
+		{
+			name: "no model year code",
+			vin:  "shhfk2760zu000001",
+			want: &vin.Info{VIN: "SHHFK2760ZU000001", WMI: "SHH", Country: "United Kingdom"},
+		},
+		{
+			name: "invalid",
+			vin:  "1HGCM82643A004352",
+			err:  vin.ErrCheckDigit,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := vin.Decode(test.vin)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package vin
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// yearCodes holds the model year codes of position 10 in the order of the 30 year cycle starting in 1980
+const yearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+var (
+	ErrYearCode  = errors.New("has an invalid model year code")
+	ErrModelYear = errors.New("does not match the model year of the VIN")
+)
+
+// yearOffset returns the position of the model year code of the VIN in the 30 year cycle
+func yearOffset(vin string) (int, error) {
+	vin = Normalize(vin)
+	if len(vin) != Length {
+		return 0, ErrLength
+	}
+	i := strings.IndexByte(yearCodes, vin[9])
+	if i < 0 {
+		return 0, ErrYearCode
+	}
+	return i, nil
+}
+
+// ModelYear decodes the model year of the VIN, position 7 of North American VINs tells the 1980 cycle (a digit)
+// from the 2010 cycle (a letter), other VINs get the latest year of the code not later than next year
+func ModelYear(vin string) (int, error) {
+	i, err := yearOffset(vin)
+	if err != nil {
+		return 0, err
+	}
+	vin = Normalize(vin)
+	if NorthAmerican(vin) {
+		if vin[6] >= '0' && vin[6] <= '9' {
+			return 1980 + i, nil
+		}
+		return 2010 + i, nil
+	}
+	year := 1980 + i
+	for year+30 <= time.Now().Year()+1 {
+		year += 30
+	}
+	return year, nil
+}
+
+// CheckYear checks that the year is one of the model years of the code at position 10 of the VIN,
+// the check is skipped for non-North American VINs, which may use position 10 otherwise, e.g. '0', 'U' or 'Z'
+func CheckYear(vin string, year int) error {
+	i, err := yearOffset(vin)
+	if errors.Is(err, ErrYearCode) && !NorthAmerican(Normalize(vin)) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if year < 1980 || (year-1980)%30 != i {
+		return ErrModelYear
+	}
+	return nil
+}
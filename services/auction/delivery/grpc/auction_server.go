@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/alechekz/online-car-auction/services/auction/delivery/grpc/proto"
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// AuctionServer implements the gRPC server for auction service
+type AuctionServer struct {
+	pb.UnimplementedAuctionServiceServer
+	uc usecase.AuctionUsecase
+}
+
+// NewAuctionServer creates a new AuctionServer instance
+func NewAuctionServer(uc usecase.AuctionUsecase) *AuctionServer {
+	return &AuctionServer{uc: uc}
+}
+
+// OpenAuction opens a new auction of a vehicle
+func (s *AuctionServer) OpenAuction(ctx context.Context, req *pb.OpenAuctionRequest) (*pb.Auction, error) {
+	var startsAt, endsAt time.Time
+	if req.GetStartsAt() != nil {
+		startsAt = req.GetStartsAt().AsTime()
+	}
+	if req.GetEndsAt() != nil {
+		endsAt = req.GetEndsAt().AsTime()
+	}
+	reserve := domain.Reserve{Price: req.GetReservePrice(), Percent: req.GetReservePercent()}
+	a, err := s.uc.Open(ctx, req.GetVin(), req.GetStartPrice(), reserve, startsAt, endsAt)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return auctionToProto(a), nil
+}
+
+// GetAuction retrieves an auction by its ID
+func (s *AuctionServer) GetAuction(ctx context.Context, req *pb.GetAuctionRequest) (*pb.Auction, error) {
+	a, err := s.uc.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return auctionToProto(a), nil
+}
+
+// ListAuctions lists auctions, optionally of the given status only
+func (s *AuctionServer) ListAuctions(ctx context.Context, req *pb.ListAuctionsRequest) (*pb.ListAuctionsResponse, error) {
+	auctions, err := s.uc.List(ctx, req.GetStatus())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &pb.ListAuctionsResponse{Auctions: make([]*pb.Auction, len(auctions))}
+	for i, a := range auctions {
+		res.Auctions[i] = auctionToProto(a)
+	}
+	return res, nil
+}
+
+// PlaceBid places a bid in an auction
+func (s *AuctionServer) PlaceBid(ctx context.Context, req *pb.PlaceBidRequest) (*pb.PlaceBidResponse, error) {
+	b := &domain.Bid{Bidder: req.GetBidder(), Amount: req.GetAmount()}
+	a, err := s.uc.PlaceBid(ctx, req.GetAuctionId(), b)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.PlaceBidResponse{Bid: bidToProto(b), Auction: auctionToProto(a)}, nil
+}
+
+// PlaceMaxBid places a hidden maximum bid in an auction
+func (s *AuctionServer) PlaceMaxBid(ctx context.Context, req *pb.PlaceMaxBidRequest) (*pb.PlaceMaxBidResponse, error) {
+	m := &domain.MaxBid{Bidder: req.GetBidder(), Amount: req.GetAmount()}
+	a, bids, err := s.uc.PlaceMaxBid(ctx, req.GetAuctionId(), m)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &pb.PlaceMaxBidResponse{Bids: make([]*pb.Bid, len(bids)), Auction: auctionToProto(a)}
+	for i, b := range bids {
+		res.Bids[i] = bidToProto(b)
+	}
+	return res, nil
+}
+
+// ListBids lists the bids of an auction, oldest first
+func (s *AuctionServer) ListBids(ctx context.Context, req *pb.ListBidsRequest) (*pb.ListBidsResponse, error) {
+	bids, err := s.uc.Bids(ctx, req.GetAuctionId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &pb.ListBidsResponse{Bids: make([]*pb.Bid, len(bids))}
+	for i, b := range bids {
+		res.Bids[i] = bidToProto(b)
+	}
+	return res, nil
+}
+
+// CounterOffer makes the seller's counter offer to the leading buyer of an auction closed below its reserve
+func (s *AuctionServer) CounterOffer(ctx context.Context, req *pb.CounterOfferRequest) (*pb.Auction, error) {
+	a, err := s.uc.CounterOffer(ctx, req.GetAuctionId(), req.GetAmount())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return auctionToProto(a), nil
+}
+
+// AcceptCounterOffer sells the vehicle to the leading buyer at the seller's counter offer
+func (s *AuctionServer) AcceptCounterOffer(ctx context.Context, req *pb.BuyerResponseRequest) (*pb.Auction, error) {
+	a, err := s.uc.AcceptCounterOffer(ctx, req.GetAuctionId(), req.GetBuyer())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return auctionToProto(a), nil
+}
+
+// DeclineCounterOffer ends the if-sale negotiation of an auction without a sale
+func (s *AuctionServer) DeclineCounterOffer(ctx context.Context, req *pb.BuyerResponseRequest) (*pb.Auction, error) {
+	a, err := s.uc.DeclineCounterOffer(ctx, req.GetAuctionId(), req.GetBuyer())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return auctionToProto(a), nil
+}
+
+// auctionToProto converts a domain auction into a protobuf auction
+func auctionToProto(a *domain.Auction) *pb.Auction {
+	res := &pb.Auction{
+		Id:               a.ID,
+		Vin:              a.VIN,
+		Status:           a.Status,
+		RecommendedPrice: a.RecommendedPrice,
+		StartPrice:       a.StartPrice,
+		Increment:        a.Increment,
+		HighBid:          a.HighBid,
+		Leader:           a.Leader,
+		BidCount:         int32(a.BidCount), //nolint:gosec // bids of a single auction
+		ReserveMet:       a.ReserveMet,
+		CounterOffer:     a.CounterOffer,
+		Winner:           a.Winner,
+		SalePrice:        a.SalePrice,
+		StartsAt:         timestamppb.New(a.StartsAt),
+		EndsAt:           timestamppb.New(a.EndsAt),
+		Version:          a.Version,
+		CreatedAt:        timestamppb.New(a.CreatedAt),
+		SoftClose: &pb.SoftClose{
+			Window:        durationpb.New(a.SoftClose.Window),
+			Extension:     durationpb.New(a.SoftClose.Extension),
+			MaxExtensions: int32(a.SoftClose.MaxExtensions), //nolint:gosec // small configured cap
+		},
+		Extensions: int32(a.Extensions), //nolint:gosec // bounded by the bids of a single auction
+	}
+	if a.IfSaleEndsAt != nil {
+		res.IfSaleEndsAt = timestamppb.New(*a.IfSaleEndsAt)
+	}
+	if a.ClosedAt != nil {
+		res.ClosedAt = timestamppb.New(*a.ClosedAt)
+	}
+	return res
+}
+
+// bidToProto converts a domain bid into a protobuf bid
+func bidToProto(b *domain.Bid) *pb.Bid {
+	return &pb.Bid{
+		Id:        b.ID,
+		AuctionId: b.AuctionID,
+		Bidder:    b.Bidder,
+		Amount:    b.Amount,
+		Auto:      b.Auto,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+}
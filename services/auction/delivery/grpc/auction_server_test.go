@@ -0,0 +1,116 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auctiongrpc "github.com/alechekz/online-car-auction/services/auction/delivery/grpc"
+	pb "github.com/alechekz/online-car-auction/services/auction/delivery/grpc/proto"
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// newTestClient starts a gRPC server with in-memory dependencies and returns a client connected to it
+func newTestClient(t *testing.T) pb.AuctionServiceClient {
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	uc := usecase.NewAuctionUC(infrastructure.NewMemoryAuctionRepo(), infrastructure.NewMemoryActiveSaleRepo(), vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), infrastructure.SystemClock{}, domain.SoftClose{})
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterAuctionServiceServer(srv, auctiongrpc.NewAuctionServer(uc))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewAuctionServiceClient(conn)
+}
+
+// TestAuctionServer tests the methods of AuctionServer
+func TestAuctionServer(t *testing.T) {
+	client := newTestClient(t)
+	var id string
+
+	t.Run("open auction", func(t *testing.T) {
+		a, err := client.OpenAuction(t.Context(), &pb.OpenAuctionRequest{
+			Vin:    "1HGCM82633A123456",
+			EndsAt: timestamppb.New(time.Now().Add(time.Hour)),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AuctionOpen, a.Status)
+		assert.Nil(t, a.ClosedAt)
+		id = a.Id
+
+		_, err = client.OpenAuction(t.Context(), &pb.OpenAuctionRequest{Vin: "1HGCM82633A000001"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("place bid", func(t *testing.T) {
+		res, err := client.PlaceBid(t.Context(), &pb.PlaceBidRequest{AuctionId: id, Bidder: "alice", Amount: 12_500})
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", res.Auction.Leader)
+		assert.Equal(t, int64(1), res.Bid.Id)
+
+		_, err = client.PlaceBid(t.Context(), &pb.PlaceBidRequest{AuctionId: id, Bidder: "bob", Amount: 12_600})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("get and list", func(t *testing.T) {
+		a, err := client.GetAuction(t.Context(), &pb.GetAuctionRequest{Id: id})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), a.BidCount)
+
+		_, err = client.GetAuction(t.Context(), &pb.GetAuctionRequest{Id: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		auctions, err := client.ListAuctions(t.Context(), &pb.ListAuctionsRequest{Status: domain.AuctionOpen})
+		assert.NoError(t, err)
+		assert.Len(t, auctions.Auctions, 1)
+
+		bids, err := client.ListBids(t.Context(), &pb.ListBidsRequest{AuctionId: id})
+		assert.NoError(t, err)
+		assert.Len(t, bids.Bids, 1)
+	})
+
+	t.Run("place max bid", func(t *testing.T) {
+		res, err := client.PlaceMaxBid(t.Context(), &pb.PlaceMaxBidRequest{AuctionId: id, Bidder: "bob", Amount: 20_000})
+		assert.NoError(t, err)
+		assert.Len(t, res.Bids, 1)
+		assert.True(t, res.Bids[0].Auto)
+		assert.Equal(t, uint64(12_800), res.Bids[0].Amount)
+		assert.Equal(t, "bob", res.Auction.Leader)
+
+		_, err = client.PlaceMaxBid(t.Context(), &pb.PlaceMaxBidRequest{AuctionId: id, Bidder: "carol", Amount: 12_900})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("if-sale negotiation", func(t *testing.T) {
+		_, err := client.CounterOffer(t.Context(), &pb.CounterOfferRequest{AuctionId: id, Amount: 20_000})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = client.AcceptCounterOffer(t.Context(), &pb.BuyerResponseRequest{AuctionId: id, Buyer: "bob"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = client.OpenAuction(t.Context(), &pb.OpenAuctionRequest{
+			Vin:            "1HGCM82633A000002",
+			EndsAt:         timestamppb.New(time.Now().Add(time.Hour)),
+			ReservePrice:   20_000,
+			ReservePercent: 80,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
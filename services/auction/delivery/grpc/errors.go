@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// statusError maps domain errors to gRPC status errors
+func statusError(err error) error {
+
+	// Default to internal error
+	code := codes.Internal
+	msg := "internal server error"
+
+	// Determine specific error type
+	switch {
+	case errors.Is(err, domain.ErrValidation):
+		code = codes.InvalidArgument
+		msg = err.Error()
+	case errors.Is(err, domain.ErrNotFound):
+		code = codes.NotFound
+		msg = err.Error()
+	case errors.Is(err, domain.ErrVehicleNotFound), errors.Is(err, domain.ErrAuctionNotOpen), errors.Is(err, domain.ErrBidTooLow),
+		errors.Is(err, domain.ErrNotNegotiating), errors.Is(err, domain.ErrNoCounterOffer), errors.Is(err, domain.ErrNotForSale):
+		code = codes.FailedPrecondition
+		msg = err.Error()
+	case errors.Is(err, domain.ErrNotBuyer):
+		code = codes.PermissionDenied
+		msg = err.Error()
+	case errors.Is(err, domain.ErrAuctionExists), errors.Is(err, domain.ErrSaleExists):
+		code = codes.AlreadyExists
+		msg = err.Error()
+	case errors.Is(err, domain.ErrVersionConflict):
+		code = codes.Aborted
+		msg = err.Error()
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+		msg = err.Error()
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+		msg = err.Error()
+	}
+	return status.Error(code, msg)
+}
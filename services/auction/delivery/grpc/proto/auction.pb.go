@@ -0,0 +1,1211 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: auction.proto
+
+package proto_proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Auction struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vin              string                 `protobuf:"bytes,2,opt,name=vin,proto3" json:"vin,omitempty"`
+	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	RecommendedPrice uint64                 `protobuf:"varint,4,opt,name=recommended_price,json=recommendedPrice,proto3" json:"recommended_price,omitempty"`
+	StartPrice       uint64                 `protobuf:"varint,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	Increment        uint64                 `protobuf:"varint,6,opt,name=increment,proto3" json:"increment,omitempty"`
+	HighBid          uint64                 `protobuf:"varint,7,opt,name=high_bid,json=highBid,proto3" json:"high_bid,omitempty"`
+	Leader           string                 `protobuf:"bytes,8,opt,name=leader,proto3" json:"leader,omitempty"`
+	BidCount         int32                  `protobuf:"varint,9,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"`
+	Winner           string                 `protobuf:"bytes,10,opt,name=winner,proto3" json:"winner,omitempty"`
+	StartsAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	ClosedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	Version          int64                  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SoftClose        *SoftClose             `protobuf:"bytes,16,opt,name=soft_close,json=softClose,proto3" json:"soft_close,omitempty"`
+	Extensions       int32                  `protobuf:"varint,17,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	ReserveMet       bool                   `protobuf:"varint,18,opt,name=reserve_met,json=reserveMet,proto3" json:"reserve_met,omitempty"`
+	CounterOffer     uint64                 `protobuf:"varint,19,opt,name=counter_offer,json=counterOffer,proto3" json:"counter_offer,omitempty"`
+	SalePrice        uint64                 `protobuf:"varint,20,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	IfSaleEndsAt     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=if_sale_ends_at,json=ifSaleEndsAt,proto3" json:"if_sale_ends_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Auction) Reset() {
+	*x = Auction{}
+	mi := &file_auction_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auction) ProtoMessage() {}
+
+func (x *Auction) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auction.ProtoReflect.Descriptor instead.
+func (*Auction) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Auction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Auction) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *Auction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Auction) GetRecommendedPrice() uint64 {
+	if x != nil {
+		return x.RecommendedPrice
+	}
+	return 0
+}
+
+func (x *Auction) GetStartPrice() uint64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
+}
+
+func (x *Auction) GetIncrement() uint64 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+func (x *Auction) GetHighBid() uint64 {
+	if x != nil {
+		return x.HighBid
+	}
+	return 0
+}
+
+func (x *Auction) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *Auction) GetBidCount() int32 {
+	if x != nil {
+		return x.BidCount
+	}
+	return 0
+}
+
+func (x *Auction) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Auction) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Auction) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Auction) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Auction) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Auction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Auction) GetSoftClose() *SoftClose {
+	if x != nil {
+		return x.SoftClose
+	}
+	return nil
+}
+
+func (x *Auction) GetExtensions() int32 {
+	if x != nil {
+		return x.Extensions
+	}
+	return 0
+}
+
+func (x *Auction) GetReserveMet() bool {
+	if x != nil {
+		return x.ReserveMet
+	}
+	return false
+}
+
+func (x *Auction) GetCounterOffer() uint64 {
+	if x != nil {
+		return x.CounterOffer
+	}
+	return 0
+}
+
+func (x *Auction) GetSalePrice() uint64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *Auction) GetIfSaleEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IfSaleEndsAt
+	}
+	return nil
+}
+
+// A bid within the window before the end extends it, at most max_extensions times unless it is zero
+type SoftClose struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *durationpb.Duration   `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Extension     *durationpb.Duration   `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	MaxExtensions int32                  `protobuf:"varint,3,opt,name=max_extensions,json=maxExtensions,proto3" json:"max_extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoftClose) Reset() {
+	*x = SoftClose{}
+	mi := &file_auction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftClose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftClose) ProtoMessage() {}
+
+func (x *SoftClose) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftClose.ProtoReflect.Descriptor instead.
+func (*SoftClose) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SoftClose) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *SoftClose) GetExtension() *durationpb.Duration {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *SoftClose) GetMaxExtensions() int32 {
+	if x != nil {
+		return x.MaxExtensions
+	}
+	return 0
+}
+
+type Bid struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuctionId     string                 `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder        string                 `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Amount        uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Auto          bool                   `protobuf:"varint,6,opt,name=auto,proto3" json:"auto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bid) Reset() {
+	*x = Bid{}
+	mi := &file_auction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bid) ProtoMessage() {}
+
+func (x *Bid) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bid.ProtoReflect.Descriptor instead.
+func (*Bid) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Bid) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Bid) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *Bid) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+func (x *Bid) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Bid) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Bid) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
+// A zero start price defaults to half of the recommended price, an unset start opens the auction right away,
+// the hidden reserve is an absolute price or a percentage of the recommended price
+type OpenAuctionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Vin            string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	StartPrice     uint64                 `protobuf:"varint,2,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	ReservePrice   uint64                 `protobuf:"varint,5,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
+	ReservePercent uint64                 `protobuf:"varint,6,opt,name=reserve_percent,json=reservePercent,proto3" json:"reserve_percent,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OpenAuctionRequest) Reset() {
+	*x = OpenAuctionRequest{}
+	mi := &file_auction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenAuctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenAuctionRequest) ProtoMessage() {}
+
+func (x *OpenAuctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenAuctionRequest.ProtoReflect.Descriptor instead.
+func (*OpenAuctionRequest) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OpenAuctionRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *OpenAuctionRequest) GetStartPrice() uint64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
+}
+
+func (x *OpenAuctionRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *OpenAuctionRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *OpenAuctionRequest) GetReservePrice() uint64 {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return 0
+}
+
+func (x *OpenAuctionRequest) GetReservePercent() uint64 {
+	if x != nil {
+		return x.ReservePercent
+	}
+	return 0
+}
+
+type GetAuctionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuctionRequest) Reset() {
+	*x = GetAuctionRequest{}
+	mi := &file_auction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuctionRequest) ProtoMessage() {}
+
+func (x *GetAuctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuctionRequest.ProtoReflect.Descriptor instead.
+func (*GetAuctionRequest) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAuctionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAuctionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuctionsRequest) Reset() {
+	*x = ListAuctionsRequest{}
+	mi := &file_auction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuctionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuctionsRequest) ProtoMessage() {}
+
+func (x *ListAuctionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuctionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuctionsRequest) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAuctionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListAuctionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Auctions      []*Auction             `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuctionsResponse) Reset() {
+	*x = ListAuctionsResponse{}
+	mi := &file_auction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuctionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuctionsResponse) ProtoMessage() {}
+
+func (x *ListAuctionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuctionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuctionsResponse) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAuctionsResponse) GetAuctions() []*Auction {
+	if x != nil {
+		return x.Auctions
+	}
+	return nil
+}
+
+type PlaceBidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuctionId     string                 `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder        string                 `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Amount        uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceBidRequest) Reset() {
+	*x = PlaceBidRequest{}
+	mi := &file_auction_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceBidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceBidRequest) ProtoMessage() {}
+
+func (x *PlaceBidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceBidRequest.ProtoReflect.Descriptor instead.
+func (*PlaceBidRequest) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PlaceBidRequest) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *PlaceBidRequest) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+func (x *PlaceBidRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PlaceBidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bid           *Bid                   `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`
+	Auction       *Auction               `protobuf:"bytes,2,opt,name=auction,proto3" json:"auction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceBidResponse) Reset() {
+	*x = PlaceBidResponse{}
+	mi := &file_auction_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceBidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceBidResponse) ProtoMessage() {}
+
+func (x *PlaceBidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceBidResponse.ProtoReflect.Descriptor instead.
+func (*PlaceBidResponse) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlaceBidResponse) GetBid() *Bid {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
+func (x *PlaceBidResponse) GetAuction() *Auction {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+// The maximum stays hidden, the auction bids on behalf of the bidder up to it
+type PlaceMaxBidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuctionId     string                 `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder        string                 `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Amount        uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceMaxBidRequest) Reset() {
+	*x = PlaceMaxBidRequest{}
+	mi := &file_auction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceMaxBidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceMaxBidRequest) ProtoMessage() {}
+
+func (x *PlaceMaxBidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceMaxBidRequest.ProtoReflect.Descriptor instead.
+func (*PlaceMaxBidRequest) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlaceMaxBidRequest) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *PlaceMaxBidRequest) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+func (x *PlaceMaxBidRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PlaceMaxBidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bids          []*Bid                 `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
+	Auction       *Auction               `protobuf:"bytes,2,opt,name=auction,proto3" json:"auction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceMaxBidResponse) Reset() {
+	*x = PlaceMaxBidResponse{}
+	mi := &file_auction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceMaxBidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceMaxBidResponse) ProtoMessage() {}
+
+func (x *PlaceMaxBidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceMaxBidResponse.ProtoReflect.Descriptor instead.
+func (*PlaceMaxBidResponse) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlaceMaxBidResponse) GetBids() []*Bid {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *PlaceMaxBidResponse) GetAuction() *Auction {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+type ListBidsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuctionId     string                 `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBidsRequest) Reset() {
+	*x = ListBidsRequest{}
+	mi := &file_auction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBidsRequest) ProtoMessage() {}
+
+func (x *ListBidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBidsRequest.ProtoReflect.Descriptor instead.
+func (*ListBidsRequest) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListBidsRequest) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+type ListBidsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bids          []*Bid                 `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBidsResponse) Reset() {
+	*x = ListBidsResponse{}
+	mi := &file_auction_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBidsResponse) ProtoMessage() {}
+
+func (x *ListBidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBidsResponse.ProtoReflect.Descriptor instead.
+func (*ListBidsResponse) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListBidsResponse) GetBids() []*Bid {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+// The seller's counter offer to the leading buyer of an auction closed below its reserve
+type CounterOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuctionId     string                 `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CounterOfferRequest) Reset() {
+	*x = CounterOfferRequest{}
+	mi := &file_auction_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterOfferRequest) ProtoMessage() {}
+
+func (x *CounterOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterOfferRequest.ProtoReflect.Descriptor instead.
+func (*CounterOfferRequest) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CounterOfferRequest) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *CounterOfferRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type BuyerResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuctionId     string                 `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Buyer         string                 `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyerResponseRequest) Reset() {
+	*x = BuyerResponseRequest{}
+	mi := &file_auction_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyerResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyerResponseRequest) ProtoMessage() {}
+
+func (x *BuyerResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auction_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyerResponseRequest.ProtoReflect.Descriptor instead.
+func (*BuyerResponseRequest) Descriptor() ([]byte, []int) {
+	return file_auction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BuyerResponseRequest) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *BuyerResponseRequest) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+var File_auction_proto protoreflect.FileDescriptor
+
+const file_auction_proto_rawDesc = "" +
+	"\n" +
+	"\rauction.proto\x12\aauction\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8e\x06\n" +
+	"\aAuction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03vin\x18\x02 \x01(\tR\x03vin\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12+\n" +
+	"\x11recommended_price\x18\x04 \x01(\x04R\x10recommendedPrice\x12\x1f\n" +
+	"\vstart_price\x18\x05 \x01(\x04R\n" +
+	"startPrice\x12\x1c\n" +
+	"\tincrement\x18\x06 \x01(\x04R\tincrement\x12\x19\n" +
+	"\bhigh_bid\x18\a \x01(\x04R\ahighBid\x12\x16\n" +
+	"\x06leader\x18\b \x01(\tR\x06leader\x12\x1b\n" +
+	"\tbid_count\x18\t \x01(\x05R\bbidCount\x12\x16\n" +
+	"\x06winner\x18\n" +
+	" \x01(\tR\x06winner\x127\n" +
+	"\tstarts_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x127\n" +
+	"\tclosed_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x121\n" +
+	"\n" +
+	"soft_close\x18\x10 \x01(\v2\x12.auction.SoftCloseR\tsoftClose\x12\x1e\n" +
+	"\n" +
+	"extensions\x18\x11 \x01(\x05R\n" +
+	"extensions\x12\x1f\n" +
+	"\vreserve_met\x18\x12 \x01(\bR\n" +
+	"reserveMet\x12#\n" +
+	"\rcounter_offer\x18\x13 \x01(\x04R\fcounterOffer\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\x14 \x01(\x04R\tsalePrice\x12A\n" +
+	"\x0fif_sale_ends_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\fifSaleEndsAt\"\x9e\x01\n" +
+	"\tSoftClose\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06window\x127\n" +
+	"\textension\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\textension\x12%\n" +
+	"\x0emax_extensions\x18\x03 \x01(\x05R\rmaxExtensions\"\xb3\x01\n" +
+	"\x03Bid\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x02 \x01(\tR\tauctionId\x12\x16\n" +
+	"\x06bidder\x18\x03 \x01(\tR\x06bidder\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04auto\x18\x06 \x01(\bR\x04auto\"\x83\x02\n" +
+	"\x12OpenAuctionRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x1f\n" +
+	"\vstart_price\x18\x02 \x01(\x04R\n" +
+	"startPrice\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12#\n" +
+	"\rreserve_price\x18\x05 \x01(\x04R\freservePrice\x12'\n" +
+	"\x0freserve_percent\x18\x06 \x01(\x04R\x0ereservePercent\"#\n" +
+	"\x11GetAuctionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
+	"\x13ListAuctionsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"D\n" +
+	"\x14ListAuctionsResponse\x12,\n" +
+	"\bauctions\x18\x01 \x03(\v2\x10.auction.AuctionR\bauctions\"`\n" +
+	"\x0fPlaceBidRequest\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x01 \x01(\tR\tauctionId\x12\x16\n" +
+	"\x06bidder\x18\x02 \x01(\tR\x06bidder\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x04R\x06amount\"^\n" +
+	"\x10PlaceBidResponse\x12\x1e\n" +
+	"\x03bid\x18\x01 \x01(\v2\f.auction.BidR\x03bid\x12*\n" +
+	"\aauction\x18\x02 \x01(\v2\x10.auction.AuctionR\aauction\"c\n" +
+	"\x12PlaceMaxBidRequest\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x01 \x01(\tR\tauctionId\x12\x16\n" +
+	"\x06bidder\x18\x02 \x01(\tR\x06bidder\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x04R\x06amount\"c\n" +
+	"\x13PlaceMaxBidResponse\x12 \n" +
+	"\x04bids\x18\x01 \x03(\v2\f.auction.BidR\x04bids\x12*\n" +
+	"\aauction\x18\x02 \x01(\v2\x10.auction.AuctionR\aauction\"0\n" +
+	"\x0fListBidsRequest\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x01 \x01(\tR\tauctionId\"4\n" +
+	"\x10ListBidsResponse\x12 \n" +
+	"\x04bids\x18\x01 \x03(\v2\f.auction.BidR\x04bids\"L\n" +
+	"\x13CounterOfferRequest\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x01 \x01(\tR\tauctionId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"K\n" +
+	"\x14BuyerResponseRequest\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x01 \x01(\tR\tauctionId\x12\x14\n" +
+	"\x05buyer\x18\x02 \x01(\tR\x05buyer2\xf2\x04\n" +
+	"\x0eAuctionService\x12<\n" +
+	"\vOpenAuction\x12\x1b.auction.OpenAuctionRequest\x1a\x10.auction.Auction\x12:\n" +
+	"\n" +
+	"GetAuction\x12\x1a.auction.GetAuctionRequest\x1a\x10.auction.Auction\x12K\n" +
+	"\fListAuctions\x12\x1c.auction.ListAuctionsRequest\x1a\x1d.auction.ListAuctionsResponse\x12?\n" +
+	"\bPlaceBid\x12\x18.auction.PlaceBidRequest\x1a\x19.auction.PlaceBidResponse\x12H\n" +
+	"\vPlaceMaxBid\x12\x1b.auction.PlaceMaxBidRequest\x1a\x1c.auction.PlaceMaxBidResponse\x12?\n" +
+	"\bListBids\x12\x18.auction.ListBidsRequest\x1a\x19.auction.ListBidsResponse\x12>\n" +
+	"\fCounterOffer\x12\x1c.auction.CounterOfferRequest\x1a\x10.auction.Auction\x12E\n" +
+	"\x12AcceptCounterOffer\x12\x1d.auction.BuyerResponseRequest\x1a\x10.auction.Auction\x12F\n" +
+	"\x13DeclineCounterOffer\x12\x1d.auction.BuyerResponseRequest\x1a\x10.auction.AuctionBSZQgithub.com/alechekz/online-car-auction/services/auction/delivery/grpc/proto:protob\x06proto3"
+
+var (
+	file_auction_proto_rawDescOnce sync.Once
+	file_auction_proto_rawDescData []byte
+)
+
+func file_auction_proto_rawDescGZIP() []byte {
+	file_auction_proto_rawDescOnce.Do(func() {
+		file_auction_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auction_proto_rawDesc), len(file_auction_proto_rawDesc)))
+	})
+	return file_auction_proto_rawDescData
+}
+
+var file_auction_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_auction_proto_goTypes = []any{
+	(*Auction)(nil),               // 0: auction.Auction
+	(*SoftClose)(nil),             // 1: auction.SoftClose
+	(*Bid)(nil),                   // 2: auction.Bid
+	(*OpenAuctionRequest)(nil),    // 3: auction.OpenAuctionRequest
+	(*GetAuctionRequest)(nil),     // 4: auction.GetAuctionRequest
+	(*ListAuctionsRequest)(nil),   // 5: auction.ListAuctionsRequest
+	(*ListAuctionsResponse)(nil),  // 6: auction.ListAuctionsResponse
+	(*PlaceBidRequest)(nil),       // 7: auction.PlaceBidRequest
+	(*PlaceBidResponse)(nil),      // 8: auction.PlaceBidResponse
+	(*PlaceMaxBidRequest)(nil),    // 9: auction.PlaceMaxBidRequest
+	(*PlaceMaxBidResponse)(nil),   // 10: auction.PlaceMaxBidResponse
+	(*ListBidsRequest)(nil),       // 11: auction.ListBidsRequest
+	(*ListBidsResponse)(nil),      // 12: auction.ListBidsResponse
+	(*CounterOfferRequest)(nil),   // 13: auction.CounterOfferRequest
+	(*BuyerResponseRequest)(nil),  // 14: auction.BuyerResponseRequest
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 16: google.protobuf.Duration
+}
+var file_auction_proto_depIdxs = []int32{
+	15, // 0: auction.Auction.starts_at:type_name -> google.protobuf.Timestamp
+	15, // 1: auction.Auction.ends_at:type_name -> google.protobuf.Timestamp
+	15, // 2: auction.Auction.closed_at:type_name -> google.protobuf.Timestamp
+	15, // 3: auction.Auction.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: auction.Auction.soft_close:type_name -> auction.SoftClose
+	15, // 5: auction.Auction.if_sale_ends_at:type_name -> google.protobuf.Timestamp
+	16, // 6: auction.SoftClose.window:type_name -> google.protobuf.Duration
+	16, // 7: auction.SoftClose.extension:type_name -> google.protobuf.Duration
+	15, // 8: auction.Bid.created_at:type_name -> google.protobuf.Timestamp
+	15, // 9: auction.OpenAuctionRequest.starts_at:type_name -> google.protobuf.Timestamp
+	15, // 10: auction.OpenAuctionRequest.ends_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: auction.ListAuctionsResponse.auctions:type_name -> auction.Auction
+	2,  // 12: auction.PlaceBidResponse.bid:type_name -> auction.Bid
+	0,  // 13: auction.PlaceBidResponse.auction:type_name -> auction.Auction
+	2,  // 14: auction.PlaceMaxBidResponse.bids:type_name -> auction.Bid
+	0,  // 15: auction.PlaceMaxBidResponse.auction:type_name -> auction.Auction
+	2,  // 16: auction.ListBidsResponse.bids:type_name -> auction.Bid
+	3,  // 17: auction.AuctionService.OpenAuction:input_type -> auction.OpenAuctionRequest
+	4,  // 18: auction.AuctionService.GetAuction:input_type -> auction.GetAuctionRequest
+	5,  // 19: auction.AuctionService.ListAuctions:input_type -> auction.ListAuctionsRequest
+	7,  // 20: auction.AuctionService.PlaceBid:input_type -> auction.PlaceBidRequest
+	9,  // 21: auction.AuctionService.PlaceMaxBid:input_type -> auction.PlaceMaxBidRequest
+	11, // 22: auction.AuctionService.ListBids:input_type -> auction.ListBidsRequest
+	13, // 23: auction.AuctionService.CounterOffer:input_type -> auction.CounterOfferRequest
+	14, // 24: auction.AuctionService.AcceptCounterOffer:input_type -> auction.BuyerResponseRequest
+	14, // 25: auction.AuctionService.DeclineCounterOffer:input_type -> auction.BuyerResponseRequest
+	0,  // 26: auction.AuctionService.OpenAuction:output_type -> auction.Auction
+	0,  // 27: auction.AuctionService.GetAuction:output_type -> auction.Auction
+	6,  // 28: auction.AuctionService.ListAuctions:output_type -> auction.ListAuctionsResponse
+	8,  // 29: auction.AuctionService.PlaceBid:output_type -> auction.PlaceBidResponse
+	10, // 30: auction.AuctionService.PlaceMaxBid:output_type -> auction.PlaceMaxBidResponse
+	12, // 31: auction.AuctionService.ListBids:output_type -> auction.ListBidsResponse
+	0,  // 32: auction.AuctionService.CounterOffer:output_type -> auction.Auction
+	0,  // 33: auction.AuctionService.AcceptCounterOffer:output_type -> auction.Auction
+	0,  // 34: auction.AuctionService.DeclineCounterOffer:output_type -> auction.Auction
+	26, // [26:35] is the sub-list for method output_type
+	17, // [17:26] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_auction_proto_init() }
+func file_auction_proto_init() {
+	if File_auction_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auction_proto_rawDesc), len(file_auction_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auction_proto_goTypes,
+		DependencyIndexes: file_auction_proto_depIdxs,
+		MessageInfos:      file_auction_proto_msgTypes,
+	}.Build()
+	File_auction_proto = out.File
+	file_auction_proto_goTypes = nil
+	file_auction_proto_depIdxs = nil
+}
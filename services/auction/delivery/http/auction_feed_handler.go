@@ -0,0 +1,146 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// Feed connection timings
+const (
+	feedWriteTimeout = 10 * time.Second
+	feedHeartbeat    = 15 * time.Second
+)
+
+// upgrader upgrades feed requests to WebSocket connections, the feed is public and read-only
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// lastEventID returns the ID of the last event the client has seen, from the SSE header or the query
+func lastEventID(r *http.Request) (int64, error) {
+	s := r.Header.Get("Last-Event-ID")
+	if s == "" {
+		s = r.URL.Query().Get("last_event_id")
+	}
+	if s == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id < 0 {
+		return 0, domain.ErrValidation
+	}
+	return id, nil
+}
+
+// GET /vehicles/{vin}/feed, served over WebSocket on upgrade requests and as Server-Sent Events otherwise
+func (h *AuctionHandler) WatchVehicle(w http.ResponseWriter, r *http.Request) {
+	vin := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/vehicles/"), "/feed")
+	lastID, err := lastEventID(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	events, err := h.UC.Watch(r.Context(), vin, lastID)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	if websocket.IsWebSocketUpgrade(r) {
+		h.serveWebSocket(w, r, events)
+		return
+	}
+	h.serveSSE(w, r, events)
+}
+
+// serveSSE streams the events as Server-Sent Events until the client leaves or falls behind,
+// browsers reconnect on their own and resume from the Last-Event-ID header
+func (h *AuctionHandler) serveSSE(w http.ResponseWriter, r *http.Request, events <-chan *domain.AuctionEvent) {
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	_ = rc.Flush()
+
+	// A write blocked by a slow client fails after the timeout and drops the client
+	write := func(s string) bool {
+		_ = rc.SetWriteDeadline(time.Now().Add(feedWriteTimeout))
+		if _, err := fmt.Fprint(w, s); err != nil {
+			return false
+		}
+		return rc.Flush() == nil
+	}
+	heartbeat := time.NewTicker(feedHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if !write(": heartbeat\n\n") {
+				return
+			}
+		case e, ok := <-events:
+			if !ok {
+				return // fell behind, the client resumes after reconnecting
+			}
+			data, _ := json.Marshal(e)
+			if !write(fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)) {
+				return
+			}
+		}
+	}
+}
+
+// serveWebSocket streams the events as WebSocket text messages until the client leaves or falls behind,
+// clients resume by reconnecting with the last_event_id query parameter
+func (h *AuctionHandler) serveWebSocket(w http.ResponseWriter, r *http.Request, events <-chan *domain.AuctionEvent) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // the upgrader has already replied
+	}
+	defer conn.Close() // nolint:errcheck
+
+	// Read until the client goes away, the feed ignores client messages
+	gone := make(chan struct{})
+	go func() {
+		defer close(gone)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	// A write blocked by a slow client fails after the timeout and drops the client
+	heartbeat := time.NewTicker(feedHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-gone:
+			return
+		case <-heartbeat.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(feedWriteTimeout)); err != nil {
+				return
+			}
+		case e, ok := <-events:
+			if !ok {
+				msg := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "fell behind, resume from the last event")
+				_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(feedWriteTimeout))
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(feedWriteTimeout))
+			if err := conn.WriteJSON(e); err != nil {
+				return
+			}
+		}
+	}
+}
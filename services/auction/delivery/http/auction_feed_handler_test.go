@@ -0,0 +1,113 @@
+package http_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// readSSE reads the next Server-Sent Event from the stream
+func readSSE(t *testing.T, r *bufio.Reader) (id, typ string, e domain.AuctionEvent) {
+	for {
+		line, err := r.ReadString('\n')
+		assert.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "" && id != "":
+			return id, typ, e
+		case strings.HasPrefix(line, "id: "):
+			id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			typ = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			assert.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &e))
+		}
+	}
+}
+
+// TestAuctionHandler_WatchVehicle tests the live auction feed over Server-Sent Events and WebSocket
+func TestAuctionHandler_WatchVehicle(t *testing.T) {
+	srv := httptest.NewServer(NewTestRouter())
+	defer srv.Close()
+	router := srv.Config.Handler
+	vin := "1HGCM82633A123456"
+	feed := srv.URL + "/vehicles/" + vin + "/feed"
+
+	t.Run("not on sale", func(t *testing.T) {
+		rec := serve(router, http.MethodGet, "/vehicles/"+vin+"/feed", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = serve(router, http.MethodGet, "/vehicles/"+vin+"/feed?last_event_id=x", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	rec := serve(router, http.MethodPost, "/auctions", `{"vin":"`+vin+`","duration":"1h"}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var a domain.Auction
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&a))
+
+	var first int64
+
+	t.Run("sse", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, feed, nil)
+		res, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer res.Body.Close() // nolint:errcheck
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+		rec := serve(router, http.MethodPost, "/auctions/"+a.ID+"/bids", `{"bidder":"alice","amount":12500}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		body := bufio.NewReader(res.Body)
+		id, typ, e := readSSE(t, body)
+		assert.Equal(t, strconv.FormatInt(e.ID, 10), id)
+		assert.Equal(t, domain.EventBidPlaced, typ)
+		assert.Equal(t, "alice", e.Bidder)
+		first = e.ID
+		id, typ, e = readSSE(t, body)
+		assert.Equal(t, strconv.FormatInt(first+1, 10), id)
+		assert.Equal(t, domain.EventPriceChanged, typ)
+		assert.Equal(t, uint64(12_500), e.HighBid)
+	})
+
+	t.Run("sse resume", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, feed, nil)
+		req.Header.Set("Last-Event-ID", strconv.FormatInt(first, 10))
+		res, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer res.Body.Close() // nolint:errcheck
+		id, typ, _ := readSSE(t, bufio.NewReader(res.Body))
+		assert.Equal(t, strconv.FormatInt(first+1, 10), id)
+		assert.Equal(t, domain.EventPriceChanged, typ)
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		url := "ws" + strings.TrimPrefix(feed, "http") + "?last_event_id=" + strconv.FormatInt(first, 10)
+		conn, _, err := websocket.DefaultDialer.DialContext(t.Context(), url, nil)
+		assert.NoError(t, err)
+		defer conn.Close() // nolint:errcheck
+
+		var e domain.AuctionEvent
+		assert.NoError(t, conn.ReadJSON(&e))
+		assert.Equal(t, first+1, e.ID)
+		assert.Equal(t, domain.EventPriceChanged, e.Type)
+
+		rec := serve(router, http.MethodPost, "/auctions/"+a.ID+"/bids", `{"bidder":"bob","amount":12800}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.NoError(t, conn.ReadJSON(&e))
+		assert.Equal(t, domain.EventBidPlaced, e.Type)
+		assert.Equal(t, "bob", e.Bidder)
+	})
+}
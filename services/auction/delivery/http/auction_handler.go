@@ -0,0 +1,144 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// AuctionHandler handles HTTP requests for auction operations
+type AuctionHandler struct {
+	UC usecase.AuctionUsecase
+}
+
+// openAuctionRequest is the body of an auction opening, the end is given by ends_at or duration,
+// the reserve by an absolute reserve_price or a reserve_percent of the recommended price
+type openAuctionRequest struct {
+	VIN            string    `json:"vin"`
+	StartPrice     uint64    `json:"start_price"`
+	ReservePrice   uint64    `json:"reserve_price"`
+	ReservePercent uint64    `json:"reserve_percent"`
+	StartsAt       time.Time `json:"starts_at"`
+	EndsAt         time.Time `json:"ends_at"`
+	Duration       string    `json:"duration"`
+}
+
+// endsAt resolves the end time of the auction
+func (req *openAuctionRequest) endsAt() (time.Time, error) {
+	if req.Duration == "" {
+		return req.EndsAt, nil
+	}
+	d, err := time.ParseDuration(req.Duration)
+	if err != nil || !req.EndsAt.IsZero() {
+		return time.Time{}, domain.ErrValidation
+	}
+	start := req.StartsAt
+	if start.IsZero() {
+		start = time.Now()
+	}
+	return start.Add(d), nil
+}
+
+// auctionID returns the auction ID of the request path
+func auctionID(r *http.Request) string {
+	id, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/auctions/"), "/")
+	return id
+}
+
+// POST /auctions
+func (h *AuctionHandler) OpenAuction(w http.ResponseWriter, r *http.Request) {
+	var req openAuctionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	endsAt, err := req.endsAt()
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	reserve := domain.Reserve{Price: req.ReservePrice, Percent: req.ReservePercent}
+	a, err := h.UC.Open(r.Context(), req.VIN, req.StartPrice, reserve, req.StartsAt, endsAt)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/auctions/"+a.ID)
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(a)
+}
+
+// GET /auctions?status=open|if_sale|closed
+func (h *AuctionHandler) ListAuctions(w http.ResponseWriter, r *http.Request) {
+	auctions, err := h.UC.List(r.Context(), r.URL.Query().Get("status"))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(auctions)
+}
+
+// GET /auctions/{id}
+func (h *AuctionHandler) GetAuction(w http.ResponseWriter, r *http.Request) {
+	a, err := h.UC.Get(r.Context(), auctionID(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(a)
+}
+
+// POST /auctions/{id}/bids
+func (h *AuctionHandler) PlaceBid(w http.ResponseWriter, r *http.Request) {
+	var b domain.Bid
+	if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	a, err := h.UC.PlaceBid(r.Context(), auctionID(r), &b)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]any{"bid": b, "auction": a})
+}
+
+// POST /auctions/{id}/max-bids, the maximum stays hidden from other bidders
+func (h *AuctionHandler) PlaceMaxBid(w http.ResponseWriter, r *http.Request) {
+	var m domain.MaxBid
+	if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	a, bids, err := h.UC.PlaceMaxBid(r.Context(), auctionID(r), &m)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	if bids == nil {
+		bids = []*domain.Bid{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]any{"max_bid": m, "bids": bids, "auction": a})
+}
+
+// GET /auctions/{id}/bids
+func (h *AuctionHandler) ListBids(w http.ResponseWriter, r *http.Request) {
+	bids, err := h.UC.Bids(r.Context(), auctionID(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(bids)
+}
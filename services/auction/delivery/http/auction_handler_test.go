@@ -0,0 +1,140 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	auctionhttp "github.com/alechekz/online-car-auction/services/auction/delivery/http"
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/internal/logger"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// TestMain sets up the testing environment
+func TestMain(m *testing.M) {
+	logger.Init()
+	os.Exit(m.Run())
+}
+
+// NewTestRouter creates a test HTTP router with in-memory dependencies
+func NewTestRouter() http.Handler {
+	repo := infrastructure.NewMemoryAuctionRepo()
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	sales := infrastructure.NewMemoryActiveSaleRepo()
+	uc := usecase.NewAuctionUC(repo, sales, vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), infrastructure.SystemClock{}, domain.SoftClose{})
+	tenders := usecase.NewTenderUC(infrastructure.NewMemoryTenderRepo(), sales, vehicleProvider, infrastructure.SystemClock{})
+	clocks := usecase.NewDutchUC(infrastructure.NewMemoryDutchClockRepo(), sales, vehicleProvider, infrastructure.SystemClock{}, 120, 60)
+	return auctionhttp.NewRouter(&auctionhttp.AuctionHandler{UC: uc}, &auctionhttp.TenderHandler{UC: tenders}, &auctionhttp.DutchHandler{UC: clocks})
+}
+
+// serve sends the request with a JSON body to the router
+func serve(router http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+// TestAuctionHandler tests the auction HTTP handlers
+func TestAuctionHandler(t *testing.T) {
+	router := NewTestRouter()
+	var a domain.Auction
+
+	t.Run("open auction", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions", `{"vin":"1HGCM82633A123456","duration":"1h"}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&a))
+		assert.Equal(t, "/auctions/"+a.ID, rec.Header().Get("Location"))
+		assert.Equal(t, uint64(12_500), a.StartPrice)
+	})
+
+	t.Run("open invalid auction", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions", `{"vin":"1HGCM82633A000001","duration":"soon"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = serve(router, http.MethodPost, "/auctions", `{"vin":"1HGCM82633A123456","duration":"1h"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("place bid", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions/"+a.ID+"/bids", `{"bidder":"alice","amount":12500}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var res struct {
+			Bid     domain.Bid     `json:"bid"`
+			Auction domain.Auction `json:"auction"`
+		}
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+		assert.Equal(t, int64(1), res.Bid.ID)
+		assert.Equal(t, "alice", res.Auction.Leader)
+	})
+
+	t.Run("bid too low", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions/"+a.ID+"/bids", `{"bidder":"bob","amount":12600}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, float64(12_800), body["min_bid"])
+	})
+
+	t.Run("get auction", func(t *testing.T) {
+		rec := serve(router, http.MethodGet, "/auctions/"+a.ID, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		rec = serve(router, http.MethodGet, "/auctions/unknown", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		rec := serve(router, http.MethodGet, "/auctions?status=open", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var auctions []*domain.Auction
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&auctions))
+		assert.Len(t, auctions, 1)
+
+		rec = serve(router, http.MethodGet, "/auctions/"+a.ID+"/bids", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var bids []*domain.Bid
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&bids))
+		assert.Len(t, bids, 1)
+
+		rec = serve(router, http.MethodGet, "/auctions?status=unknown", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("place max bid", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions/"+a.ID+"/max-bids", `{"bidder":"bob","amount":20000}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var res struct {
+			Bids    []*domain.Bid  `json:"bids"`
+			Auction map[string]any `json:"auction"`
+		}
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+		assert.Len(t, res.Bids, 1)
+		assert.True(t, res.Bids[0].Auto)
+		assert.Equal(t, "bob", res.Auction["leader"])
+		assert.NotContains(t, res.Auction, "leader_max")
+
+		// The hidden maximum counters a lower bid
+		rec = serve(router, http.MethodPost, "/auctions/"+a.ID+"/bids", `{"bidder":"alice","amount":13100}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		rec = serve(router, http.MethodGet, "/auctions/"+a.ID, "")
+		var got domain.Auction
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+		assert.Equal(t, "bob", got.Leader)
+		assert.Equal(t, uint64(13_400), got.HighBid)
+
+		rec = serve(router, http.MethodGet, "/auctions/"+a.ID+"/max-bids", "")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		rec := serve(router, http.MethodDelete, "/auctions/"+a.ID, "")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
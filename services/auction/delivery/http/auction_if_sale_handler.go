@@ -0,0 +1,61 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// counterOfferRequest is the body of a seller's counter offer
+type counterOfferRequest struct {
+	Amount uint64 `json:"amount"`
+}
+
+// buyerResponseRequest is the body of the leading buyer's response to the counter offer
+type buyerResponseRequest struct {
+	Buyer string `json:"buyer"`
+}
+
+// POST /auctions/{id}/counter-offer
+func (h *AuctionHandler) CounterOffer(w http.ResponseWriter, r *http.Request) {
+	var req counterOfferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	a, err := h.UC.CounterOffer(r.Context(), auctionID(r), req.Amount)
+	writeAuction(w, a, err)
+}
+
+// POST /auctions/{id}/accept
+func (h *AuctionHandler) AcceptCounterOffer(w http.ResponseWriter, r *http.Request) {
+	h.respondToCounterOffer(w, r, h.UC.AcceptCounterOffer)
+}
+
+// POST /auctions/{id}/decline
+func (h *AuctionHandler) DeclineCounterOffer(w http.ResponseWriter, r *http.Request) {
+	h.respondToCounterOffer(w, r, h.UC.DeclineCounterOffer)
+}
+
+// respondToCounterOffer applies the buyer's response to the counter offer
+func (h *AuctionHandler) respondToCounterOffer(w http.ResponseWriter, r *http.Request, respond func(ctx context.Context, id, buyer string) (*domain.Auction, error)) {
+	var req buyerResponseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	a, err := respond(r.Context(), auctionID(r), req.Buyer)
+	writeAuction(w, a, err)
+}
+
+// writeAuction writes the changed auction or the error of the change
+func writeAuction(w http.ResponseWriter, a *domain.Auction, err error) {
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(a)
+}
@@ -0,0 +1,91 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	auctionhttp "github.com/alechekz/online-car-auction/services/auction/delivery/http"
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// TestAuctionHandler_IfSale tests the reserve price and the if-sale negotiation handlers
+func TestAuctionHandler_IfSale(t *testing.T) {
+	clock := infrastructure.NewMockClock(time.Now())
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	sales := infrastructure.NewMemoryActiveSaleRepo()
+	uc := usecase.NewAuctionUC(infrastructure.NewMemoryAuctionRepo(), sales, vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), clock, domain.SoftClose{})
+	tenders := usecase.NewTenderUC(infrastructure.NewMemoryTenderRepo(), sales, vehicleProvider, clock)
+	clocks := usecase.NewDutchUC(infrastructure.NewMemoryDutchClockRepo(), sales, vehicleProvider, clock, 120, 60)
+	router := auctionhttp.NewRouter(&auctionhttp.AuctionHandler{UC: uc}, &auctionhttp.TenderHandler{UC: tenders}, &auctionhttp.DutchHandler{UC: clocks})
+	var id string
+
+	t.Run("open with reserve", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions", `{"vin":"1HGCM82633A123456","duration":"1h","reserve_price":20000,"reserve_percent":80}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serve(router, http.MethodPost, "/auctions", `{"vin":"1HGCM82633A123456","duration":"1h","reserve_percent":80}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, false, body["reserve_met"])
+		assert.NotContains(t, body, "reserve_price")
+		id = body["id"].(string)
+	})
+
+	t.Run("counter offer before closing", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions/"+id+"/counter-offer", `{"amount":18000}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("closed below reserve", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions/"+id+"/bids", `{"bidder":"alice","amount":15000}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		clock.Advance(time.Hour + time.Minute) // past the end computed from the system time
+
+		rec = serve(router, http.MethodGet, "/auctions/"+id, "")
+		var a domain.Auction
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&a))
+		assert.Equal(t, domain.AuctionIfSale, a.Status)
+	})
+
+	t.Run("accept without counter offer", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions/"+id+"/accept", `{"buyer":"alice"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("counter offer", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions/"+id+"/counter-offer", `{"amount":14000}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = serve(router, http.MethodPost, "/auctions/"+id+"/counter-offer", `{"amount":18000}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var a domain.Auction
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&a))
+		assert.Equal(t, uint64(18_000), a.CounterOffer)
+	})
+
+	t.Run("decline by another buyer", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions/"+id+"/decline", `{"buyer":"bob"}`)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/auctions/"+id+"/accept", `{"buyer":"alice"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var a domain.Auction
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&a))
+		assert.Equal(t, domain.AuctionClosed, a.Status)
+		assert.Equal(t, "alice", a.Winner)
+		assert.Equal(t, uint64(18_000), a.SalePrice)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		rec := serve(router, http.MethodGet, "/auctions/"+id+"/accept", "")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
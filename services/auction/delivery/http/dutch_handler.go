@@ -0,0 +1,87 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// DutchHandler handles HTTP requests for Dutch clock operations
+type DutchHandler struct {
+	UC usecase.DutchUsecase
+}
+
+// openClockRequest is the body of a Dutch clock opening, zero values fall back to the defaults
+type openClockRequest struct {
+	VIN         string    `json:"vin"`
+	FloorPrice  uint64    `json:"floor_price"`
+	Step        uint64    `json:"step"`
+	StepSeconds int64     `json:"step_seconds"`
+	StartsAt    time.Time `json:"starts_at"`
+}
+
+// clockID returns the clock ID of the request path
+func clockID(r *http.Request) string {
+	id, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/dutch-clocks/"), "/")
+	return id
+}
+
+// writeClock writes the clock or the error of the operation
+func writeClock(w http.ResponseWriter, c *domain.DutchClock, err error) {
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(c)
+}
+
+// POST /dutch-clocks
+func (h *DutchHandler) OpenClock(w http.ResponseWriter, r *http.Request) {
+	var req openClockRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	c, err := h.UC.Open(r.Context(), req.VIN, req.FloorPrice, req.Step, req.StepSeconds, req.StartsAt)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/dutch-clocks/"+c.ID)
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(c)
+}
+
+// GET /dutch-clocks?status=running|sold|expired
+func (h *DutchHandler) ListClocks(w http.ResponseWriter, r *http.Request) {
+	clocks, err := h.UC.List(r.Context(), r.URL.Query().Get("status"))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(clocks)
+}
+
+// GET /dutch-clocks/{id}
+func (h *DutchHandler) GetClock(w http.ResponseWriter, r *http.Request) {
+	c, err := h.UC.Get(r.Context(), clockID(r))
+	writeClock(w, c, err)
+}
+
+// POST /dutch-clocks/{id}/accept
+func (h *DutchHandler) AcceptClock(w http.ResponseWriter, r *http.Request) {
+	var req buyerResponseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	c, err := h.UC.Accept(r.Context(), clockID(r), req.Buyer)
+	writeClock(w, c, err)
+}
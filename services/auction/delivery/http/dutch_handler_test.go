@@ -0,0 +1,74 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	auctionhttp "github.com/alechekz/online-car-auction/services/auction/delivery/http"
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// TestDutchHandler tests the Dutch clock handlers
+func TestDutchHandler(t *testing.T) {
+	clock := infrastructure.NewMockClock(time.Now())
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	sales := infrastructure.NewMemoryActiveSaleRepo()
+	uc := usecase.NewAuctionUC(infrastructure.NewMemoryAuctionRepo(), sales, vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), clock, domain.SoftClose{})
+	tenders := usecase.NewTenderUC(infrastructure.NewMemoryTenderRepo(), sales, vehicleProvider, clock)
+	clocks := usecase.NewDutchUC(infrastructure.NewMemoryDutchClockRepo(), sales, vehicleProvider, clock, 120, 60)
+	router := auctionhttp.NewRouter(&auctionhttp.AuctionHandler{UC: uc}, &auctionhttp.TenderHandler{UC: tenders}, &auctionhttp.DutchHandler{UC: clocks})
+	var id string
+
+	t.Run("open", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/dutch-clocks", `{"vin":"1HGCM82633A123456","floor_price":40000}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serve(router, http.MethodPost, "/dutch-clocks", `{"vin":"1HGCM82633A123456","floor_price":20000,"step":1000,"step_seconds":30}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var c domain.DutchClock
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&c))
+		id = c.ID
+		assert.Equal(t, uint64(30_000), c.Price)
+		assert.Equal(t, "/dutch-clocks/"+id, rec.Header().Get("Location"))
+	})
+
+	t.Run("price drops", func(t *testing.T) {
+		clock.Advance(time.Minute)
+		rec := serve(router, http.MethodGet, "/dutch-clocks/"+id, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var c domain.DutchClock
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&c))
+		assert.Equal(t, uint64(28_000), c.Price)
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/dutch-clocks/"+id+"/accept", `{"buyer":"alice"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var c domain.DutchClock
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&c))
+		assert.Equal(t, domain.ClockSold, c.Status)
+		assert.Equal(t, uint64(28_000), c.SalePrice)
+
+		rec = serve(router, http.MethodPost, "/dutch-clocks/"+id+"/accept", `{"buyer":"bob"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("list and routing", func(t *testing.T) {
+		rec := serve(router, http.MethodGet, "/dutch-clocks?status=sold", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var list []*domain.DutchClock
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+		assert.Len(t, list, 1)
+
+		rec = serve(router, http.MethodGet, "/dutch-clocks/missing", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = serve(router, http.MethodGet, "/dutch-clocks/"+id+"/accept", "")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
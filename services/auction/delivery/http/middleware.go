@@ -0,0 +1,68 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/internal/logger"
+)
+
+// LoggingMiddleware logs details about each HTTP request
+func LoggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		next.ServeHTTP(w, r)
+		duration := time.Since(start)
+
+		logger.Log.Info("HTTP request",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Duration("duration", duration),
+		)
+	})
+}
+
+// WriteError writes an error response based on the error type
+func WriteError(w http.ResponseWriter, err error) {
+
+	// Default to internal server error
+	status := http.StatusInternalServerError
+	msg := "internal server error"
+
+	// Determine specific error type
+	switch {
+	case errors.Is(err, domain.ErrValidation):
+		status = http.StatusBadRequest
+		msg = err.Error()
+	case errors.Is(err, domain.ErrNotFound), errors.Is(err, domain.ErrTenderNotFound), errors.Is(err, domain.ErrClockNotFound):
+		status = http.StatusNotFound
+		msg = err.Error()
+	case errors.Is(err, domain.ErrVehicleNotFound), errors.Is(err, domain.ErrBidTooLow):
+		status = http.StatusUnprocessableEntity
+		msg = err.Error()
+	case errors.Is(err, domain.ErrNotBuyer):
+		status = http.StatusForbidden
+		msg = err.Error()
+	case errors.Is(err, domain.ErrAuctionExists), errors.Is(err, domain.ErrAuctionNotOpen), errors.Is(err, domain.ErrVersionConflict),
+		errors.Is(err, domain.ErrNotNegotiating), errors.Is(err, domain.ErrNoCounterOffer),
+		errors.Is(err, domain.ErrTenderExists), errors.Is(err, domain.ErrTenderNotOpen), errors.Is(err, domain.ErrAlreadyBid),
+		errors.Is(err, domain.ErrTenderSealed), errors.Is(err, domain.ErrClockExists), errors.Is(err, domain.ErrClockNotRunning),
+		errors.Is(err, domain.ErrSaleExists), errors.Is(err, domain.ErrNotForSale):
+		status = http.StatusConflict
+		msg = err.Error()
+	}
+	body := map[string]any{"error": msg}
+	var tooLow *domain.BidTooLowError
+	if errors.As(err, &tooLow) {
+		body["min_bid"] = tooLow.MinBid
+	}
+
+	// Write response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
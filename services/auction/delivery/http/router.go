@@ -0,0 +1,148 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+)
+
+// healthHandler provides a simple health check endpoint
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("OK"))
+}
+
+// regAuctionRoutes registers auction routes
+func regAuctionRoutes(mux *http.ServeMux, handler *AuctionHandler) {
+
+	// /auctions (POST, GET)
+	mux.HandleFunc("/auctions", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			handler.OpenAuction(w, r)
+		case http.MethodGet:
+			handler.ListAuctions(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// /auctions/{id} (GET), /auctions/{id}/bids (POST, GET), /auctions/{id}/max-bids (POST),
+	// /auctions/{id}/counter-offer, /auctions/{id}/accept, /auctions/{id}/decline (POST)
+	mux.HandleFunc("/auctions/", func(w http.ResponseWriter, r *http.Request) {
+		_, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/auctions/"), "/")
+		post := r.Method == http.MethodPost
+		switch {
+		case action == "bids" && post:
+			handler.PlaceBid(w, r)
+		case action == "bids" && r.Method == http.MethodGet:
+			handler.ListBids(w, r)
+		case action == "max-bids" && post:
+			handler.PlaceMaxBid(w, r)
+		case action == "counter-offer" && post:
+			handler.CounterOffer(w, r)
+		case action == "accept" && post:
+			handler.AcceptCounterOffer(w, r)
+		case action == "decline" && post:
+			handler.DeclineCounterOffer(w, r)
+		case action == "" && r.Method == http.MethodGet:
+			handler.GetAuction(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// regTenderRoutes registers sealed-bid tender routes
+func regTenderRoutes(mux *http.ServeMux, handler *TenderHandler) {
+
+	// /tenders (POST, GET)
+	mux.HandleFunc("/tenders", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			handler.OpenTender(w, r)
+		case http.MethodGet:
+			handler.ListTenders(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// /tenders/{id} (GET), /tenders/{id}/bids (POST), /tenders/{id}/results (GET)
+	mux.HandleFunc("/tenders/", func(w http.ResponseWriter, r *http.Request) {
+		_, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/tenders/"), "/")
+		switch {
+		case action == "bids" && r.Method == http.MethodPost:
+			handler.SubmitBid(w, r)
+		case action == "results" && r.Method == http.MethodGet:
+			handler.GetResults(w, r)
+		case action == "" && r.Method == http.MethodGet:
+			handler.GetTender(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// regDutchRoutes registers Dutch clock routes
+func regDutchRoutes(mux *http.ServeMux, handler *DutchHandler) {
+
+	// /dutch-clocks (POST, GET)
+	mux.HandleFunc("/dutch-clocks", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			handler.OpenClock(w, r)
+		case http.MethodGet:
+			handler.ListClocks(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// /dutch-clocks/{id} (GET), /dutch-clocks/{id}/accept (POST)
+	mux.HandleFunc("/dutch-clocks/", func(w http.ResponseWriter, r *http.Request) {
+		_, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/dutch-clocks/"), "/")
+		switch {
+		case action == "accept" && r.Method == http.MethodPost:
+			handler.AcceptClock(w, r)
+		case action == "" && r.Method == http.MethodGet:
+			handler.GetClock(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// regFeedRoutes registers the live auction feed routes
+func regFeedRoutes(mux *http.ServeMux, handler *AuctionHandler) {
+
+	// /vehicles/{vin}/feed (GET)
+	mux.HandleFunc("/vehicles/", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case !strings.HasSuffix(r.URL.Path, "/feed"):
+			http.NotFound(w, r)
+		case r.Method == http.MethodGet:
+			handler.WatchVehicle(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// NewRouter sets up the HTTP routes for auction, tender and Dutch clock operations
+func NewRouter(handler *AuctionHandler, tenders *TenderHandler, clocks *DutchHandler) http.Handler {
+
+	// Create a new ServeMux
+	mux := http.NewServeMux()
+
+	// Health check endpoint
+	mux.HandleFunc("/health", healthHandler)
+
+	// Register auction routes
+	regAuctionRoutes(mux, handler)
+	regTenderRoutes(mux, tenders)
+	regDutchRoutes(mux, clocks)
+	regFeedRoutes(mux, handler)
+
+	// Wrap with logging middleware and exit
+	return LoggingMiddleware(mux)
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// TenderHandler handles HTTP requests for sealed-bid tender operations
+type TenderHandler struct {
+	UC usecase.TenderUsecase
+}
+
+// openTenderRequest is the body of a tender opening, the end is given by ends_at or duration
+type openTenderRequest struct {
+	VIN        string    `json:"vin"`
+	Settlement string    `json:"settlement"`
+	MinPrice   uint64    `json:"min_price"`
+	StartsAt   time.Time `json:"starts_at"`
+	EndsAt     time.Time `json:"ends_at"`
+	Duration   string    `json:"duration"`
+}
+
+// tenderID returns the tender ID of the request path
+func tenderID(r *http.Request) string {
+	id, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/tenders/"), "/")
+	return id
+}
+
+// POST /tenders
+func (h *TenderHandler) OpenTender(w http.ResponseWriter, r *http.Request) {
+	var req openTenderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	window := openAuctionRequest{StartsAt: req.StartsAt, EndsAt: req.EndsAt, Duration: req.Duration}
+	endsAt, err := window.endsAt()
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	t, err := h.UC.Open(r.Context(), req.VIN, req.Settlement, req.MinPrice, req.StartsAt, endsAt)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/tenders/"+t.ID)
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(t)
+}
+
+// GET /tenders?status=open|closed
+func (h *TenderHandler) ListTenders(w http.ResponseWriter, r *http.Request) {
+	tenders, err := h.UC.List(r.Context(), r.URL.Query().Get("status"))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(tenders)
+}
+
+// GET /tenders/{id}
+func (h *TenderHandler) GetTender(w http.ResponseWriter, r *http.Request) {
+	t, err := h.UC.Get(r.Context(), tenderID(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(t)
+}
+
+// POST /tenders/{id}/bids, the receipt leaves out the amount that stays sealed until the tender closes
+func (h *TenderHandler) SubmitBid(w http.ResponseWriter, r *http.Request) {
+	var b domain.SealedBid
+	if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	t, err := h.UC.SubmitBid(r.Context(), tenderID(r), &b)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]any{"bid": b.Receipt(), "tender": t})
+}
+
+// GET /tenders/{id}/results
+func (h *TenderHandler) GetResults(w http.ResponseWriter, r *http.Request) {
+	res, err := h.UC.Results(r.Context(), tenderID(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,90 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	auctionhttp "github.com/alechekz/online-car-auction/services/auction/delivery/http"
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// TestTenderHandler tests the sealed-bid tender handlers
+func TestTenderHandler(t *testing.T) {
+	clock := infrastructure.NewMockClock(time.Now())
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	sales := infrastructure.NewMemoryActiveSaleRepo()
+	uc := usecase.NewAuctionUC(infrastructure.NewMemoryAuctionRepo(), sales, vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), clock, domain.SoftClose{})
+	tenders := usecase.NewTenderUC(infrastructure.NewMemoryTenderRepo(), sales, vehicleProvider, clock)
+	clocks := usecase.NewDutchUC(infrastructure.NewMemoryDutchClockRepo(), sales, vehicleProvider, clock, 120, 60)
+	router := auctionhttp.NewRouter(&auctionhttp.AuctionHandler{UC: uc}, &auctionhttp.TenderHandler{UC: tenders}, &auctionhttp.DutchHandler{UC: clocks})
+	var id string
+
+	t.Run("open", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/tenders", `{"vin":"1HGCM82633A123456","duration":"1h","settlement":"dutch"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serve(router, http.MethodPost, "/tenders", `{"vin":"1HGCM82633A123456","duration":"1h","settlement":"second_price"}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var tender domain.Tender
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&tender))
+		id = tender.ID
+		assert.Equal(t, "/tenders/"+id, rec.Header().Get("Location"))
+
+		rec = serve(router, http.MethodPost, "/tenders", `{"vin":"1HGCM82633A123456","duration":"1h"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("submit sealed bids", func(t *testing.T) {
+		rec := serve(router, http.MethodPost, "/tenders/"+id+"/bids", `{"bidder":"alice","amount":21000}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "21000", "the amount stays sealed")
+
+		rec = serve(router, http.MethodPost, "/tenders/"+id+"/bids", `{"bidder":"alice","amount":22000}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		rec = serve(router, http.MethodPost, "/tenders/"+id+"/bids", `{"bidder":"bob","amount":1000}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		rec = serve(router, http.MethodPost, "/tenders/"+id+"/bids", `{"bidder":"bob","amount":23000}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		rec = serve(router, http.MethodGet, "/tenders/"+id, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var tender domain.Tender
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&tender))
+		assert.Equal(t, 2, tender.BidCount)
+	})
+
+	t.Run("results sealed while open", func(t *testing.T) {
+		rec := serve(router, http.MethodGet, "/tenders/"+id+"/results", "")
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("results after close", func(t *testing.T) {
+		clock.Advance(time.Hour + time.Minute) // past the end computed from the system time
+		rec := serve(router, http.MethodGet, "/tenders/"+id+"/results", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var res domain.TenderResult
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+		assert.Equal(t, "bob", res.Tender.Winner)
+		assert.Equal(t, uint64(21_000), res.Tender.SalePrice)
+		assert.Equal(t, []uint64{23_000, 21_000}, []uint64{res.Bids[0].Amount, res.Bids[1].Amount})
+	})
+
+	t.Run("list and routing", func(t *testing.T) {
+		rec := serve(router, http.MethodGet, "/tenders?status=closed", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var list []*domain.Tender
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+		assert.Len(t, list, 1)
+
+		rec = serve(router, http.MethodGet, "/tenders/missing", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = serve(router, http.MethodDelete, "/tenders/"+id, "")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
@@ -0,0 +1,379 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Auction statuses
+const (
+	AuctionOpen   = "open"
+	AuctionIfSale = "if_sale"
+	AuctionClosed = "closed"
+)
+
+// Auction duration limits
+const (
+	MinAuctionDuration = time.Minute
+	MaxAuctionDuration = 30 * 24 * time.Hour
+)
+
+// IfSaleDuration is how long the if-sale negotiation lasts before the counter offer is declined automatically
+const IfSaleDuration = 48 * time.Hour
+
+// Auction represents a timed ascending (English) auction of a vehicle,
+// a sale closing below the hidden reserve price goes to an if-sale negotiation with the leading buyer
+type Auction struct {
+	ID               string     `json:"id"`
+	VIN              string     `json:"vin"`
+	Status           string     `json:"status"`
+	RecommendedPrice uint64     `json:"recommended_price"`
+	StartPrice       uint64     `json:"start_price"`
+	Increment        uint64     `json:"increment"`
+	HighBid          uint64     `json:"high_bid"`
+	Leader           string     `json:"leader,omitempty"`
+	LeaderMax        uint64     `json:"-"`
+	BidCount         int        `json:"bid_count"`
+	ReservePrice     uint64     `json:"-"`
+	ReserveMet       bool       `json:"reserve_met"`
+	CounterOffer     uint64     `json:"counter_offer,omitempty"`
+	Winner           string     `json:"winner,omitempty"`
+	SalePrice        uint64     `json:"sale_price,omitempty"`
+	StartsAt         time.Time  `json:"starts_at"`
+	EndsAt           time.Time  `json:"ends_at"`
+	SoftClose        SoftClose  `json:"soft_close"`
+	Extensions       int        `json:"extensions"`
+	IfSaleEndsAt     *time.Time `json:"if_sale_ends_at,omitempty"`
+	ClosedAt         *time.Time `json:"closed_at,omitempty"`
+	Version          int64      `json:"version"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// Bid represents a single bid placed in an auction
+type Bid struct {
+	ID        int64     `json:"id"`
+	AuctionID string    `json:"auction_id"`
+	Bidder    string    `json:"bidder"`
+	Amount    uint64    `json:"amount"`
+	Auto      bool      `json:"auto,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MaxBid represents the hidden maximum a bidder is willing to pay, the auction bids on their behalf up to it
+type MaxBid struct {
+	AuctionID string    `json:"auction_id"`
+	Bidder    string    `json:"bidder"`
+	Amount    uint64    `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MinIncrement derives the minimum bid increment from the recommended price,
+// one percent of it rounded up to a hundred, but at least a hundred
+func MinIncrement(recommendedPrice uint64) uint64 {
+	increment := (recommendedPrice/100 + 99) / 100 * 100
+	return max(increment, 100)
+}
+
+// NewAuction creates a new open auction of the vehicle, a zero start price defaults to half of the recommended price
+func NewAuction(id, vin string, recommendedPrice, startPrice uint64, startsAt, endsAt time.Time) *Auction {
+	increment := MinIncrement(recommendedPrice)
+	if startPrice == 0 {
+		startPrice = max(recommendedPrice/2, increment)
+	}
+	return &Auction{
+		ID:               id,
+		VIN:              vin,
+		Status:           AuctionOpen,
+		RecommendedPrice: recommendedPrice,
+		StartPrice:       startPrice,
+		Increment:        increment,
+		ReserveMet:       true,
+		StartsAt:         startsAt.UTC(),
+		EndsAt:           endsAt.UTC(),
+		CreatedAt:        time.Now().UTC(),
+	}
+}
+
+// Validate checks if the auction data is valid
+func (a *Auction) Validate() error {
+	return validation.ValidateStruct(
+		a,
+		validation.Field(
+			&a.ID,
+			validation.Required,
+		),
+		validation.Field(
+			&a.VIN,
+			validation.Required,
+			validation.Length(17, 17),
+		),
+		validation.Field(
+			&a.StartPrice,
+			validation.Required,
+		),
+		validation.Field(
+			&a.StartsAt,
+			validation.Required,
+		),
+		validation.Field(
+			&a.EndsAt,
+			validation.Required,
+			validation.By(a.validateDuration),
+		),
+		validation.Field(
+			&a.SoftClose,
+		),
+	)
+}
+
+// validateDuration checks that the auction lasts within the duration limits
+func (a *Auction) validateDuration(any) error {
+	d := a.EndsAt.Sub(a.StartsAt)
+	if d < MinAuctionDuration || d > MaxAuctionDuration {
+		return errors.New("auction must last between " + MinAuctionDuration.String() + " and " + MaxAuctionDuration.String())
+	}
+	return nil
+}
+
+// MinBid returns the lowest amount the next bid must have
+func (a *Auction) MinBid() uint64 {
+	if a.BidCount == 0 {
+		return a.StartPrice
+	}
+	return a.HighBid + a.Increment
+}
+
+// IsOpenAt reports whether the auction accepts bids at the given time
+func (a *Auction) IsOpenAt(now time.Time) bool {
+	return a.Status == AuctionOpen && !now.Before(a.StartsAt) && now.Before(a.EndsAt)
+}
+
+// IsDue reports whether the open auction has reached its end time or the if-sale negotiation its deadline
+func (a *Auction) IsDue(now time.Time) bool {
+	switch a.Status {
+	case AuctionOpen:
+		return !now.Before(a.EndsAt)
+	case AuctionIfSale:
+		return a.IfSaleEndsAt != nil && !now.Before(*a.IfSaleEndsAt)
+	}
+	return false
+}
+
+// validateBid checks that the bidder can bid the amount at the given time
+func (a *Auction) validateBid(bidder string, amount uint64, now time.Time) error {
+	if bidder == "" || amount == 0 {
+		return ErrValidation
+	}
+	if !a.IsOpenAt(now) {
+		return ErrAuctionNotOpen
+	}
+	if minBid := a.MinBid(); amount < minBid {
+		return &BidTooLowError{MinBid: minBid}
+	}
+	return nil
+}
+
+// leaderMax returns the most the leading bidder is willing to pay
+func (a *Auction) leaderMax() uint64 {
+	return max(a.LeaderMax, a.HighBid)
+}
+
+// newBid counts a new bid of the auction, bids placed on behalf of a maximum bid are automatic
+func (a *Auction) newBid(bidder string, amount uint64, auto bool, now time.Time) *Bid {
+	a.BidCount++
+	return &Bid{AuctionID: a.ID, Bidder: bidder, Amount: amount, Auto: auto, CreatedAt: now.UTC()}
+}
+
+// lead makes the bid the highest one of the auction
+func (a *Auction) lead(b *Bid) {
+	a.HighBid = b.Amount
+	a.Leader = b.Bidder
+	a.ReserveMet = a.HighBid >= a.ReservePrice
+}
+
+// SetReserve sets the hidden reserve price of the auction, zero for no reserve
+func (a *Auction) SetReserve(price uint64) {
+	a.ReservePrice = price
+	a.ReserveMet = a.HighBid >= price
+}
+
+// proxyPrice raises the price bid on behalf of a maximum to the reserve price when the maximum allows it
+func (a *Auction) proxyPrice(price, maximum uint64) uint64 {
+	return max(price, min(maximum, a.ReservePrice))
+}
+
+// PlaceBid places the bid, the maximum bid of the leader counters it automatically,
+// it returns the bids placed in order, the given one included
+func (a *Auction) PlaceBid(b *Bid, now time.Time) ([]*Bid, error) {
+
+	// Validate the bid
+	if err := a.validateBid(b.Bidder, b.Amount, now); err != nil {
+		return nil, err
+	}
+	defer a.extend(now) // a late bid extends the auction
+	outbid := a.BidCount > 0 && a.Leader != b.Bidder
+	a.BidCount++
+	b.AuctionID = a.ID
+	b.Auto = false
+	b.CreatedAt = now.UTC()
+
+	// The leader keeps the lead up to their maximum, an equal maximum is the earlier one
+	if outbid && a.LeaderMax >= b.Amount {
+		counter := a.newBid(a.Leader, a.proxyPrice(min(a.LeaderMax, b.Amount+a.Increment), a.LeaderMax), true, now)
+		a.lead(counter)
+		return []*Bid{b, counter}, nil
+	}
+
+	// Lead the auction, the outbid maximum is bid in full first
+	var bids []*Bid
+	if outbid && a.LeaderMax > a.HighBid {
+		bids = append(bids, a.newBid(a.Leader, a.LeaderMax, true, now))
+	}
+	if outbid || a.LeaderMax <= b.Amount {
+		a.LeaderMax = 0
+	}
+	a.lead(b)
+	return append(bids, b), nil
+}
+
+// PlaceMaxBid places the maximum bid, the auction counter-bids in the smallest valid increment
+// until a maximum is exceeded and ties go to the earliest maximum,
+// it returns the bids placed in order, none when the leader raises their own maximum
+func (a *Auction) PlaceMaxBid(m *MaxBid, now time.Time) ([]*Bid, error) {
+	m.AuctionID = a.ID
+	m.CreatedAt = now.UTC()
+
+	// Raise the maximum of the leader
+	if a.BidCount > 0 && a.Leader == m.Bidder {
+		if !a.IsOpenAt(now) {
+			return nil, ErrAuctionNotOpen
+		}
+		if m.Amount <= a.leaderMax() {
+			return nil, &BidTooLowError{MinBid: a.leaderMax() + 1}
+		}
+		a.LeaderMax = m.Amount
+		return nil, nil
+	}
+
+	// Validate the maximum bid
+	if err := a.validateBid(m.Bidder, m.Amount, now); err != nil {
+		return nil, err
+	}
+	defer a.extend(now) // a late bid extends the auction
+
+	// The first maximum bid opens at the start price
+	if a.BidCount == 0 {
+		b := a.newBid(m.Bidder, a.proxyPrice(a.StartPrice, m.Amount), true, now)
+		a.lead(b)
+		a.LeaderMax = m.Amount
+		return []*Bid{b}, nil
+	}
+
+	// The leader keeps the lead up to their maximum, an equal maximum is the earlier one
+	leaderMax := a.leaderMax()
+	if m.Amount <= leaderMax {
+		b := a.newBid(m.Bidder, m.Amount, true, now)
+		counter := a.newBid(a.Leader, a.proxyPrice(min(leaderMax, m.Amount+a.Increment), leaderMax), true, now)
+		a.lead(counter)
+		return []*Bid{b, counter}, nil
+	}
+
+	// Exceed the maximum of the leader, bid in full first, by the smallest increment
+	var bids []*Bid
+	if leaderMax > a.HighBid {
+		bids = append(bids, a.newBid(a.Leader, leaderMax, true, now))
+	}
+	b := a.newBid(m.Bidder, a.proxyPrice(min(m.Amount, leaderMax+a.Increment), m.Amount), true, now)
+	a.lead(b)
+	a.LeaderMax = m.Amount
+	return append(bids, b), nil
+}
+
+// extend postpones the end of the auction for a bid placed within the soft close window
+func (a *Auction) extend(now time.Time) {
+	if !a.SoftClose.Enabled() || a.EndsAt.Sub(now) > a.SoftClose.Window {
+		return
+	}
+	if a.SoftClose.MaxExtensions > 0 && a.Extensions >= a.SoftClose.MaxExtensions {
+		return
+	}
+	a.EndsAt = a.EndsAt.Add(a.SoftClose.Extension)
+	a.Extensions++
+}
+
+// Close ends the bidding, the leading bidder wins unless the high bid is below the reserve price,
+// then the auction waits for the if-sale negotiation until its deadline; closing an if-sale auction declines it
+func (a *Auction) Close(now time.Time) {
+	if a.Status == AuctionIfSale {
+		a.finish("", 0, now)
+		return
+	}
+	if a.Leader != "" && a.HighBid < a.ReservePrice {
+		deadline := now.Add(IfSaleDuration).UTC()
+		a.Status = AuctionIfSale
+		a.IfSaleEndsAt = &deadline
+		return
+	}
+	a.finish(a.Leader, a.HighBid, now)
+}
+
+// finish ends the auction, sold to the winner at the price unless the winner is empty
+func (a *Auction) finish(winner string, price uint64, now time.Time) {
+	closedAt := now.UTC()
+	a.Status = AuctionClosed
+	a.Winner = winner
+	if winner != "" {
+		a.SalePrice = price
+	}
+	a.ClosedAt = &closedAt
+}
+
+// MakeCounterOffer makes the seller's counter offer to the leading buyer of an if-sale auction,
+// it replaces the previous one and is at least the high bid
+func (a *Auction) MakeCounterOffer(amount uint64) error {
+	if a.Status != AuctionIfSale {
+		return ErrNotNegotiating
+	}
+	if amount < a.HighBid {
+		return ErrValidation
+	}
+	a.CounterOffer = amount
+	return nil
+}
+
+// AcceptCounterOffer sells the vehicle to the leading buyer at the seller's counter offer
+func (a *Auction) AcceptCounterOffer(buyer string, now time.Time) error {
+	if err := a.validateBuyer(buyer); err != nil {
+		return err
+	}
+	if a.CounterOffer == 0 {
+		return ErrNoCounterOffer
+	}
+	a.finish(a.Leader, a.CounterOffer, now)
+	return nil
+}
+
+// DeclineCounterOffer ends the if-sale negotiation without a sale
+func (a *Auction) DeclineCounterOffer(buyer string, now time.Time) error {
+	if err := a.validateBuyer(buyer); err != nil {
+		return err
+	}
+	a.finish("", 0, now)
+	return nil
+}
+
+// validateBuyer checks that the buyer is the leading bidder of an if-sale auction
+func (a *Auction) validateBuyer(buyer string) error {
+	if a.Status != AuctionIfSale {
+		return ErrNotNegotiating
+	}
+	if buyer == "" {
+		return ErrValidation
+	}
+	if buyer != a.Leader {
+		return ErrNotBuyer
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+import "time"
+
+// Auction event types
+const (
+	EventBidPlaced    = "bid_placed"
+	EventPriceChanged = "price_changed"
+	EventExtended     = "extended"
+	EventIfSale       = "if_sale"
+	EventCounterOffer = "counter_offer"
+	EventClosed       = "closed"
+)
+
+// AuctionEvent represents a change of an auction pushed to the buyers watching the vehicle,
+// events of concurrent changes may arrive out of order, the auction version tells the latest state
+type AuctionEvent struct {
+	ID        int64     `json:"id"`
+	Type      string    `json:"type"`
+	AuctionID string    `json:"auction_id"`
+	VIN       string    `json:"vin"`
+	Version   int64     `json:"version"`
+	Bidder    string    `json:"bidder,omitempty"`
+	Amount    uint64    `json:"amount,omitempty"`
+	Auto      bool      `json:"auto,omitempty"`
+	HighBid   uint64    `json:"high_bid"`
+	MinBid    uint64    `json:"min_bid"`
+	EndsAt    time.Time `json:"ends_at"`
+	Winner    string    `json:"winner,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuctionEvents describes the change of an auction from the before state to the after state
+// as the events buyers see, one per placed bid followed by the resulting changes
+func AuctionEvents(before, after *Auction, bids []*Bid, now time.Time) []*AuctionEvent {
+	var events []*AuctionEvent
+	event := func(typ string) *AuctionEvent {
+		e := &AuctionEvent{
+			Type:      typ,
+			AuctionID: after.ID,
+			VIN:       after.VIN,
+			Version:   after.Version,
+			HighBid:   after.HighBid,
+			MinBid:    after.MinBid(),
+			EndsAt:    after.EndsAt,
+			CreatedAt: now.UTC(),
+		}
+		events = append(events, e)
+		return e
+	}
+	for _, b := range bids {
+		e := event(EventBidPlaced)
+		e.Bidder = b.Bidder
+		e.Amount = b.Amount
+		e.Auto = b.Auto
+	}
+	if after.HighBid != before.HighBid {
+		event(EventPriceChanged).Bidder = after.Leader
+	}
+	if !after.EndsAt.Equal(before.EndsAt) {
+		event(EventExtended)
+	}
+	if after.Status == AuctionIfSale && before.Status != AuctionIfSale {
+		event(EventIfSale).Bidder = after.Leader
+	}
+	if after.CounterOffer != before.CounterOffer {
+		// The amount of the counter offer is only for the leader, who reads it from the auction
+		event(EventCounterOffer).Bidder = after.Leader
+	}
+	if after.Status == AuctionClosed && before.Status != AuctionClosed {
+		e := event(EventClosed)
+		e.Winner = after.Winner
+		e.Amount = after.SalePrice
+	}
+	return events
+}
@@ -0,0 +1,50 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// types returns the types of the events
+func types(events []*domain.AuctionEvent) []string {
+	var typ []string
+	for _, e := range events {
+		typ = append(typ, e.Type)
+	}
+	return typ
+}
+
+// TestAuctionEvents tests the AuctionEvents function
+func TestAuctionEvents(t *testing.T) {
+
+	// Bid raising the price
+	a := newTestAuction()
+	before := *a
+	bid := &domain.Bid{Bidder: "alice", Amount: a.StartPrice}
+	_, err := a.PlaceBid(bid, testStart)
+	assert.NoError(t, err)
+	events := domain.AuctionEvents(&before, a, []*domain.Bid{bid}, testStart)
+	assert.Equal(t, []string{domain.EventBidPlaced, domain.EventPriceChanged}, types(events))
+	assert.Equal(t, "alice", events[0].Bidder)
+	assert.Equal(t, a.StartPrice, events[0].Amount)
+	assert.Equal(t, a.MinBid(), events[1].MinBid)
+
+	// Extension
+	before = *a
+	a.EndsAt = a.EndsAt.Add(time.Minute)
+	assert.Equal(t, []string{domain.EventExtended}, types(domain.AuctionEvents(&before, a, nil, testStart)))
+
+	// Close
+	before = *a
+	a.Close(a.EndsAt)
+	events = domain.AuctionEvents(&before, a, nil, a.EndsAt)
+	assert.Equal(t, []string{domain.EventClosed}, types(events))
+	assert.Equal(t, "alice", events[0].Winner)
+
+	// No change
+	assert.Empty(t, domain.AuctionEvents(a, a, nil, a.EndsAt))
+}
@@ -0,0 +1,666 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// aTest is a struct for auction tests
+type aTest struct {
+	name    string
+	data    func() *domain.Auction
+	isValid bool
+}
+
+// testStart is the start time of test auctions
+var testStart = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// newTestAuction is a test valid auction lasting an hour
+func newTestAuction() *domain.Auction {
+	return domain.NewAuction("auction-1", "1HGCM82633A123456", 25_000, 0, testStart, testStart.Add(time.Hour))
+}
+
+// TestMinIncrement tests the MinIncrement function
+func TestMinIncrement(t *testing.T) {
+	assert.Equal(t, uint64(100), domain.MinIncrement(0))
+	assert.Equal(t, uint64(100), domain.MinIncrement(9_000))
+	assert.Equal(t, uint64(300), domain.MinIncrement(25_000))
+	assert.Equal(t, uint64(1_000), domain.MinIncrement(100_000))
+}
+
+// TestNewAuction tests the NewAuction function
+func TestNewAuction(t *testing.T) {
+	a := newTestAuction()
+	assert.Equal(t, domain.AuctionOpen, a.Status)
+	assert.Equal(t, uint64(12_500), a.StartPrice)
+	assert.Equal(t, uint64(300), a.Increment)
+
+	a = domain.NewAuction("auction-1", "1HGCM82633A123456", 25_000, 20_000, testStart, testStart.Add(time.Hour))
+	assert.Equal(t, uint64(20_000), a.StartPrice)
+}
+
+// TestAuction_Validate tests the Validate method of the Auction struct
+func TestAuction_Validate(t *testing.T) {
+	tests := []aTest{
+		{
+			name: "valid auction",
+			data: func() *domain.Auction {
+				return newTestAuction()
+			},
+			isValid: true,
+		},
+		{
+			name: "invalid VIN",
+			data: func() *domain.Auction {
+				a := newTestAuction()
+				a.VIN = "123"
+				return a
+			},
+			isValid: false,
+		},
+		{
+			name: "too short",
+			data: func() *domain.Auction {
+				a := newTestAuction()
+				a.EndsAt = a.StartsAt.Add(time.Second)
+				return a
+			},
+			isValid: false,
+		},
+		{
+			name: "ends before start",
+			data: func() *domain.Auction {
+				a := newTestAuction()
+				a.EndsAt = a.StartsAt.Add(-time.Hour)
+				return a
+			},
+			isValid: false,
+		},
+		{
+			name: "too long",
+			data: func() *domain.Auction {
+				a := newTestAuction()
+				a.EndsAt = a.StartsAt.Add(domain.MaxAuctionDuration + time.Hour)
+				return a
+			},
+			isValid: false,
+		},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.isValid {
+				assert.NoError(t, test.data().Validate())
+			} else {
+				assert.Error(t, test.data().Validate())
+			}
+		})
+	}
+}
+
+// TestAuction_PlaceBid tests the PlaceBid method of the Auction struct
+func TestAuction_PlaceBid(t *testing.T) {
+	a := newTestAuction()
+	during := testStart.Add(time.Minute)
+
+	t.Run("first bid at start price", func(t *testing.T) {
+		b := &domain.Bid{Bidder: "alice", Amount: a.StartPrice}
+		_, err := a.PlaceBid(b, during)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", a.Leader)
+		assert.Equal(t, a.StartPrice, a.HighBid)
+		assert.Equal(t, a.ID, b.AuctionID)
+		assert.Equal(t, 1, a.BidCount)
+	})
+
+	t.Run("below increment", func(t *testing.T) {
+		_, err := a.PlaceBid(&domain.Bid{Bidder: "bob", Amount: a.HighBid + a.Increment - 1}, during)
+		assert.ErrorIs(t, err, domain.ErrBidTooLow)
+		var tooLow *domain.BidTooLowError
+		assert.ErrorAs(t, err, &tooLow)
+		assert.Equal(t, a.HighBid+a.Increment, tooLow.MinBid)
+	})
+
+	t.Run("outbid", func(t *testing.T) {
+		_, err := a.PlaceBid(&domain.Bid{Bidder: "bob", Amount: a.MinBid()}, during)
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", a.Leader)
+		assert.Equal(t, 2, a.BidCount)
+	})
+
+	t.Run("invalid bid", func(t *testing.T) {
+		_, err := a.PlaceBid(&domain.Bid{Amount: a.MinBid()}, during)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("before start", func(t *testing.T) {
+		_, err := a.PlaceBid(&domain.Bid{Bidder: "carol", Amount: a.MinBid()}, testStart.Add(-time.Minute))
+		assert.ErrorIs(t, err, domain.ErrAuctionNotOpen)
+	})
+
+	t.Run("after end", func(t *testing.T) {
+		_, err := a.PlaceBid(&domain.Bid{Bidder: "carol", Amount: a.MinBid()}, a.EndsAt)
+		assert.ErrorIs(t, err, domain.ErrAuctionNotOpen)
+	})
+}
+
+// bidStep is a bid or a maximum bid placed in an auction test
+type bidStep struct {
+	bidder string
+	amount uint64
+	max    bool
+}
+
+// placeSteps places the steps in the auction and returns the placed bids as bidder:amount, automatic ones marked with *
+func placeSteps(a *domain.Auction, steps []bidStep) ([]string, error) {
+	var placed []string
+	for _, s := range steps {
+		var bids []*domain.Bid
+		var err error
+		if s.max {
+			bids, err = a.PlaceMaxBid(&domain.MaxBid{Bidder: s.bidder, Amount: s.amount}, testStart)
+		} else {
+			bids, err = a.PlaceBid(&domain.Bid{Bidder: s.bidder, Amount: s.amount}, testStart)
+		}
+		if err != nil {
+			return placed, err
+		}
+		for _, b := range bids {
+			p := fmt.Sprintf("%s:%d", b.Bidder, b.Amount)
+			if b.Auto {
+				p += "*"
+			}
+			placed = append(placed, p)
+		}
+	}
+	return placed, nil
+}
+
+// TestAuction_PlaceMaxBid tests the proxy bidding of the Auction struct
+func TestAuction_PlaceMaxBid(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   []bidStep
+		bids    []string
+		leader  string
+		highBid uint64
+	}{
+		{
+			name:    "first maximum opens at start price",
+			steps:   []bidStep{{"alice", 20_000, true}},
+			bids:    []string{"alice:12500*"},
+			leader:  "alice",
+			highBid: 12_500,
+		},
+		{
+			name:    "lower maximum is countered by an increment",
+			steps:   []bidStep{{"alice", 20_000, true}, {"bob", 15_000, true}},
+			bids:    []string{"alice:12500*", "bob:15000*", "alice:15300*"},
+			leader:  "alice",
+			highBid: 15_300,
+		},
+		{
+			name:    "counter is capped by the maximum",
+			steps:   []bidStep{{"alice", 15_100, true}, {"bob", 15_000, true}},
+			bids:    []string{"alice:12500*", "bob:15000*", "alice:15100*"},
+			leader:  "alice",
+			highBid: 15_100,
+		},
+		{
+			name:    "higher maximum takes the lead",
+			steps:   []bidStep{{"alice", 15_000, true}, {"bob", 20_000, true}},
+			bids:    []string{"alice:12500*", "alice:15000*", "bob:15300*"},
+			leader:  "bob",
+			highBid: 15_300,
+		},
+		{
+			name:    "higher maximum within an increment",
+			steps:   []bidStep{{"alice", 15_000, true}, {"bob", 15_100, true}},
+			bids:    []string{"alice:12500*", "alice:15000*", "bob:15100*"},
+			leader:  "bob",
+			highBid: 15_100,
+		},
+		{
+			name:    "equal maximum goes to the earliest",
+			steps:   []bidStep{{"alice", 15_000, true}, {"bob", 15_000, true}},
+			bids:    []string{"alice:12500*", "bob:15000*", "alice:15000*"},
+			leader:  "alice",
+			highBid: 15_000,
+		},
+		{
+			name:    "bid is countered by the maximum",
+			steps:   []bidStep{{"alice", 20_000, true}, {"bob", 14_000, false}},
+			bids:    []string{"alice:12500*", "bob:14000", "alice:14300*"},
+			leader:  "alice",
+			highBid: 14_300,
+		},
+		{
+			name:    "bid equal to the maximum loses",
+			steps:   []bidStep{{"alice", 15_000, true}, {"bob", 15_000, false}},
+			bids:    []string{"alice:12500*", "bob:15000", "alice:15000*"},
+			leader:  "alice",
+			highBid: 15_000,
+		},
+		{
+			name:    "bid above the maximum takes the lead",
+			steps:   []bidStep{{"alice", 15_000, true}, {"bob", 16_000, false}, {"carol", 16_300, false}},
+			bids:    []string{"alice:12500*", "alice:15000*", "bob:16000", "carol:16300"},
+			leader:  "carol",
+			highBid: 16_300,
+		},
+		{
+			name:    "maximum outbids a bid",
+			steps:   []bidStep{{"alice", 12_500, false}, {"bob", 20_000, true}},
+			bids:    []string{"alice:12500", "bob:12800*"},
+			leader:  "bob",
+			highBid: 12_800,
+		},
+		{
+			name:    "leader raises the maximum",
+			steps:   []bidStep{{"alice", 15_000, true}, {"alice", 18_000, true}, {"bob", 17_000, true}},
+			bids:    []string{"alice:12500*", "bob:17000*", "alice:17300*"},
+			leader:  "alice",
+			highBid: 17_300,
+		},
+		{
+			name:    "leader bids below the maximum",
+			steps:   []bidStep{{"alice", 20_000, true}, {"alice", 13_000, false}, {"bob", 15_000, true}},
+			bids:    []string{"alice:12500*", "alice:13000", "bob:15000*", "alice:15300*"},
+			leader:  "alice",
+			highBid: 15_300,
+		},
+		{
+			name:    "proxy war",
+			steps:   []bidStep{{"alice", 14_000, true}, {"bob", 16_000, true}, {"carol", 15_000, true}},
+			bids:    []string{"alice:12500*", "alice:14000*", "bob:14300*", "carol:15000*", "bob:15300*"},
+			leader:  "bob",
+			highBid: 15_300,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := newTestAuction()
+			bids, err := placeSteps(a, test.steps)
+			assert.NoError(t, err)
+			assert.Equal(t, test.bids, bids)
+			assert.Equal(t, test.leader, a.Leader)
+			assert.Equal(t, test.highBid, a.HighBid)
+			assert.Equal(t, len(test.bids), a.BidCount)
+		})
+	}
+}
+
+// TestAuction_PlaceMaxBid_Invalid tests the rejected maximum bids of the Auction struct
+func TestAuction_PlaceMaxBid_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		steps  []bidStep
+		err    error
+		minBid uint64
+	}{
+		{
+			name:  "missing bidder",
+			steps: []bidStep{{"", 20_000, true}},
+			err:   domain.ErrValidation,
+		},
+		{
+			name:   "below start price",
+			steps:  []bidStep{{"alice", 12_000, true}},
+			err:    domain.ErrBidTooLow,
+			minBid: 12_500,
+		},
+		{
+			name:   "below increment",
+			steps:  []bidStep{{"alice", 20_000, true}, {"bob", 12_700, true}},
+			err:    domain.ErrBidTooLow,
+			minBid: 12_800,
+		},
+		{
+			name:   "leader lowers the maximum",
+			steps:  []bidStep{{"alice", 20_000, true}, {"alice", 19_000, true}},
+			err:    domain.ErrBidTooLow,
+			minBid: 20_001,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := placeSteps(newTestAuction(), test.steps)
+			assert.ErrorIs(t, err, test.err)
+			var tooLow *domain.BidTooLowError
+			if errors.As(err, &tooLow) {
+				assert.Equal(t, test.minBid, tooLow.MinBid)
+			}
+		})
+	}
+
+	// Closed auction
+	a := newTestAuction()
+	_, err := a.PlaceMaxBid(&domain.MaxBid{Bidder: "alice", Amount: 20_000}, a.EndsAt)
+	assert.ErrorIs(t, err, domain.ErrAuctionNotOpen)
+}
+
+// TestAuction_PlaceMaxBid_Order tests that distinct maximum bids resolve identically in any order,
+// a maximum already below the minimum bid when placed is rejected
+func TestAuction_PlaceMaxBid_Order(t *testing.T) {
+	orders := [][]bidStep{
+		{{"alice", 14_000, true}, {"bob", 16_000, true}, {"carol", 15_000, true}},
+		{{"alice", 14_000, true}, {"carol", 15_000, true}, {"bob", 16_000, true}},
+		{{"bob", 16_000, true}, {"alice", 14_000, true}, {"carol", 15_000, true}},
+		{{"bob", 16_000, true}, {"carol", 15_000, true}, {"alice", 14_000, true}},
+		{{"carol", 15_000, true}, {"alice", 14_000, true}, {"bob", 16_000, true}},
+		{{"carol", 15_000, true}, {"bob", 16_000, true}, {"alice", 14_000, true}},
+	}
+	for _, steps := range orders {
+		a := newTestAuction()
+		for _, s := range steps {
+			_, err := placeSteps(a, []bidStep{s})
+			if err != nil {
+				assert.ErrorIs(t, err, domain.ErrBidTooLow)
+			}
+		}
+		assert.Equal(t, "bob", a.Leader)
+		assert.Equal(t, uint64(15_300), a.HighBid)
+	}
+}
+
+// TestAuction_SoftClose tests the extension of the Auction struct by late bids
+func TestAuction_SoftClose(t *testing.T) {
+	policy := domain.SoftClose{Window: 2 * time.Minute, Extension: 3 * time.Minute, MaxExtensions: 2}
+	end := testStart.Add(time.Hour)
+	tests := []struct {
+		name       string
+		policy     domain.SoftClose
+		at         []time.Duration // before the original end
+		max        bool
+		endsAt     time.Time
+		extensions int
+	}{
+		{
+			name:   "bid before the window",
+			policy: policy,
+			at:     []time.Duration{3 * time.Minute},
+			endsAt: end,
+		},
+		{
+			name:       "bid within the window",
+			policy:     policy,
+			at:         []time.Duration{time.Minute},
+			endsAt:     end.Add(3 * time.Minute),
+			extensions: 1,
+		},
+		{
+			name:       "bid at the window start",
+			policy:     policy,
+			at:         []time.Duration{2 * time.Minute},
+			endsAt:     end.Add(3 * time.Minute),
+			extensions: 1,
+		},
+		{
+			name:       "maximum bid within the window",
+			policy:     policy,
+			at:         []time.Duration{time.Second},
+			max:        true,
+			endsAt:     end.Add(3 * time.Minute),
+			extensions: 1,
+		},
+		{
+			name:       "extensions are capped",
+			policy:     policy,
+			at:         []time.Duration{time.Second, -2 * time.Minute, -5 * time.Minute},
+			endsAt:     end.Add(6 * time.Minute),
+			extensions: 2,
+		},
+		{
+			name:       "uncapped extensions",
+			policy:     domain.SoftClose{Window: 2 * time.Minute, Extension: 3 * time.Minute},
+			at:         []time.Duration{time.Second, -2 * time.Minute, -5 * time.Minute},
+			endsAt:     end.Add(9 * time.Minute),
+			extensions: 3,
+		},
+		{
+			name:   "disabled",
+			at:     []time.Duration{time.Second},
+			endsAt: end,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := newTestAuction()
+			a.SoftClose = test.policy
+			for i, before := range test.at {
+				now := end.Add(-before)
+				var err error
+				if test.max {
+					_, err = a.PlaceMaxBid(&domain.MaxBid{Bidder: fmt.Sprintf("bidder-%d", i), Amount: a.MinBid()}, now)
+				} else {
+					_, err = a.PlaceBid(&domain.Bid{Bidder: fmt.Sprintf("bidder-%d", i), Amount: a.MinBid()}, now)
+				}
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.endsAt, a.EndsAt)
+			assert.Equal(t, test.extensions, a.Extensions)
+		})
+	}
+
+	t.Run("leader raising the maximum", func(t *testing.T) {
+		a := newTestAuction()
+		a.SoftClose = policy
+		_, err := a.PlaceMaxBid(&domain.MaxBid{Bidder: "alice", Amount: 15_000}, testStart)
+		assert.NoError(t, err)
+		_, err = a.PlaceMaxBid(&domain.MaxBid{Bidder: "alice", Amount: 20_000}, end.Add(-time.Second))
+		assert.NoError(t, err)
+		assert.Equal(t, end, a.EndsAt)
+	})
+
+	t.Run("rejected bid", func(t *testing.T) {
+		a := newTestAuction()
+		a.SoftClose = policy
+		_, err := a.PlaceBid(&domain.Bid{Bidder: "alice", Amount: 1}, end.Add(-time.Second))
+		assert.ErrorIs(t, err, domain.ErrBidTooLow)
+		assert.Equal(t, end, a.EndsAt)
+	})
+}
+
+// TestSoftClose_Validate tests the Validate method of the SoftClose struct
+func TestSoftClose_Validate(t *testing.T) {
+	assert.NoError(t, domain.SoftClose{}.Validate())
+	assert.NoError(t, domain.SoftClose{Window: time.Minute, Extension: time.Minute, MaxExtensions: 3}.Validate())
+	assert.Error(t, domain.SoftClose{Window: time.Minute}.Validate())
+	assert.Error(t, domain.SoftClose{Window: -time.Minute, Extension: time.Minute}.Validate())
+	assert.Error(t, domain.SoftClose{MaxExtensions: -1}.Validate())
+
+	a := newTestAuction()
+	a.SoftClose = domain.SoftClose{Window: time.Minute}
+	assert.Error(t, a.Validate())
+}
+
+// TestSoftClose_JSON tests the JSON encoding of the SoftClose struct
+func TestSoftClose_JSON(t *testing.T) {
+	s := domain.SoftClose{Window: 2 * time.Minute, Extension: 90 * time.Second, MaxExtensions: 5}
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"window":"2m0s","extension":"1m30s","max_extensions":5}`, string(data))
+
+	var got domain.SoftClose
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, s, got)
+	assert.Error(t, json.Unmarshal([]byte(`{"window":"soon"}`), &got))
+}
+
+// TestAuction_Close tests the Close method of the Auction struct
+func TestAuction_Close(t *testing.T) {
+	a := newTestAuction()
+	_, err := a.PlaceBid(&domain.Bid{Bidder: "alice", Amount: a.StartPrice}, testStart)
+	assert.NoError(t, err)
+	assert.False(t, a.IsDue(a.EndsAt.Add(-time.Second)))
+	assert.True(t, a.IsDue(a.EndsAt))
+
+	a.Close(a.EndsAt)
+	assert.Equal(t, domain.AuctionClosed, a.Status)
+	assert.Equal(t, "alice", a.Winner)
+	assert.NotNil(t, a.ClosedAt)
+	assert.False(t, a.IsDue(a.EndsAt))
+
+	// Auction without bids closes without a winner
+	unsold := newTestAuction()
+	unsold.Close(unsold.EndsAt)
+	assert.Empty(t, unsold.Winner)
+}
+
+// TestAuction_Close_Reserve tests the closing of the Auction struct against its reserve price
+func TestAuction_Close_Reserve(t *testing.T) {
+	tests := []struct {
+		name    string
+		reserve uint64
+		bid     uint64
+		status  string
+		winner  string
+		price   uint64
+	}{
+		{
+			name:   "no reserve",
+			bid:    12_500,
+			status: domain.AuctionClosed,
+			winner: "alice",
+			price:  12_500,
+		},
+		{
+			name:    "reserve met",
+			reserve: 15_000,
+			bid:     15_000,
+			status:  domain.AuctionClosed,
+			winner:  "alice",
+			price:   15_000,
+		},
+		{
+			name:    "below reserve",
+			reserve: 15_000,
+			bid:     14_900,
+			status:  domain.AuctionIfSale,
+		},
+		{
+			name:    "no bids",
+			reserve: 15_000,
+			status:  domain.AuctionClosed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := newTestAuction()
+			a.SetReserve(test.reserve)
+			assert.Equal(t, test.reserve == 0, a.ReserveMet)
+			if test.bid > 0 {
+				_, err := a.PlaceBid(&domain.Bid{Bidder: "alice", Amount: test.bid}, testStart)
+				assert.NoError(t, err)
+				assert.Equal(t, test.bid >= test.reserve, a.ReserveMet)
+			}
+			a.Close(a.EndsAt)
+			assert.Equal(t, test.status, a.Status)
+			assert.Equal(t, test.winner, a.Winner)
+			assert.Equal(t, test.price, a.SalePrice)
+			assert.Equal(t, test.status == domain.AuctionClosed, a.ClosedAt != nil)
+		})
+	}
+
+	t.Run("maximum bid jumps to the reserve", func(t *testing.T) {
+		a := newTestAuction()
+		a.SetReserve(15_000)
+		bids, err := a.PlaceMaxBid(&domain.MaxBid{Bidder: "alice", Amount: 20_000}, testStart)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(15_000), bids[0].Amount)
+		assert.True(t, a.ReserveMet)
+
+		bids, err = a.PlaceMaxBid(&domain.MaxBid{Bidder: "bob", Amount: 14_000}, testStart)
+		assert.Error(t, err)
+		assert.Nil(t, bids)
+	})
+
+	t.Run("maximum below the reserve", func(t *testing.T) {
+		a := newTestAuction()
+		a.SetReserve(15_000)
+		bids, err := a.PlaceMaxBid(&domain.MaxBid{Bidder: "alice", Amount: 14_000}, testStart)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(14_000), bids[0].Amount)
+		assert.False(t, a.ReserveMet)
+	})
+}
+
+// TestAuction_IfSale tests the if-sale negotiation of the Auction struct
+func TestAuction_IfSale(t *testing.T) {
+
+	// newIfSale returns an auction closed with alice bidding below the reserve
+	newIfSale := func() *domain.Auction {
+		a := newTestAuction()
+		a.SetReserve(20_000)
+		_, err := a.PlaceBid(&domain.Bid{Bidder: "alice", Amount: 15_000}, testStart)
+		assert.NoError(t, err)
+		a.Close(a.EndsAt)
+		return a
+	}
+	later := testStart.Add(2 * time.Hour)
+
+	t.Run("accept counter offer", func(t *testing.T) {
+		a := newIfSale()
+		assert.ErrorIs(t, a.AcceptCounterOffer("alice", later), domain.ErrNoCounterOffer)
+		assert.ErrorIs(t, a.MakeCounterOffer(14_000), domain.ErrValidation)
+		assert.NoError(t, a.MakeCounterOffer(19_000))
+		assert.NoError(t, a.MakeCounterOffer(18_000))
+		assert.ErrorIs(t, a.AcceptCounterOffer("bob", later), domain.ErrNotBuyer)
+		assert.ErrorIs(t, a.AcceptCounterOffer("", later), domain.ErrValidation)
+
+		assert.NoError(t, a.AcceptCounterOffer("alice", later))
+		assert.Equal(t, domain.AuctionClosed, a.Status)
+		assert.Equal(t, "alice", a.Winner)
+		assert.Equal(t, uint64(18_000), a.SalePrice)
+		assert.Equal(t, later, *a.ClosedAt)
+	})
+
+	t.Run("decline counter offer", func(t *testing.T) {
+		a := newIfSale()
+		assert.NoError(t, a.MakeCounterOffer(18_000))
+		assert.NoError(t, a.DeclineCounterOffer("alice", later))
+		assert.Equal(t, domain.AuctionClosed, a.Status)
+		assert.Empty(t, a.Winner)
+		assert.Zero(t, a.SalePrice)
+	})
+
+	t.Run("not negotiating", func(t *testing.T) {
+		a := newTestAuction()
+		assert.ErrorIs(t, a.MakeCounterOffer(18_000), domain.ErrNotNegotiating)
+		assert.ErrorIs(t, a.AcceptCounterOffer("alice", later), domain.ErrNotNegotiating)
+		assert.ErrorIs(t, a.DeclineCounterOffer("alice", later), domain.ErrNotNegotiating)
+
+		a = newIfSale()
+		assert.NoError(t, a.DeclineCounterOffer("alice", later))
+		assert.ErrorIs(t, a.MakeCounterOffer(18_000), domain.ErrNotNegotiating)
+	})
+
+	t.Run("no bidding in negotiation", func(t *testing.T) {
+		a := newIfSale()
+		_, err := a.PlaceBid(&domain.Bid{Bidder: "bob", Amount: 30_000}, a.EndsAt.Add(-time.Minute))
+		assert.ErrorIs(t, err, domain.ErrAuctionNotOpen)
+	})
+
+	t.Run("declined at the deadline", func(t *testing.T) {
+		a := newIfSale()
+		assert.NoError(t, a.MakeCounterOffer(18_000))
+		deadline := a.EndsAt.Add(domain.IfSaleDuration)
+		assert.Equal(t, deadline, *a.IfSaleEndsAt)
+		assert.False(t, a.IsDue(deadline.Add(-time.Second)))
+		assert.True(t, a.IsDue(deadline))
+
+		a.Close(deadline)
+		assert.Equal(t, domain.AuctionClosed, a.Status)
+		assert.Empty(t, a.Winner)
+		assert.Zero(t, a.SalePrice)
+		assert.False(t, a.IsDue(deadline))
+	})
+}
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Dutch clock statuses
+const (
+	ClockRunning = "running"
+	ClockSold    = "sold"
+	ClockExpired = "expired"
+)
+
+// Dutch clock limits
+const (
+	MinClockStepSeconds = 1
+	MaxClockStepSeconds = 24 * 60 * 60
+)
+
+// DutchClock represents a descending-price sale of a vehicle, the price drops by the step every StepSeconds
+// from the start price down to the floor price and the first buyer to accept it wins, the clock expires
+// one step after reaching the floor, the current price is always derived from the start time
+type DutchClock struct {
+	ID               string     `json:"id"`
+	VIN              string     `json:"vin"`
+	Status           string     `json:"status"`
+	RecommendedPrice uint64     `json:"recommended_price"`
+	StartPrice       uint64     `json:"start_price"`
+	FloorPrice       uint64     `json:"floor_price"`
+	Step             uint64     `json:"step"`
+	StepSeconds      int64      `json:"step_seconds"`
+	Price            uint64     `json:"price"`
+	Buyer            string     `json:"buyer,omitempty"`
+	SalePrice        uint64     `json:"sale_price,omitempty"`
+	StartsAt         time.Time  `json:"starts_at"`
+	EndsAt           time.Time  `json:"ends_at"`
+	ClosedAt         *time.Time `json:"closed_at,omitempty"`
+	Version          int64      `json:"version"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// NewDutchClock creates a new running clock of the vehicle starting at the recommended price raised to startPercent,
+// a zero floor defaults to half of the recommended price and a zero step to the minimum auction increment
+func NewDutchClock(id, vin string, recommendedPrice, startPercent, floorPrice, step uint64, stepSeconds int64, startsAt time.Time) *DutchClock {
+	if floorPrice == 0 {
+		floorPrice = max(recommendedPrice/2, MinIncrement(recommendedPrice))
+	}
+	if step == 0 {
+		step = MinIncrement(recommendedPrice)
+	}
+	c := &DutchClock{
+		ID:               id,
+		VIN:              vin,
+		Status:           ClockRunning,
+		RecommendedPrice: recommendedPrice,
+		StartPrice:       recommendedPrice * startPercent / 100,
+		FloorPrice:       floorPrice,
+		Step:             step,
+		StepSeconds:      stepSeconds,
+		StartsAt:         startsAt.UTC(),
+		CreatedAt:        time.Now().UTC(),
+	}
+	c.Price = c.StartPrice
+	if c.Step > 0 && c.StartPrice > c.FloorPrice {
+		steps := (c.StartPrice - c.FloorPrice + c.Step - 1) / c.Step
+		c.EndsAt = c.StartsAt.Add(time.Duration(steps+1) * c.stepInterval())
+	}
+	return c
+}
+
+// Validate checks if the clock data is valid
+func (c *DutchClock) Validate() error {
+	return validation.ValidateStruct(
+		c,
+		validation.Field(
+			&c.ID,
+			validation.Required,
+		),
+		validation.Field(
+			&c.VIN,
+			validation.Required,
+			validation.Length(17, 17),
+		),
+		validation.Field(
+			&c.FloorPrice,
+			validation.Required,
+			validation.By(c.validateFloor),
+		),
+		validation.Field(
+			&c.Step,
+			validation.Required,
+		),
+		validation.Field(
+			&c.StepSeconds,
+			validation.Required,
+			validation.Min(int64(MinClockStepSeconds)),
+			validation.Max(int64(MaxClockStepSeconds)),
+		),
+		validation.Field(
+			&c.StartsAt,
+			validation.Required,
+		),
+	)
+}
+
+// validateFloor checks that the price has room to drop
+func (c *DutchClock) validateFloor(any) error {
+	if c.FloorPrice >= c.StartPrice {
+		return errors.New("floor price must be below the start price")
+	}
+	return nil
+}
+
+// stepInterval returns the time between two price drops
+func (c *DutchClock) stepInterval() time.Duration {
+	return time.Duration(c.StepSeconds) * time.Second
+}
+
+// PriceAt returns the price of the clock at the given time, recomputed from the start time
+func (c *DutchClock) PriceAt(now time.Time) uint64 {
+	if !now.After(c.StartsAt) || c.StepSeconds <= 0 {
+		return c.StartPrice
+	}
+	drop := uint64(now.Sub(c.StartsAt)/c.stepInterval()) * c.Step
+	if drop >= c.StartPrice-c.FloorPrice {
+		return c.FloorPrice
+	}
+	return c.StartPrice - drop
+}
+
+// Tick sets the price of a running clock for the given time, a sold clock shows its sale price
+func (c *DutchClock) Tick(now time.Time) {
+	switch c.Status {
+	case ClockRunning:
+		c.Price = c.PriceAt(now)
+	case ClockSold:
+		c.Price = c.SalePrice
+	}
+}
+
+// IsRunningAt reports whether the clock can be accepted at the given time
+func (c *DutchClock) IsRunningAt(now time.Time) bool {
+	return c.Status == ClockRunning && !now.Before(c.StartsAt) && now.Before(c.EndsAt)
+}
+
+// IsDue reports whether the running clock has expired unsold
+func (c *DutchClock) IsDue(now time.Time) bool {
+	return c.Status == ClockRunning && !now.Before(c.EndsAt)
+}
+
+// Accept sells the vehicle to the buyer at the current price of the clock
+func (c *DutchClock) Accept(buyer string, now time.Time) error {
+	if !c.IsRunningAt(now) {
+		return ErrClockNotRunning
+	}
+	if buyer == "" {
+		return ErrValidation
+	}
+	closedAt := now.UTC()
+	c.Status = ClockSold
+	c.Buyer = buyer
+	c.SalePrice = c.PriceAt(now)
+	c.Price = c.SalePrice
+	c.ClosedAt = &closedAt
+	return nil
+}
+
+// Expire ends the clock without a sale
+func (c *DutchClock) Expire(now time.Time) {
+	closedAt := now.UTC()
+	c.Status = ClockExpired
+	c.Price = c.FloorPrice
+	c.ClosedAt = &closedAt
+}
@@ -0,0 +1,101 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClock is a test valid clock dropping from 30,000 to 20,000 by 1,000 every minute
+func newTestClock() *domain.DutchClock {
+	return domain.NewDutchClock("clock-1", "1HGCM82633A123456", 25_000, 120, 20_000, 1_000, 60, testStart)
+}
+
+// TestNewDutchClock tests the NewDutchClock function
+func TestNewDutchClock(t *testing.T) {
+	c := newTestClock()
+	assert.Equal(t, domain.ClockRunning, c.Status)
+	assert.Equal(t, uint64(30_000), c.StartPrice)
+	assert.Equal(t, testStart.Add(11*time.Minute), c.EndsAt, "one step after reaching the floor")
+	assert.NoError(t, c.Validate())
+
+	c = domain.NewDutchClock("clock-1", "1HGCM82633A123456", 25_000, 120, 0, 0, 60, testStart)
+	assert.Equal(t, uint64(12_500), c.FloorPrice)
+	assert.Equal(t, uint64(300), c.Step)
+	assert.Equal(t, testStart.Add(60*time.Minute), c.EndsAt)
+
+	assert.Error(t, domain.NewDutchClock("clock-1", "1HGCM82633A123456", 25_000, 100, 30_000, 0, 60, testStart).Validate(), "floor above start")
+	assert.Error(t, domain.NewDutchClock("clock-1", "1HGCM82633A123456", 25_000, 120, 0, 0, 0, testStart).Validate(), "no step interval")
+}
+
+// TestDutchClock_PriceAt tests the PriceAt method of the DutchClock struct
+func TestDutchClock_PriceAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		at       time.Duration
+		expected uint64
+	}{
+		{name: "before start", at: -time.Minute, expected: 30_000},
+		{name: "at start", at: 0, expected: 30_000},
+		{name: "within the first step", at: 59 * time.Second, expected: 30_000},
+		{name: "first drop", at: time.Minute, expected: 29_000},
+		{name: "midway", at: 5*time.Minute + 30*time.Second, expected: 25_000},
+		{name: "at the floor", at: 10 * time.Minute, expected: 20_000},
+		{name: "past the floor", at: time.Hour, expected: 20_000},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, newTestClock().PriceAt(testStart.Add(test.at)))
+		})
+	}
+}
+
+// TestDutchClock_Accept tests the Accept method of the DutchClock struct
+func TestDutchClock_Accept(t *testing.T) {
+	tests := []struct {
+		name  string
+		buyer string
+		at    time.Duration
+		price uint64
+		err   error
+	}{
+		{name: "at the start price", buyer: "alice", at: 0, price: 30_000},
+		{name: "after drops", buyer: "alice", at: 3 * time.Minute, price: 27_000},
+		{name: "at the floor", buyer: "alice", at: 10*time.Minute + 59*time.Second, price: 20_000},
+		{name: "before start", buyer: "alice", at: -time.Second, err: domain.ErrClockNotRunning},
+		{name: "expired", buyer: "alice", at: 11 * time.Minute, err: domain.ErrClockNotRunning},
+		{name: "no buyer", at: time.Minute, err: domain.ErrValidation},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newTestClock()
+			err := c.Accept(test.buyer, testStart.Add(test.at))
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+				assert.Equal(t, domain.ClockRunning, c.Status)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, domain.ClockSold, c.Status)
+			assert.Equal(t, test.price, c.SalePrice)
+			assert.ErrorIs(t, c.Accept("bob", testStart.Add(test.at)), domain.ErrClockNotRunning, "the first acceptance wins")
+		})
+	}
+}
+
+// TestDutchClock_Expire tests that a clock expires one step after reaching the floor
+func TestDutchClock_Expire(t *testing.T) {
+	c := newTestClock()
+	assert.False(t, c.IsDue(testStart.Add(10*time.Minute)))
+	assert.True(t, c.IsDue(testStart.Add(11*time.Minute)))
+	c.Expire(testStart.Add(11 * time.Minute))
+	assert.Equal(t, domain.ClockExpired, c.Status)
+	assert.False(t, c.IsDue(testStart.Add(11*time.Minute)))
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+)
+
+var (
+	ErrNotFound        = errors.New("auction not found")
+	ErrValidation      = errors.New("validation failed")
+	ErrVehicleNotFound = errors.New("vehicle not found")
+	ErrAuctionExists   = errors.New("vehicle already has an open auction")
+	ErrAuctionNotOpen  = errors.New("auction is not open for bidding")
+	ErrBidTooLow       = errors.New("bid is below the minimum bid")
+	ErrVersionConflict = errors.New("auction version conflict")
+	ErrNotNegotiating  = errors.New("auction is not in if-sale negotiation")
+	ErrNoCounterOffer  = errors.New("seller has not made a counter offer")
+	ErrNotBuyer        = errors.New("only the leading buyer can respond to the counter offer")
+	ErrTenderNotFound  = errors.New("tender not found")
+	ErrTenderExists    = errors.New("vehicle already has an open tender")
+	ErrTenderNotOpen   = errors.New("tender is not open for bids")
+	ErrAlreadyBid      = errors.New("bidder has already submitted a sealed bid")
+	ErrTenderSealed    = errors.New("tender bids are sealed until it closes")
+	ErrClockNotFound   = errors.New("dutch clock not found")
+	ErrClockExists     = errors.New("vehicle already has a running dutch clock")
+	ErrClockNotRunning = errors.New("dutch clock is not running")
+	ErrSaleExists      = errors.New("vehicle is already on sale")
+	ErrNotForSale      = errors.New("vehicle is not for sale")
+)
+
+// BidTooLowError reports the minimum bid the auction accepts
+type BidTooLowError struct {
+	MinBid uint64
+}
+
+// Error returns the error message
+func (e *BidTooLowError) Error() string {
+	return ErrBidTooLow.Error() + " of " + strconv.FormatUint(e.MinBid, 10)
+}
+
+// Unwrap makes the error match ErrBidTooLow
+func (e *BidTooLowError) Unwrap() error {
+	return ErrBidTooLow
+}
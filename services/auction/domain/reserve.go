@@ -0,0 +1,33 @@
+package domain
+
+import validation "github.com/go-ozzo/ozzo-validation/v4"
+
+// MaxReservePercent limits a reserve given as a percentage of the recommended price
+const MaxReservePercent = 200
+
+// Reserve is the seller's floor of an auction, either an absolute price
+// or a percentage of the price recommended by the pricing service
+type Reserve struct {
+	Price   uint64 `json:"price"`
+	Percent uint64 `json:"percent"`
+}
+
+// Validate checks if the reserve is valid
+func (r Reserve) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Percent,
+			validation.Max(uint64(MaxReservePercent)),
+			validation.When(r.Price > 0, validation.Empty.Error("cannot be combined with an absolute price")),
+		),
+	)
+}
+
+// Amount resolves the reserve price against the recommended price, zero for no reserve
+func (r Reserve) Amount(recommendedPrice uint64) uint64 {
+	if r.Percent > 0 {
+		return (recommendedPrice*r.Percent + 99) / 100
+	}
+	return r.Price
+}
@@ -0,0 +1,60 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestReserve tests the Validate and Amount methods of the Reserve struct
+func TestReserve(t *testing.T) {
+	tests := []struct {
+		name    string
+		reserve domain.Reserve
+		isValid bool
+		amount  uint64
+	}{
+		{
+			name:    "no reserve",
+			isValid: true,
+		},
+		{
+			name:    "absolute price",
+			reserve: domain.Reserve{Price: 18_000},
+			isValid: true,
+			amount:  18_000,
+		},
+		{
+			name:    "percentage of the recommendation",
+			reserve: domain.Reserve{Percent: 80},
+			isValid: true,
+			amount:  20_000,
+		},
+		{
+			name:    "percentage rounded up",
+			reserve: domain.Reserve{Percent: 33},
+			isValid: true,
+			amount:  8_250,
+		},
+		{
+			name:    "percentage above the limit",
+			reserve: domain.Reserve{Percent: domain.MaxReservePercent + 1},
+		},
+		{
+			name:    "price and percentage",
+			reserve: domain.Reserve{Price: 18_000, Percent: 80},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !test.isValid {
+				assert.Error(t, test.reserve.Validate())
+				return
+			}
+			assert.NoError(t, test.reserve.Validate())
+			assert.Equal(t, test.amount, test.reserve.Amount(25_000))
+		})
+	}
+}
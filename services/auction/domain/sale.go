@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// Sale modes of the vehicles sold by the auction service
+const (
+	SaleAuction = "auction"
+	SaleTender  = "tender"
+	SaleDutch   = "dutch"
+)
+
+// StaleClaimAge is how long a claim may wait for its sale to be saved before it is abandoned
+const StaleClaimAge = time.Minute
+
+// ActiveSale claims a vehicle for its one open sale, a vehicle is on sale in one mode at a time,
+// the claim of a sold vehicle is kept once the sale is settled with the vehicle service
+type ActiveSale struct {
+	VIN       string     `json:"vin"`
+	Mode      string     `json:"mode"`
+	SaleID    string     `json:"sale_id"`
+	CreatedAt time.Time  `json:"created_at"`
+	SettledAt *time.Time `json:"settled_at,omitempty"`
+}
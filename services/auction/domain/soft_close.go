@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// SoftClose is the anti-sniping policy of an auction, a bid within the window before the end
+// extends the end by the extension, at most MaxExtensions times unless it is zero
+type SoftClose struct {
+	Window        time.Duration
+	Extension     time.Duration
+	MaxExtensions int
+}
+
+// softCloseJSON is the JSON form of SoftClose with readable durations
+type softCloseJSON struct {
+	Window        string `json:"window"`
+	Extension     string `json:"extension"`
+	MaxExtensions int    `json:"max_extensions"`
+}
+
+// Enabled reports whether late bids extend the auction
+func (s SoftClose) Enabled() bool {
+	return s.Window > 0
+}
+
+// Validate checks if the soft close policy is valid
+func (s SoftClose) Validate() error {
+	if s.Window < 0 || s.Extension < 0 || s.MaxExtensions < 0 {
+		return errors.New("soft close must not be negative")
+	}
+	if s.Enabled() && s.Extension == 0 {
+		return errors.New("soft close window requires an extension")
+	}
+	return nil
+}
+
+// MarshalJSON encodes the policy with durations like "2m0s"
+func (s SoftClose) MarshalJSON() ([]byte, error) {
+	return json.Marshal(softCloseJSON{
+		Window:        s.Window.String(),
+		Extension:     s.Extension.String(),
+		MaxExtensions: s.MaxExtensions,
+	})
+}
+
+// UnmarshalJSON decodes the policy with durations like "2m0s"
+func (s *SoftClose) UnmarshalJSON(data []byte) error {
+	var raw softCloseJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	window, err := time.ParseDuration(raw.Window)
+	if err != nil {
+		return err
+	}
+	extension, err := time.ParseDuration(raw.Extension)
+	if err != nil {
+		return err
+	}
+	*s = SoftClose{Window: window, Extension: extension, MaxExtensions: raw.MaxExtensions}
+	return nil
+}
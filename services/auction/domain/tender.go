@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Tender statuses
+const (
+	TenderOpen   = "open"
+	TenderClosed = "closed"
+)
+
+// Settlement rules of a tender, the highest bid wins and pays its own amount under the first-price rule
+// or the second highest amount under the second-price (Vickrey) rule
+const (
+	FirstPrice  = "first_price"
+	SecondPrice = "second_price"
+)
+
+// Tender represents a sealed-bid tender of a vehicle, every buyer submits one hidden bid during the window
+// and the bids are revealed only when the tender closes
+type Tender struct {
+	ID               string     `json:"id"`
+	VIN              string     `json:"vin"`
+	Status           string     `json:"status"`
+	Settlement       string     `json:"settlement"`
+	RecommendedPrice uint64     `json:"recommended_price"`
+	MinPrice         uint64     `json:"min_price"`
+	BidCount         int        `json:"bid_count"`
+	Winner           string     `json:"winner,omitempty"`
+	SalePrice        uint64     `json:"sale_price,omitempty"`
+	StartsAt         time.Time  `json:"starts_at"`
+	EndsAt           time.Time  `json:"ends_at"`
+	ClosedAt         *time.Time `json:"closed_at,omitempty"`
+	Version          int64      `json:"version"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// SealedBid represents the single hidden bid of a buyer in a tender, ranked once the tender is closed
+type SealedBid struct {
+	ID        int64     `json:"id"`
+	TenderID  string    `json:"tender_id"`
+	Bidder    string    `json:"bidder"`
+	Amount    uint64    `json:"amount,omitempty"`
+	Rank      int       `json:"rank,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TenderResult represents the published outcome of a closed tender with all of its bids, best first
+type TenderResult struct {
+	Tender *Tender      `json:"tender"`
+	Bids   []*SealedBid `json:"bids"`
+}
+
+// NewTender creates a new open tender of the vehicle, a zero minimum price defaults to half of the recommended price
+// and an empty settlement to the first-price rule
+func NewTender(id, vin, settlement string, recommendedPrice, minPrice uint64, startsAt, endsAt time.Time) *Tender {
+	if settlement == "" {
+		settlement = FirstPrice
+	}
+	if minPrice == 0 {
+		minPrice = max(recommendedPrice/2, MinIncrement(recommendedPrice))
+	}
+	return &Tender{
+		ID:               id,
+		VIN:              vin,
+		Status:           TenderOpen,
+		Settlement:       settlement,
+		RecommendedPrice: recommendedPrice,
+		MinPrice:         minPrice,
+		StartsAt:         startsAt.UTC(),
+		EndsAt:           endsAt.UTC(),
+		CreatedAt:        time.Now().UTC(),
+	}
+}
+
+// Validate checks if the tender data is valid
+func (t *Tender) Validate() error {
+	return validation.ValidateStruct(
+		t,
+		validation.Field(
+			&t.ID,
+			validation.Required,
+		),
+		validation.Field(
+			&t.VIN,
+			validation.Required,
+			validation.Length(17, 17),
+		),
+		validation.Field(
+			&t.Settlement,
+			validation.Required,
+			validation.In(FirstPrice, SecondPrice),
+		),
+		validation.Field(
+			&t.StartsAt,
+			validation.Required,
+		),
+		validation.Field(
+			&t.EndsAt,
+			validation.Required,
+			validation.By(t.validateDuration),
+		),
+	)
+}
+
+// validateDuration checks that the bidding window lasts within the auction duration limits
+func (t *Tender) validateDuration(any) error {
+	d := t.EndsAt.Sub(t.StartsAt)
+	if d < MinAuctionDuration || d > MaxAuctionDuration {
+		return errors.New("tender must last between " + MinAuctionDuration.String() + " and " + MaxAuctionDuration.String())
+	}
+	return nil
+}
+
+// IsOpenAt reports whether the tender accepts bids at the given time
+func (t *Tender) IsOpenAt(now time.Time) bool {
+	return t.Status == TenderOpen && !now.Before(t.StartsAt) && now.Before(t.EndsAt)
+}
+
+// IsDue reports whether the open tender has reached its end time
+func (t *Tender) IsDue(now time.Time) bool {
+	return t.Status == TenderOpen && !now.Before(t.EndsAt)
+}
+
+// SubmitBid accepts the sealed bid into the tender, whether the bidder has already bid is up to the repository
+func (t *Tender) SubmitBid(b *SealedBid, now time.Time) error {
+	if !t.IsOpenAt(now) {
+		return ErrTenderNotOpen
+	}
+	if b.Bidder == "" {
+		return ErrValidation
+	}
+	if b.Amount < t.MinPrice {
+		return &BidTooLowError{MinBid: t.MinPrice}
+	}
+	b.TenderID = t.ID
+	b.Rank = 0
+	b.CreatedAt = now.UTC()
+	t.BidCount++
+	return nil
+}
+
+// Close closes the tender and settles it on the given bids, the earliest of equal bids wins
+func (t *Tender) Close(bids []*SealedBid, now time.Time) {
+	ranked := RankBids(bids)
+	closedAt := now.UTC()
+	t.Status = TenderClosed
+	t.ClosedAt = &closedAt
+	if len(ranked) == 0 {
+		return
+	}
+	t.Winner = ranked[0].Bidder
+	t.SalePrice = ranked[0].Amount
+	if t.Settlement == SecondPrice {
+		t.SalePrice = t.MinPrice
+		if len(ranked) > 1 {
+			t.SalePrice = ranked[1].Amount
+		}
+	}
+}
+
+// Results reveals the bids of the closed tender, best first
+func (t *Tender) Results(bids []*SealedBid) (*TenderResult, error) {
+	if t.Status != TenderClosed {
+		return nil, ErrTenderSealed
+	}
+	return &TenderResult{Tender: t, Bids: RankBids(bids)}, nil
+}
+
+// RankBids sorts the bids by amount, highest first, and then by submission order and sets their ranks
+func RankBids(bids []*SealedBid) []*SealedBid {
+	ranked := slices.Clone(bids)
+	slices.SortStableFunc(ranked, func(a, b *SealedBid) int {
+		if c := cmp.Compare(b.Amount, a.Amount); c != 0 {
+			return c
+		}
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	for i, b := range ranked {
+		b.Rank = i + 1
+	}
+	return ranked
+}
+
+// Receipt returns the bid as confirmed to the bidder, without the amount that stays sealed until the reveal
+func (b *SealedBid) Receipt() *SealedBid {
+	r := *b
+	r.Amount = 0
+	return &r
+}
@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestTender is a test valid tender lasting an hour
+func newTestTender(settlement string) *domain.Tender {
+	return domain.NewTender("tender-1", "1HGCM82633A123456", settlement, 25_000, 0, testStart, testStart.Add(time.Hour))
+}
+
+// TestNewTender tests the NewTender function
+func TestNewTender(t *testing.T) {
+	tender := newTestTender("")
+	assert.Equal(t, domain.TenderOpen, tender.Status)
+	assert.Equal(t, domain.FirstPrice, tender.Settlement)
+	assert.Equal(t, uint64(12_500), tender.MinPrice)
+	assert.NoError(t, tender.Validate())
+
+	tender = newTestTender("english")
+	assert.Error(t, tender.Validate())
+	tender = domain.NewTender("tender-1", "1HGCM82633A123456", domain.SecondPrice, 25_000, 0, testStart, testStart.Add(time.Second))
+	assert.Error(t, tender.Validate())
+}
+
+// TestTender_SubmitBid tests the SubmitBid method of the Tender struct
+func TestTender_SubmitBid(t *testing.T) {
+	tests := []struct {
+		name string
+		bid  domain.SealedBid
+		at   time.Time
+		err  error
+	}{
+		{name: "valid bid", bid: domain.SealedBid{Bidder: "alice", Amount: 20_000}, at: testStart},
+		{name: "at the minimum price", bid: domain.SealedBid{Bidder: "alice", Amount: 12_500}, at: testStart},
+		{name: "below the minimum price", bid: domain.SealedBid{Bidder: "alice", Amount: 12_499}, at: testStart, err: domain.ErrBidTooLow},
+		{name: "no bidder", bid: domain.SealedBid{Amount: 20_000}, at: testStart, err: domain.ErrValidation},
+		{name: "before start", bid: domain.SealedBid{Bidder: "alice", Amount: 20_000}, at: testStart.Add(-time.Second), err: domain.ErrTenderNotOpen},
+		{name: "at end", bid: domain.SealedBid{Bidder: "alice", Amount: 20_000}, at: testStart.Add(time.Hour), err: domain.ErrTenderNotOpen},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tender := newTestTender(domain.FirstPrice)
+			err := tender.SubmitBid(&test.bid, test.at)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+				assert.Zero(t, tender.BidCount)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 1, tender.BidCount)
+			assert.Equal(t, tender.ID, test.bid.TenderID)
+		})
+	}
+}
+
+// TestTender_Close tests the settlement of a tender under both rules
+func TestTender_Close(t *testing.T) {
+	bid := func(id int64, bidder string, amount uint64) *domain.SealedBid {
+		return &domain.SealedBid{ID: id, Bidder: bidder, Amount: amount, CreatedAt: testStart.Add(time.Duration(id) * time.Minute)}
+	}
+	tests := []struct {
+		name       string
+		settlement string
+		bids       []*domain.SealedBid
+		winner     string
+		price      uint64
+	}{
+		{name: "first price", settlement: domain.FirstPrice, bids: []*domain.SealedBid{bid(1, "alice", 20_000), bid(2, "bob", 22_000), bid(3, "carol", 21_000)}, winner: "bob", price: 22_000},
+		{name: "second price", settlement: domain.SecondPrice, bids: []*domain.SealedBid{bid(1, "alice", 20_000), bid(2, "bob", 22_000), bid(3, "carol", 21_000)}, winner: "bob", price: 21_000},
+		{name: "second price with a single bid", settlement: domain.SecondPrice, bids: []*domain.SealedBid{bid(1, "alice", 20_000)}, winner: "alice", price: 12_500},
+		{name: "tie goes to the earliest bid", settlement: domain.FirstPrice, bids: []*domain.SealedBid{bid(2, "bob", 20_000), bid(1, "alice", 20_000)}, winner: "alice", price: 20_000},
+		{name: "second price tie", settlement: domain.SecondPrice, bids: []*domain.SealedBid{bid(2, "bob", 20_000), bid(1, "alice", 20_000)}, winner: "alice", price: 20_000},
+		{name: "no bids", settlement: domain.FirstPrice},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tender := newTestTender(test.settlement)
+			_, err := tender.Results(test.bids)
+			assert.ErrorIs(t, err, domain.ErrTenderSealed)
+
+			tender.Close(test.bids, testStart.Add(time.Hour))
+			assert.Equal(t, domain.TenderClosed, tender.Status)
+			assert.Equal(t, test.winner, tender.Winner)
+			assert.Equal(t, test.price, tender.SalePrice)
+
+			res, err := tender.Results(test.bids)
+			assert.NoError(t, err)
+			assert.Len(t, res.Bids, len(test.bids))
+			for i, b := range res.Bids {
+				assert.Equal(t, i+1, b.Rank)
+			}
+		})
+	}
+}
+
+// TestSealedBid_Receipt tests that the receipt of a sealed bid hides its amount
+func TestSealedBid_Receipt(t *testing.T) {
+	b := &domain.SealedBid{ID: 1, Bidder: "alice", Amount: 20_000}
+	r := b.Receipt()
+	assert.Zero(t, r.Amount)
+	assert.Equal(t, "alice", r.Bidder)
+	assert.Equal(t, uint64(20_000), b.Amount)
+}
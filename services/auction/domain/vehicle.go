@@ -0,0 +1,29 @@
+package domain
+
+// VehicleListed is the lifecycle status of the vehicles that may be put on sale
+const VehicleListed = "listed"
+
+// VehicleClaimed is the sale status of the vehicles claimed by a sale
+const VehicleClaimed = "claimed"
+
+// Vehicle represents the auctioned vehicle as known by the vehicle service
+type Vehicle struct {
+	VIN        string `json:"vin"`
+	Brand      string `json:"brand"`
+	Year       int32  `json:"year"`
+	Price      uint64 `json:"price"`
+	Lifecycle  string `json:"lifecycle"`
+	SaleStatus string `json:"sale_status"`
+}
+
+// CheckSellable reports whether the vehicle may be put on sale, it must be listed and not on a buy-now sale
+// or claimed by another sale
+func (v *Vehicle) CheckSellable() error {
+	if v.SaleStatus == VehicleClaimed {
+		return ErrSaleExists
+	}
+	if v.Lifecycle != VehicleListed || v.SaleStatus != "" {
+		return ErrNotForSale
+	}
+	return nil
+}
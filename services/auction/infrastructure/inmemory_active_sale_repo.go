@@ -0,0 +1,68 @@
+package infrastructure
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// MemoryActiveSaleRepo is an in-memory implementation of ActiveSaleRepository interface
+type MemoryActiveSaleRepo struct {
+	mu    sync.Mutex
+	sales map[string]*domain.ActiveSale
+}
+
+// NewMemoryActiveSaleRepo creates a new instance of MemoryActiveSaleRepo
+func NewMemoryActiveSaleRepo() *MemoryActiveSaleRepo {
+	return &MemoryActiveSaleRepo{sales: make(map[string]*domain.ActiveSale)}
+}
+
+// Claim claims the vehicle for the sale unless it is already on sale
+func (r *MemoryActiveSaleRepo) Claim(ctx context.Context, s *domain.ActiveSale) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.sales[s.VIN]; ok {
+		return domain.ErrSaleExists
+	}
+	claimed := *s
+	r.sales[s.VIN] = &claimed
+	return nil
+}
+
+// Release releases the claim of the sale on the vehicle, releasing a claim of another sale does nothing
+func (r *MemoryActiveSaleRepo) Release(ctx context.Context, vin, saleID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := r.sales[vin]; ok && s.SaleID == saleID {
+		delete(r.sales, vin)
+	}
+	return nil
+}
+
+// Settle marks the claim of the sale on the vehicle as settled, settling a claim of another sale does nothing
+func (r *MemoryActiveSaleRepo) Settle(ctx context.Context, vin, saleID string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := r.sales[vin]; ok && s.SaleID == saleID && s.SettledAt == nil {
+		s.SettledAt = &at
+	}
+	return nil
+}
+
+// ListUnsettled lists the claims whose sale has not been settled yet, oldest first
+func (r *MemoryActiveSaleRepo) ListUnsettled(ctx context.Context) ([]*domain.ActiveSale, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var sales []*domain.ActiveSale
+	for _, s := range r.sales {
+		if s.SettledAt == nil {
+			c := *s
+			sales = append(sales, &c)
+		}
+	}
+	slices.SortFunc(sales, func(a, b *domain.ActiveSale) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return sales, nil
+}
@@ -0,0 +1,134 @@
+package infrastructure
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// MemoryAuctionRepo is an in-memory implementation of AuctionRepository interface
+type MemoryAuctionRepo struct {
+	mu       sync.RWMutex
+	auctions map[string]*domain.Auction
+	bids     map[string][]*domain.Bid
+	lastBid  int64
+}
+
+// NewMemoryAuctionRepo creates a new instance of MemoryAuctionRepo
+func NewMemoryAuctionRepo() *MemoryAuctionRepo {
+	return &MemoryAuctionRepo{
+		auctions: make(map[string]*domain.Auction),
+		bids:     make(map[string][]*domain.Bid),
+	}
+}
+
+// Save saves a new auction to the in-memory store, a vehicle can have one unfinished auction only
+func (r *MemoryAuctionRepo) Save(ctx context.Context, a *domain.Auction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.auctions {
+		if stored.VIN == a.VIN && stored.Status != domain.AuctionClosed {
+			return domain.ErrAuctionExists
+		}
+	}
+	a.Version = 1
+	stored := *a
+	r.auctions[a.ID] = &stored
+	return nil
+}
+
+// FindByID finds an auction by its ID in the in-memory store
+func (r *MemoryAuctionRepo) FindByID(ctx context.Context, id string) (*domain.Auction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stored, ok := r.auctions[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	a := *stored
+	return &a, nil
+}
+
+// FindByVIN finds the latest auction of a vehicle in the in-memory store
+func (r *MemoryAuctionRepo) FindByVIN(ctx context.Context, vin string) (*domain.Auction, error) {
+	auctions := r.list(func(a *domain.Auction) bool {
+		return a.VIN == vin
+	})
+	if len(auctions) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return auctions[0], nil
+}
+
+// Update updates an auction guarded by its version and appends the bids placed in it
+func (r *MemoryAuctionRepo) Update(ctx context.Context, a *domain.Auction, bids ...*domain.Bid) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.auctions[a.ID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if a.Version != stored.Version {
+		return domain.ErrVersionConflict
+	}
+	for _, b := range bids {
+		r.lastBid++
+		b.ID = r.lastBid
+		saved := *b
+		r.bids[a.ID] = append(r.bids[a.ID], &saved)
+	}
+	a.Version++
+	updated := *a
+	r.auctions[a.ID] = &updated
+	return nil
+}
+
+// List lists auctions of the status, all of them for an empty status, newest first
+func (r *MemoryAuctionRepo) List(ctx context.Context, status string) ([]*domain.Auction, error) {
+	return r.list(func(a *domain.Auction) bool {
+		return status == "" || a.Status == status
+	}), nil
+}
+
+// ListDue lists open auctions whose end time has passed and if-sale auctions whose negotiation deadline has passed
+func (r *MemoryAuctionRepo) ListDue(ctx context.Context, now time.Time) ([]*domain.Auction, error) {
+	return r.list(func(a *domain.Auction) bool {
+		return a.IsDue(now)
+	}), nil
+}
+
+// list returns copies of the auctions matching the filter, newest first
+func (r *MemoryAuctionRepo) list(match func(*domain.Auction) bool) []*domain.Auction {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	auctions := []*domain.Auction{}
+	for _, stored := range r.auctions {
+		if match(stored) {
+			a := *stored
+			auctions = append(auctions, &a)
+		}
+	}
+	slices.SortFunc(auctions, func(a, b *domain.Auction) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return auctions
+}
+
+// Bids lists the bids of an auction, oldest first
+func (r *MemoryAuctionRepo) Bids(ctx context.Context, auctionID string) ([]*domain.Bid, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	bids := make([]*domain.Bid, len(r.bids[auctionID]))
+	for i, stored := range r.bids[auctionID] {
+		b := *stored
+		bids[i] = &b
+	}
+	return bids, nil
+}
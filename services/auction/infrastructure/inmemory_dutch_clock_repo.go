@@ -0,0 +1,100 @@
+package infrastructure
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// MemoryDutchClockRepo is an in-memory implementation of DutchClockRepository interface
+type MemoryDutchClockRepo struct {
+	mu     sync.RWMutex
+	clocks map[string]*domain.DutchClock
+}
+
+// NewMemoryDutchClockRepo creates a new instance of MemoryDutchClockRepo
+func NewMemoryDutchClockRepo() *MemoryDutchClockRepo {
+	return &MemoryDutchClockRepo{clocks: make(map[string]*domain.DutchClock)}
+}
+
+// Save saves a new clock to the in-memory store, a vehicle can have one running clock only
+func (r *MemoryDutchClockRepo) Save(ctx context.Context, c *domain.DutchClock) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.clocks {
+		if stored.VIN == c.VIN && stored.Status == domain.ClockRunning {
+			return domain.ErrClockExists
+		}
+	}
+	c.Version = 1
+	stored := *c
+	r.clocks[c.ID] = &stored
+	return nil
+}
+
+// FindByID finds a clock by its ID in the in-memory store
+func (r *MemoryDutchClockRepo) FindByID(ctx context.Context, id string) (*domain.DutchClock, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stored, ok := r.clocks[id]
+	if !ok {
+		return nil, domain.ErrClockNotFound
+	}
+	c := *stored
+	return &c, nil
+}
+
+// Update updates a clock guarded by its version
+func (r *MemoryDutchClockRepo) Update(ctx context.Context, c *domain.DutchClock) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.clocks[c.ID]
+	if !ok {
+		return domain.ErrClockNotFound
+	}
+	if c.Version != stored.Version {
+		return domain.ErrVersionConflict
+	}
+	c.Version++
+	updated := *c
+	r.clocks[c.ID] = &updated
+	return nil
+}
+
+// List lists clocks of the status, all of them for an empty status, newest first
+func (r *MemoryDutchClockRepo) List(ctx context.Context, status string) ([]*domain.DutchClock, error) {
+	return r.list(func(c *domain.DutchClock) bool {
+		return status == "" || c.Status == status
+	}), nil
+}
+
+// ListDue lists running clocks whose end time has passed
+func (r *MemoryDutchClockRepo) ListDue(ctx context.Context, now time.Time) ([]*domain.DutchClock, error) {
+	return r.list(func(c *domain.DutchClock) bool {
+		return c.IsDue(now)
+	}), nil
+}
+
+// list returns copies of the clocks matching the filter, newest first
+func (r *MemoryDutchClockRepo) list(match func(*domain.DutchClock) bool) []*domain.DutchClock {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	clocks := []*domain.DutchClock{}
+	for _, stored := range r.clocks {
+		if match(stored) {
+			c := *stored
+			clocks = append(clocks, &c)
+		}
+	}
+	slices.SortFunc(clocks, func(a, b *domain.DutchClock) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return clocks
+}
@@ -0,0 +1,141 @@
+package infrastructure
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// MemoryTenderRepo is an in-memory implementation of TenderRepository interface
+type MemoryTenderRepo struct {
+	mu      sync.RWMutex
+	tenders map[string]*domain.Tender
+	bids    map[string][]*domain.SealedBid
+	lastBid int64
+}
+
+// NewMemoryTenderRepo creates a new instance of MemoryTenderRepo
+func NewMemoryTenderRepo() *MemoryTenderRepo {
+	return &MemoryTenderRepo{
+		tenders: make(map[string]*domain.Tender),
+		bids:    make(map[string][]*domain.SealedBid),
+	}
+}
+
+// Save saves a new tender to the in-memory store, a vehicle can have one open tender only
+func (r *MemoryTenderRepo) Save(ctx context.Context, t *domain.Tender) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.tenders {
+		if stored.VIN == t.VIN && stored.Status == domain.TenderOpen {
+			return domain.ErrTenderExists
+		}
+	}
+	t.Version = 1
+	stored := *t
+	r.tenders[t.ID] = &stored
+	return nil
+}
+
+// FindByID finds a tender by its ID in the in-memory store
+func (r *MemoryTenderRepo) FindByID(ctx context.Context, id string) (*domain.Tender, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stored, ok := r.tenders[id]
+	if !ok {
+		return nil, domain.ErrTenderNotFound
+	}
+	t := *stored
+	return &t, nil
+}
+
+// Update updates a tender guarded by its version
+func (r *MemoryTenderRepo) Update(ctx context.Context, t *domain.Tender) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.update(t)
+}
+
+// update replaces the stored tender if its version matches, the caller must hold the lock
+func (r *MemoryTenderRepo) update(t *domain.Tender) error {
+	stored, ok := r.tenders[t.ID]
+	if !ok {
+		return domain.ErrTenderNotFound
+	}
+	if t.Version != stored.Version {
+		return domain.ErrVersionConflict
+	}
+	t.Version++
+	updated := *t
+	r.tenders[t.ID] = &updated
+	return nil
+}
+
+// SaveBid updates the tender guarded by its version and adds the bid, one bid per bidder
+func (r *MemoryTenderRepo) SaveBid(ctx context.Context, t *domain.Tender, b *domain.SealedBid) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if slices.ContainsFunc(r.bids[t.ID], func(stored *domain.SealedBid) bool {
+		return stored.Bidder == b.Bidder
+	}) {
+		return domain.ErrAlreadyBid
+	}
+	if err := r.update(t); err != nil {
+		return err
+	}
+	r.lastBid++
+	b.ID = r.lastBid
+	saved := *b
+	r.bids[t.ID] = append(r.bids[t.ID], &saved)
+	return nil
+}
+
+// List lists tenders of the status, all of them for an empty status, newest first
+func (r *MemoryTenderRepo) List(ctx context.Context, status string) ([]*domain.Tender, error) {
+	return r.list(func(t *domain.Tender) bool {
+		return status == "" || t.Status == status
+	}), nil
+}
+
+// ListDue lists open tenders whose end time has passed
+func (r *MemoryTenderRepo) ListDue(ctx context.Context, now time.Time) ([]*domain.Tender, error) {
+	return r.list(func(t *domain.Tender) bool {
+		return t.IsDue(now)
+	}), nil
+}
+
+// list returns copies of the tenders matching the filter, newest first
+func (r *MemoryTenderRepo) list(match func(*domain.Tender) bool) []*domain.Tender {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tenders := []*domain.Tender{}
+	for _, stored := range r.tenders {
+		if match(stored) {
+			t := *stored
+			tenders = append(tenders, &t)
+		}
+	}
+	slices.SortFunc(tenders, func(a, b *domain.Tender) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return tenders
+}
+
+// Bids lists the bids of a tender, oldest first
+func (r *MemoryTenderRepo) Bids(ctx context.Context, tenderID string) ([]*domain.SealedBid, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	bids := make([]*domain.SealedBid, len(r.bids[tenderID]))
+	for i, stored := range r.bids[tenderID] {
+		b := *stored
+		bids[i] = &b
+	}
+	return bids, nil
+}
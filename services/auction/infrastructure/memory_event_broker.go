@@ -0,0 +1,104 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// MemoryEventBroker is an in-memory implementation of EventBroker interface,
+// it keeps the latest events of each VIN so that watchers can resume after a disconnect
+type MemoryEventBroker struct {
+	mu          sync.Mutex
+	lastID      int64
+	history     map[string][]*domain.AuctionEvent
+	subscribers map[string]map[chan *domain.AuctionEvent]struct{}
+	historySize int
+	bufferSize  int
+}
+
+// NewMemoryEventBroker creates a new instance of MemoryEventBroker keeping historySize events per VIN
+// and buffering bufferSize events per subscriber, event IDs start from the creation time in microseconds,
+// so that they keep increasing across restarts unless more than one event per microsecond was published
+func NewMemoryEventBroker(historySize, bufferSize int) *MemoryEventBroker {
+	return &MemoryEventBroker{
+		lastID:      time.Now().UnixMicro(),
+		history:     make(map[string][]*domain.AuctionEvent),
+		subscribers: make(map[string]map[chan *domain.AuctionEvent]struct{}),
+		historySize: historySize,
+		bufferSize:  bufferSize,
+	}
+}
+
+// Publish assigns the next event ID and delivers the event to the subscribers of its VIN,
+// subscribers whose buffer is full are dropped instead of slowing down the others
+func (b *MemoryEventBroker) Publish(e *domain.AuctionEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lastID++
+	e.ID = b.lastID
+
+	// Remember the event for resuming watchers
+	history := append(b.history[e.VIN], e)
+	if len(history) > b.historySize {
+		history = history[len(history)-b.historySize:]
+	}
+	b.history[e.VIN] = history
+
+	// Deliver the event
+	for ch := range b.subscribers[e.VIN] {
+		select {
+		case ch <- e:
+		default:
+			b.unsubscribe(e.VIN, ch)
+		}
+	}
+}
+
+// Subscribe returns the events of the VIN published after lastEventID followed by the live ones,
+// the channel is closed when the context is done or the subscriber falls behind
+func (b *MemoryEventBroker) Subscribe(ctx context.Context, vin string, lastEventID int64) <-chan *domain.AuctionEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Replay the missed events
+	var missed []*domain.AuctionEvent
+	if lastEventID > 0 {
+		for _, e := range b.history[vin] {
+			if e.ID > lastEventID {
+				missed = append(missed, e)
+			}
+		}
+	}
+	ch := make(chan *domain.AuctionEvent, b.bufferSize+len(missed))
+	for _, e := range missed {
+		ch <- e
+	}
+
+	// Register the subscriber until the context is done
+	if b.subscribers[vin] == nil {
+		b.subscribers[vin] = make(map[chan *domain.AuctionEvent]struct{})
+	}
+	b.subscribers[vin][ch] = struct{}{}
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.unsubscribe(vin, ch)
+	}()
+	return ch
+}
+
+// unsubscribe removes the subscriber of the VIN and closes its channel, it must be called with the lock held
+func (b *MemoryEventBroker) unsubscribe(vin string, ch chan *domain.AuctionEvent) {
+	if _, ok := b.subscribers[vin][ch]; !ok {
+		return
+	}
+	delete(b.subscribers[vin], ch)
+	if len(b.subscribers[vin]) == 0 {
+		delete(b.subscribers, vin)
+	}
+	close(ch)
+}
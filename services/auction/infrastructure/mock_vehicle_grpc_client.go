@@ -0,0 +1,99 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// mockSale is the sale claiming a vehicle of the mock, settled with its buyer
+type mockSale struct {
+	id      string
+	buyer   string
+	settled bool
+}
+
+// MockVehicleProvider is a mock implementation of the VehicleProvider interface for testing purposes,
+// it keeps the sales claiming the vehicles like the vehicle service does
+type MockVehicleProvider struct {
+	Data      *domain.Vehicle
+	Err       error
+	SettleErr error // returned by SettleSale when set
+
+	mu    sync.Mutex
+	sales map[string]*mockSale
+}
+
+// GetVehicle simulates fetching a vehicle, the data is returned for any VIN
+// with the state of the sale claiming it
+func (m *MockVehicleProvider) GetVehicle(ctx context.Context, vin string) (*domain.Vehicle, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	v := *m.Data
+	v.VIN = vin
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s := m.sales[vin]; s != nil && !s.settled {
+		v.SaleStatus = domain.VehicleClaimed
+	} else if s != nil && s.buyer != "" {
+		v.Lifecycle = "sold"
+		v.SaleStatus = "sold"
+	}
+	return &v, nil
+}
+
+// ClaimSale simulates claiming the vehicle for the sale, a vehicle claimed by another sale or sold is not for sale
+func (m *MockVehicleProvider) ClaimSale(ctx context.Context, vin, mode, saleID string) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s := m.sales[vin]; s != nil && s.id != saleID && (!s.settled || s.buyer != "") {
+		return domain.ErrNotForSale
+	}
+	if m.sales == nil {
+		m.sales = make(map[string]*mockSale)
+	}
+	m.sales[vin] = &mockSale{id: saleID}
+	return nil
+}
+
+// SettleSale simulates recording the outcome of the sale
+func (m *MockVehicleProvider) SettleSale(ctx context.Context, vin, mode, saleID, buyer string, price uint64) error {
+	if m.SettleErr != nil {
+		return m.SettleErr
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s := m.sales[vin]; s != nil && s.id == saleID && !s.settled {
+		s.buyer = buyer
+		s.settled = true
+	}
+	return nil
+}
+
+// ReleaseSale simulates dropping the claim of the sale
+func (m *MockVehicleProvider) ReleaseSale(ctx context.Context, vin, saleID string) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s := m.sales[vin]; s != nil && s.id == saleID && !s.settled {
+		delete(m.sales, vin)
+	}
+	return nil
+}
+
+// Sale returns the sale claiming the vehicle, whether it is settled and its buyer
+func (m *MockVehicleProvider) Sale(vin string) (id string, settled bool, buyer string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s := m.sales[vin]; s != nil {
+		return s.id, s.settled, s.buyer
+	}
+	return "", false, ""
+}
@@ -0,0 +1,83 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresActiveSaleRepo is a PostgreSQL implementation of ActiveSaleRepository interface
+type PostgresActiveSaleRepo struct {
+	db      *pgxpool.Pool
+	timeout time.Duration
+}
+
+// NewPostgresActiveSaleRepo creates a new instance of PostgresActiveSaleRepo, the timeout limits every operation
+func NewPostgresActiveSaleRepo(conn string, timeout time.Duration) (*PostgresActiveSaleRepo, error) {
+	pool, err := pgxpool.New(context.Background(), conn)
+	if err != nil {
+		return nil, err
+	}
+	return &PostgresActiveSaleRepo{db: pool, timeout: timeout}, nil
+}
+
+// Claim claims the vehicle for the sale unless it is already on sale or sold
+func (r *PostgresActiveSaleRepo) Claim(ctx context.Context, s *domain.ActiveSale) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO active_sales (vin, mode, sale_id, created_at) VALUES ($1, $2, $3, $4)`,
+		s.VIN, s.Mode, s.SaleID, s.CreatedAt,
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrSaleExists
+	}
+	return err
+}
+
+// Release releases the claim of the sale on the vehicle, releasing a claim of another sale does nothing
+func (r *PostgresActiveSaleRepo) Release(ctx context.Context, vin, saleID string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	_, err := r.db.Exec(ctx, `DELETE FROM active_sales WHERE vin=$1 AND sale_id=$2`, vin, saleID)
+	return err
+}
+
+// Settle marks the claim of the sale on the vehicle as settled, settling a claim of another sale does nothing
+func (r *PostgresActiveSaleRepo) Settle(ctx context.Context, vin, saleID string, at time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	_, err := r.db.Exec(ctx,
+		`UPDATE active_sales SET settled_at=$1 WHERE vin=$2 AND sale_id=$3 AND settled_at IS NULL`,
+		at, vin, saleID,
+	)
+	return err
+}
+
+// ListUnsettled lists the claims whose sale has not been settled yet, oldest first
+func (r *PostgresActiveSaleRepo) ListUnsettled(ctx context.Context) ([]*domain.ActiveSale, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT vin, mode, sale_id, created_at FROM active_sales WHERE settled_at IS NULL ORDER BY created_at`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sales []*domain.ActiveSale
+	for rows.Next() {
+		var s domain.ActiveSale
+		if err := rows.Scan(&s.VIN, &s.Mode, &s.SaleID, &s.CreatedAt); err != nil {
+			return nil, err
+		}
+		sales = append(sales, &s)
+	}
+	return sales, rows.Err()
+}
@@ -0,0 +1,197 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// auctionColumns are the columns scanned by scanAuction
+const auctionColumns = `id, vin, status, recommended_price, start_price, increment, reserve_price, reserve_met,
+	high_bid, leader, leader_max, bid_count, counter_offer, winner, sale_price,
+	starts_at, ends_at, soft_close_window, soft_close_extension, soft_close_max_extensions, extensions,
+	if_sale_ends_at, closed_at, version, created_at`
+
+// PostgresAuctionRepo is a PostgreSQL implementation of AuctionRepository interface
+type PostgresAuctionRepo struct {
+	db      *pgxpool.Pool
+	timeout time.Duration
+}
+
+// NewPostgresAuctionRepo creates a new instance of PostgresAuctionRepo, the timeout limits every operation
+func NewPostgresAuctionRepo(conn string, timeout time.Duration) (*PostgresAuctionRepo, error) {
+	pool, err := pgxpool.New(context.Background(), conn)
+	if err != nil {
+		return nil, err
+	}
+	return &PostgresAuctionRepo{db: pool, timeout: timeout}, nil
+}
+
+// scanAuction scans a row of auctionColumns into an auction
+func scanAuction(row pgx.Row) (*domain.Auction, error) {
+	var a domain.Auction
+	err := row.Scan(
+		&a.ID, &a.VIN, &a.Status, &a.RecommendedPrice, &a.StartPrice, &a.Increment, &a.ReservePrice, &a.ReserveMet,
+		&a.HighBid, &a.Leader, &a.LeaderMax, &a.BidCount, &a.CounterOffer, &a.Winner, &a.SalePrice,
+		&a.StartsAt, &a.EndsAt, &a.SoftClose.Window, &a.SoftClose.Extension, &a.SoftClose.MaxExtensions, &a.Extensions,
+		&a.IfSaleEndsAt, &a.ClosedAt, &a.Version, &a.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// Save saves a new auction to the PostgreSQL database, a vehicle can have one unfinished auction only
+func (r *PostgresAuctionRepo) Save(ctx context.Context, a *domain.Auction) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	a.Version = 1
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO auctions (`+auctionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25)`,
+		a.ID, a.VIN, a.Status, a.RecommendedPrice, a.StartPrice, a.Increment, a.ReservePrice, a.ReserveMet,
+		a.HighBid, a.Leader, a.LeaderMax, a.BidCount, a.CounterOffer, a.Winner, a.SalePrice,
+		a.StartsAt, a.EndsAt, a.SoftClose.Window, a.SoftClose.Extension, a.SoftClose.MaxExtensions, a.Extensions,
+		a.IfSaleEndsAt, a.ClosedAt, a.Version, a.CreatedAt,
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrAuctionExists
+	}
+	return err
+}
+
+// FindByID retrieves an auction by its ID
+func (r *PostgresAuctionRepo) FindByID(ctx context.Context, id string) (*domain.Auction, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	return scanAuction(r.db.QueryRow(ctx, `SELECT `+auctionColumns+` FROM auctions WHERE id=$1`, id))
+}
+
+// FindByVIN retrieves the latest auction of a vehicle
+func (r *PostgresAuctionRepo) FindByVIN(ctx context.Context, vin string) (*domain.Auction, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	return scanAuction(r.db.QueryRow(ctx,
+		`SELECT `+auctionColumns+` FROM auctions WHERE vin=$1 ORDER BY created_at DESC, id LIMIT 1`, vin,
+	))
+}
+
+// Update updates an auction guarded by its version and inserts the bids placed in it within one transaction
+func (r *PostgresAuctionRepo) Update(ctx context.Context, a *domain.Auction, bids ...*domain.Bid) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	// Update the auction if nobody changed it in the meantime
+	tag, err := tx.Exec(ctx,
+		`UPDATE auctions SET status=$1, high_bid=$2, leader=$3, leader_max=$4, bid_count=$5, reserve_met=$6, counter_offer=$7,
+		winner=$8, sale_price=$9, ends_at=$10, extensions=$11, if_sale_ends_at=$12, closed_at=$13, version=version+1
+		WHERE id=$14 AND version=$15`,
+		a.Status, a.HighBid, a.Leader, a.LeaderMax, a.BidCount, a.ReserveMet, a.CounterOffer,
+		a.Winner, a.SalePrice, a.EndsAt, a.Extensions, a.IfSaleEndsAt, a.ClosedAt, a.ID, a.Version,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		if _, err := r.FindByID(ctx, a.ID); err != nil {
+			return err
+		}
+		return domain.ErrVersionConflict
+	}
+
+	// Insert the bids
+	for _, b := range bids {
+		err := tx.QueryRow(ctx,
+			`INSERT INTO bids (auction_id, bidder, amount, auto, created_at) VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+			a.ID, b.Bidder, b.Amount, b.Auto, b.CreatedAt,
+		).Scan(&b.ID)
+		if err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	a.Version++
+	return nil
+}
+
+// List lists auctions of the status, all of them for an empty status, newest first
+func (r *PostgresAuctionRepo) List(ctx context.Context, status string) ([]*domain.Auction, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT `+auctionColumns+` FROM auctions WHERE $1 = '' OR status = $1 ORDER BY created_at DESC, id`, status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return collectAuctions(rows)
+}
+
+// ListDue lists open auctions whose end time has passed and if-sale auctions whose negotiation deadline has passed
+func (r *PostgresAuctionRepo) ListDue(ctx context.Context, now time.Time) ([]*domain.Auction, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT `+auctionColumns+` FROM auctions
+		WHERE (status = $1 AND ends_at <= $3) OR (status = $2 AND if_sale_ends_at <= $3)
+		ORDER BY ends_at`,
+		domain.AuctionOpen, domain.AuctionIfSale, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return collectAuctions(rows)
+}
+
+// collectAuctions scans all rows into auctions
+func collectAuctions(rows pgx.Rows) ([]*domain.Auction, error) {
+	defer rows.Close()
+	auctions := []*domain.Auction{}
+	for rows.Next() {
+		a, err := scanAuction(rows)
+		if err != nil {
+			return nil, err
+		}
+		auctions = append(auctions, a)
+	}
+	return auctions, rows.Err()
+}
+
+// Bids lists the bids of an auction, oldest first
+func (r *PostgresAuctionRepo) Bids(ctx context.Context, auctionID string) ([]*domain.Bid, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT id, auction_id, bidder, amount, auto, created_at FROM bids WHERE auction_id=$1 ORDER BY id`, auctionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	bids := []*domain.Bid{}
+	for rows.Next() {
+		var b domain.Bid
+		if err := rows.Scan(&b.ID, &b.AuctionID, &b.Bidder, &b.Amount, &b.Auto, &b.CreatedAt); err != nil {
+			return nil, err
+		}
+		bids = append(bids, &b)
+	}
+	return bids, rows.Err()
+}
@@ -0,0 +1,134 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// dutchClockColumns are the columns scanned by scanDutchClock
+const dutchClockColumns = `id, vin, status, recommended_price, start_price, floor_price, step, step_seconds,
+	buyer, sale_price, starts_at, ends_at, closed_at, version, created_at`
+
+// PostgresDutchClockRepo is a PostgreSQL implementation of DutchClockRepository interface
+type PostgresDutchClockRepo struct {
+	db      *pgxpool.Pool
+	timeout time.Duration
+}
+
+// NewPostgresDutchClockRepo creates a new instance of PostgresDutchClockRepo, the timeout limits every operation
+func NewPostgresDutchClockRepo(conn string, timeout time.Duration) (*PostgresDutchClockRepo, error) {
+	pool, err := pgxpool.New(context.Background(), conn)
+	if err != nil {
+		return nil, err
+	}
+	return &PostgresDutchClockRepo{db: pool, timeout: timeout}, nil
+}
+
+// scanDutchClock scans a row of dutchClockColumns into a clock
+func scanDutchClock(row pgx.Row) (*domain.DutchClock, error) {
+	var c domain.DutchClock
+	err := row.Scan(
+		&c.ID, &c.VIN, &c.Status, &c.RecommendedPrice, &c.StartPrice, &c.FloorPrice, &c.Step, &c.StepSeconds,
+		&c.Buyer, &c.SalePrice, &c.StartsAt, &c.EndsAt, &c.ClosedAt, &c.Version, &c.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrClockNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Save saves a new clock to the PostgreSQL database, a vehicle can have one running clock only
+func (r *PostgresDutchClockRepo) Save(ctx context.Context, c *domain.DutchClock) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	c.Version = 1
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO dutch_clocks (`+dutchClockColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+		c.ID, c.VIN, c.Status, c.RecommendedPrice, c.StartPrice, c.FloorPrice, c.Step, c.StepSeconds,
+		c.Buyer, c.SalePrice, c.StartsAt, c.EndsAt, c.ClosedAt, c.Version, c.CreatedAt,
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrClockExists
+	}
+	return err
+}
+
+// FindByID retrieves a clock by its ID
+func (r *PostgresDutchClockRepo) FindByID(ctx context.Context, id string) (*domain.DutchClock, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	return scanDutchClock(r.db.QueryRow(ctx, `SELECT `+dutchClockColumns+` FROM dutch_clocks WHERE id=$1`, id))
+}
+
+// Update updates a clock guarded by its version
+func (r *PostgresDutchClockRepo) Update(ctx context.Context, c *domain.DutchClock) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tag, err := r.db.Exec(ctx,
+		`UPDATE dutch_clocks SET status=$1, buyer=$2, sale_price=$3, closed_at=$4, version=version+1
+		WHERE id=$5 AND version=$6`,
+		c.Status, c.Buyer, c.SalePrice, c.ClosedAt, c.ID, c.Version,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		if _, err := r.FindByID(ctx, c.ID); err != nil {
+			return err
+		}
+		return domain.ErrVersionConflict
+	}
+	c.Version++
+	return nil
+}
+
+// List lists clocks of the status, all of them for an empty status, newest first
+func (r *PostgresDutchClockRepo) List(ctx context.Context, status string) ([]*domain.DutchClock, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT `+dutchClockColumns+` FROM dutch_clocks WHERE $1 = '' OR status = $1 ORDER BY created_at DESC, id`, status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return collectDutchClocks(rows)
+}
+
+// ListDue lists running clocks whose end time has passed
+func (r *PostgresDutchClockRepo) ListDue(ctx context.Context, now time.Time) ([]*domain.DutchClock, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT `+dutchClockColumns+` FROM dutch_clocks WHERE status = $1 AND ends_at <= $2 ORDER BY ends_at`, domain.ClockRunning, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return collectDutchClocks(rows)
+}
+
+// collectDutchClocks scans all rows into clocks
+func collectDutchClocks(rows pgx.Rows) ([]*domain.DutchClock, error) {
+	defer rows.Close()
+	clocks := []*domain.DutchClock{}
+	for rows.Next() {
+		c, err := scanDutchClock(rows)
+		if err != nil {
+			return nil, err
+		}
+		clocks = append(clocks, c)
+	}
+	return clocks, rows.Err()
+}
@@ -0,0 +1,195 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// tenderColumns are the columns scanned by scanTender
+const tenderColumns = `id, vin, status, settlement, recommended_price, min_price, bid_count, winner, sale_price,
+	starts_at, ends_at, closed_at, version, created_at`
+
+// PostgresTenderRepo is a PostgreSQL implementation of TenderRepository interface
+type PostgresTenderRepo struct {
+	db      *pgxpool.Pool
+	timeout time.Duration
+}
+
+// NewPostgresTenderRepo creates a new instance of PostgresTenderRepo, the timeout limits every operation
+func NewPostgresTenderRepo(conn string, timeout time.Duration) (*PostgresTenderRepo, error) {
+	pool, err := pgxpool.New(context.Background(), conn)
+	if err != nil {
+		return nil, err
+	}
+	return &PostgresTenderRepo{db: pool, timeout: timeout}, nil
+}
+
+// scanTender scans a row of tenderColumns into a tender
+func scanTender(row pgx.Row) (*domain.Tender, error) {
+	var t domain.Tender
+	err := row.Scan(
+		&t.ID, &t.VIN, &t.Status, &t.Settlement, &t.RecommendedPrice, &t.MinPrice, &t.BidCount, &t.Winner, &t.SalePrice,
+		&t.StartsAt, &t.EndsAt, &t.ClosedAt, &t.Version, &t.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrTenderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Save saves a new tender to the PostgreSQL database, a vehicle can have one open tender only
+func (r *PostgresTenderRepo) Save(ctx context.Context, t *domain.Tender) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	t.Version = 1
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO tenders (`+tenderColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		t.ID, t.VIN, t.Status, t.Settlement, t.RecommendedPrice, t.MinPrice, t.BidCount, t.Winner, t.SalePrice,
+		t.StartsAt, t.EndsAt, t.ClosedAt, t.Version, t.CreatedAt,
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrTenderExists
+	}
+	return err
+}
+
+// FindByID retrieves a tender by its ID
+func (r *PostgresTenderRepo) FindByID(ctx context.Context, id string) (*domain.Tender, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	return scanTender(r.db.QueryRow(ctx, `SELECT `+tenderColumns+` FROM tenders WHERE id=$1`, id))
+}
+
+// Update updates a tender guarded by its version
+func (r *PostgresTenderRepo) Update(ctx context.Context, t *domain.Tender) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	return r.update(ctx, r.db, t)
+}
+
+// SaveBid updates the tender guarded by its version and inserts the bid within one transaction, one bid per bidder
+func (r *PostgresTenderRepo) SaveBid(ctx context.Context, t *domain.Tender, b *domain.SealedBid) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	// Insert the bid first so that a second bid of the bidder is reported as such
+	err = tx.QueryRow(ctx,
+		`INSERT INTO tender_bids (tender_id, bidder, amount, created_at) VALUES ($1, $2, $3, $4) RETURNING id`,
+		t.ID, b.Bidder, b.Amount, b.CreatedAt,
+	).Scan(&b.ID)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrAlreadyBid
+	}
+	if err != nil {
+		return err
+	}
+
+	// Update the tender if nobody changed it in the meantime
+	if err := r.update(ctx, tx, t); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// execer is implemented by both the pool and a transaction
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+// update updates a tender guarded by its version and bumps the version on success
+func (r *PostgresTenderRepo) update(ctx context.Context, db execer, t *domain.Tender) error {
+	tag, err := db.Exec(ctx,
+		`UPDATE tenders SET status=$1, bid_count=$2, winner=$3, sale_price=$4, closed_at=$5, version=version+1
+		WHERE id=$6 AND version=$7`,
+		t.Status, t.BidCount, t.Winner, t.SalePrice, t.ClosedAt, t.ID, t.Version,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		if _, err := r.FindByID(ctx, t.ID); err != nil {
+			return err
+		}
+		return domain.ErrVersionConflict
+	}
+	t.Version++
+	return nil
+}
+
+// List lists tenders of the status, all of them for an empty status, newest first
+func (r *PostgresTenderRepo) List(ctx context.Context, status string) ([]*domain.Tender, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT `+tenderColumns+` FROM tenders WHERE $1 = '' OR status = $1 ORDER BY created_at DESC, id`, status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return collectTenders(rows)
+}
+
+// ListDue lists open tenders whose end time has passed
+func (r *PostgresTenderRepo) ListDue(ctx context.Context, now time.Time) ([]*domain.Tender, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT `+tenderColumns+` FROM tenders WHERE status = $1 AND ends_at <= $2 ORDER BY ends_at`, domain.TenderOpen, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return collectTenders(rows)
+}
+
+// collectTenders scans all rows into tenders
+func collectTenders(rows pgx.Rows) ([]*domain.Tender, error) {
+	defer rows.Close()
+	tenders := []*domain.Tender{}
+	for rows.Next() {
+		t, err := scanTender(rows)
+		if err != nil {
+			return nil, err
+		}
+		tenders = append(tenders, t)
+	}
+	return tenders, rows.Err()
+}
+
+// Bids lists the bids of a tender, oldest first
+func (r *PostgresTenderRepo) Bids(ctx context.Context, tenderID string) ([]*domain.SealedBid, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT id, tender_id, bidder, amount, created_at FROM tender_bids WHERE tender_id=$1 ORDER BY id`, tenderID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	bids := []*domain.SealedBid{}
+	for rows.Next() {
+		var b domain.SealedBid
+		if err := rows.Scan(&b.ID, &b.TenderID, &b.Bidder, &b.Amount, &b.CreatedAt); err != nil {
+			return nil, err
+		}
+		bids = append(bids, &b)
+	}
+	return bids, rows.Err()
+}
@@ -0,0 +1,11 @@
+package infrastructure
+
+import "time"
+
+// SystemClock is an implementation of Clock interface telling the system time
+type SystemClock struct{}
+
+// Now returns the current system time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,93 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	pb "github.com/alechekz/online-car-auction/services/vehicle/delivery/grpc/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// VehicleGRPCClient is a gRPC client for the Vehicle Service
+type VehicleGRPCClient struct {
+	client  pb.VehicleServiceClient
+	conn    *grpc.ClientConn
+	timeout time.Duration
+}
+
+// NewVehicleGRPCClient creates a new VehicleGRPCClient instance, the timeout limits every call
+func NewVehicleGRPCClient(address string, timeout time.Duration) (*VehicleGRPCClient, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	client := pb.NewVehicleServiceClient(conn)
+	return &VehicleGRPCClient{client: client, conn: conn, timeout: timeout}, nil
+}
+
+// Close closes the gRPC connection
+func (c *VehicleGRPCClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetVehicle retrieves a vehicle from the Vehicle Service
+func (c *VehicleGRPCClient) GetVehicle(ctx context.Context, vin string) (*domain.Vehicle, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	resp, err := c.client.GetVehicle(ctx, &pb.GetVehicleRequest{Vin: vin})
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrVehicleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Vehicle{
+		VIN:        resp.Vin,
+		Brand:      resp.Brand,
+		Year:       resp.Year,
+		Price:      resp.Price,
+		Lifecycle:  resp.Lifecycle,
+		SaleStatus: resp.SaleStatus,
+	}, nil
+}
+
+// ClaimSale claims the vehicle for the sale in the Vehicle Service
+func (c *VehicleGRPCClient) ClaimSale(ctx context.Context, vin, mode, saleID string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	_, err := c.client.ClaimSale(ctx, &pb.ClaimSaleRequest{Vin: vin, Channel: mode, SaleId: saleID})
+	return saleError(err)
+}
+
+// SettleSale records the outcome of the sale in the Vehicle Service, an empty buyer settles it unsold
+func (c *VehicleGRPCClient) SettleSale(ctx context.Context, vin, mode, saleID, buyer string, price uint64) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	_, err := c.client.SettleSale(ctx, &pb.SettleSaleRequest{Vin: vin, Channel: mode, SaleId: saleID, Buyer: buyer, Price: price})
+	return saleError(err)
+}
+
+// ReleaseSale drops the claim of the sale in the Vehicle Service
+func (c *VehicleGRPCClient) ReleaseSale(ctx context.Context, vin, saleID string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	_, err := c.client.ReleaseSale(ctx, &pb.ReleaseSaleRequest{Vin: vin, SaleId: saleID})
+	return saleError(err)
+}
+
+// saleError maps the status of a sale call to the domain errors
+func saleError(err error) error {
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return domain.ErrVehicleNotFound
+	case codes.FailedPrecondition:
+		return domain.ErrNotForSale
+	}
+	return err
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// config holds the configuration for the Auction Service server
+type config struct {
+	HttpAddress   string
+	GrpcAddress   string
+	VehicleURL    string
+	DatabaseURL   string
+	Repo          string // "postgres" or "inmemory"
+	CloseInterval time.Duration
+
+	// Anti-sniping policy of new auctions
+	SoftClose domain.SoftClose
+
+	// Start price of new Dutch clocks in percent of the recommended price and their default seconds per price step
+	DutchStartPercent uint64
+	DutchStepSeconds  int64
+
+	// Events kept per VIN for resuming watchers and buffered per watcher before it is dropped
+	EventHistory int
+	EventBuffer  int
+
+	// Deadlines of the calls to each dependency
+	VehicleTimeout  time.Duration
+	DatabaseTimeout time.Duration
+}
+
+// NewConfig creates a new server configuration with default values
+func NewConfig() *config {
+	cfg := &config{
+		HttpAddress:   ":6067",
+		GrpcAddress:   ":6068",
+		VehicleURL:    ":6066",
+		Repo:          "inmemory",
+		CloseInterval: time.Second,
+		EventHistory:  100,
+		EventBuffer:   64,
+		SoftClose:     domain.SoftClose{Window: 2 * time.Minute, Extension: 2 * time.Minute},
+
+		DutchStartPercent: 120,
+		DutchStepSeconds:  60,
+
+		VehicleTimeout:  15 * time.Second,
+		DatabaseTimeout: 15 * time.Second,
+	}
+	if os.Getenv("AUCTION_HTTP") != "" {
+		cfg.HttpAddress = os.Getenv("AUCTION_HTTP")
+	}
+	if os.Getenv("AUCTION_GRPC") != "" {
+		cfg.GrpcAddress = os.Getenv("AUCTION_GRPC")
+	}
+	if os.Getenv("VEHICLE_URL") != "" {
+		cfg.VehicleURL = os.Getenv("VEHICLE_URL")
+	}
+	if os.Getenv("AUCTION_DB") != "" {
+		cfg.DatabaseURL = os.Getenv("AUCTION_DB")
+		cfg.Repo = "postgres"
+	}
+	if d, err := time.ParseDuration(os.Getenv("AUCTION_CLOSE_INTERVAL")); err == nil && d > 0 {
+		cfg.CloseInterval = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("AUCTION_SOFT_CLOSE_WINDOW")); err == nil && d >= 0 {
+		cfg.SoftClose.Window = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("AUCTION_SOFT_CLOSE_EXTENSION")); err == nil && d > 0 {
+		cfg.SoftClose.Extension = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("AUCTION_SOFT_CLOSE_MAX_EXTENSIONS")); err == nil && n >= 0 {
+		cfg.SoftClose.MaxExtensions = n
+	}
+	if n, err := strconv.ParseUint(os.Getenv("AUCTION_DUTCH_START_PERCENT"), 10, 64); err == nil && n > 0 {
+		cfg.DutchStartPercent = n
+	}
+	if n, err := strconv.ParseInt(os.Getenv("AUCTION_DUTCH_STEP_SECONDS"), 10, 64); err == nil && n > 0 {
+		cfg.DutchStepSeconds = n
+	}
+	if n, err := strconv.Atoi(os.Getenv("AUCTION_EVENT_HISTORY")); err == nil && n > 0 {
+		cfg.EventHistory = n
+	}
+	if n, err := strconv.Atoi(os.Getenv("AUCTION_EVENT_BUFFER")); err == nil && n > 0 {
+		cfg.EventBuffer = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("VEHICLE_TIMEOUT")); err == nil && d > 0 {
+		cfg.VehicleTimeout = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("AUCTION_DB_TIMEOUT")); err == nil && d > 0 {
+		cfg.DatabaseTimeout = d
+	}
+	return cfg
+}
@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	grpcDelivery "github.com/alechekz/online-car-auction/services/auction/delivery/grpc"
+	pb "github.com/alechekz/online-car-auction/services/auction/delivery/grpc/proto"
+	httpDelivery "github.com/alechekz/online-car-auction/services/auction/delivery/http"
+
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/internal/logger"
+	"github.com/alechekz/online-car-auction/services/auction/repository"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// Server represents both HTTP and gRPC servers for the Auction Service
+type Server struct {
+	httpServer    *http.Server
+	grpcServer    *grpc.Server
+	grpcLis       net.Listener
+	auctions      usecase.AuctionUsecase
+	tenders       usecase.TenderUsecase
+	clocks        usecase.DutchUsecase
+	settlements   usecase.SettlementUsecase
+	closeInterval time.Duration
+	stop          chan struct{}
+}
+
+// NewServer creates and configures a new Server instance
+func NewServer(cfg *config) (*Server, error) {
+
+	// dependencies
+	var repo repository.AuctionRepository
+	var tenderRepo repository.TenderRepository
+	var clockRepo repository.DutchClockRepository
+	var sales repository.ActiveSaleRepository
+	switch cfg.Repo {
+	case "postgres":
+		logger.Log.Info("using postgres auction repository")
+		var err error
+		repo, err = infrastructure.NewPostgresAuctionRepo(cfg.DatabaseURL, cfg.DatabaseTimeout)
+		if err != nil {
+			logger.Log.Error("failed to connect to postgres", slog.String("error", err.Error()))
+		}
+		tenderRepo, err = infrastructure.NewPostgresTenderRepo(cfg.DatabaseURL, cfg.DatabaseTimeout)
+		if err != nil {
+			logger.Log.Error("failed to connect to postgres", slog.String("error", err.Error()))
+		}
+		clockRepo, err = infrastructure.NewPostgresDutchClockRepo(cfg.DatabaseURL, cfg.DatabaseTimeout)
+		if err != nil {
+			logger.Log.Error("failed to connect to postgres", slog.String("error", err.Error()))
+		}
+		sales, err = infrastructure.NewPostgresActiveSaleRepo(cfg.DatabaseURL, cfg.DatabaseTimeout)
+		if err != nil {
+			logger.Log.Error("failed to connect to postgres", slog.String("error", err.Error()))
+		}
+	default:
+		logger.Log.Info("using in-memory auction repository")
+		repo = infrastructure.NewMemoryAuctionRepo()
+		tenderRepo = infrastructure.NewMemoryTenderRepo()
+		clockRepo = infrastructure.NewMemoryDutchClockRepo()
+		sales = infrastructure.NewMemoryActiveSaleRepo()
+	}
+	vehicleProvider, err := infrastructure.NewVehicleGRPCClient(cfg.VehicleURL, cfg.VehicleTimeout)
+	if err != nil {
+		logger.Log.Error("failed to create vehicle gRPC client", slog.String("error", err.Error()))
+	}
+	logger.Log.Info("connected to dependencies", slog.String("vehicle_url", cfg.VehicleURL))
+	events := infrastructure.NewMemoryEventBroker(cfg.EventHistory, cfg.EventBuffer)
+	uc := usecase.NewAuctionUC(repo, sales, vehicleProvider, events, infrastructure.SystemClock{}, cfg.SoftClose)
+	tenders := usecase.NewTenderUC(tenderRepo, sales, vehicleProvider, infrastructure.SystemClock{})
+	clocks := usecase.NewDutchUC(clockRepo, sales, vehicleProvider, infrastructure.SystemClock{}, cfg.DutchStartPercent, cfg.DutchStepSeconds)
+	settlements := usecase.NewSettlementUC(sales, vehicleProvider, repo, tenderRepo, clockRepo, infrastructure.SystemClock{})
+
+	// HTTP handler
+	handler := &httpDelivery.AuctionHandler{UC: uc}
+	mux := httpDelivery.NewRouter(handler, &httpDelivery.TenderHandler{UC: tenders}, &httpDelivery.DutchHandler{UC: clocks})
+
+	// gRPC handler
+	grpcSrv := grpc.NewServer()
+	pb.RegisterAuctionServiceServer(grpcSrv, grpcDelivery.NewAuctionServer(uc))
+	reflection.Register(grpcSrv)
+	lis, err := net.Listen("tcp", cfg.GrpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// create server
+	return &Server{
+		httpServer: &http.Server{
+			Addr:              cfg.HttpAddress,
+			Handler:           mux,
+			ReadHeaderTimeout: 15 * time.Second,
+		},
+		grpcServer:    grpcSrv,
+		grpcLis:       lis,
+		auctions:      uc,
+		tenders:       tenders,
+		clocks:        clocks,
+		settlements:   settlements,
+		closeInterval: cfg.CloseInterval,
+		stop:          make(chan struct{}),
+	}, nil
+}
+
+// Start runs both HTTP and gRPC servers and the closing of ended auctions, tenders and Dutch clocks
+func (s *Server) Start() error {
+	go s.closeDue()
+
+	// HTTP
+	go func() {
+		logger.Log.Info("starting HTTP server", slog.String("addr", s.httpServer.Addr))
+		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Log.Error("http server error", slog.String("err", err.Error()))
+		}
+	}()
+
+	// gRPC
+	go func() {
+		logger.Log.Info("starting gRPC server", slog.String("addr", s.grpcLis.Addr().String()))
+		if err := s.grpcServer.Serve(s.grpcLis); err != nil {
+			logger.Log.Error("grpc server error", slog.String("err", err.Error()))
+		}
+	}()
+
+	return nil
+}
+
+// closeDue periodically closes auctions, tenders and Dutch clocks whose end time has passed
+// and settles the ended sales in the vehicle service
+func (s *Server) closeDue() {
+	ticker := time.NewTicker(s.closeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			n, err := s.auctions.CloseDue(context.Background())
+			if err != nil {
+				logger.Log.Error("failed to close ended auctions", slog.String("error", err.Error()))
+				continue
+			}
+			if n > 0 {
+				logger.Log.Info("closed ended auctions", slog.Int("count", n))
+			}
+			n, err = s.tenders.CloseDue(context.Background())
+			if err != nil {
+				logger.Log.Error("failed to close ended tenders", slog.String("error", err.Error()))
+				continue
+			}
+			if n > 0 {
+				logger.Log.Info("closed ended tenders", slog.Int("count", n))
+			}
+			n, err = s.clocks.ExpireDue(context.Background())
+			if err != nil {
+				logger.Log.Error("failed to expire dutch clocks", slog.String("error", err.Error()))
+				continue
+			}
+			if n > 0 {
+				logger.Log.Info("expired dutch clocks", slog.Int("count", n))
+			}
+			n, err = s.settlements.SettleDue(context.Background())
+			if err != nil {
+				logger.Log.Error("failed to settle ended sales", slog.String("error", err.Error()))
+				continue
+			}
+			if n > 0 {
+				logger.Log.Info("settled ended sales", slog.Int("count", n))
+			}
+		}
+	}
+}
+
+// Stop gracefully shuts down both servers
+func (s *Server) Stop() error {
+	logger.Log.Info("shutting down servers")
+	close(s.stop)
+	if err := s.httpServer.Close(); err != nil {
+		return err
+	}
+	s.grpcServer.GracefulStop()
+	return nil
+}
+
+// Handler returns the HTTP handler of the server
+func (s *Server) Handler() http.Handler {
+	return s.httpServer.Handler
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// ActiveSaleRepository defines the interface for the claims of the vehicles on sale, shared by all sale modes
+type ActiveSaleRepository interface {
+	Claim(ctx context.Context, s *domain.ActiveSale) error
+	Release(ctx context.Context, vin, saleID string) error
+	Settle(ctx context.Context, vin, saleID string, at time.Time) error
+	ListUnsettled(ctx context.Context) ([]*domain.ActiveSale, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// AuctionRepository defines the interface for auction data operations
+type AuctionRepository interface {
+	Save(ctx context.Context, a *domain.Auction) error
+	FindByID(ctx context.Context, id string) (*domain.Auction, error)
+	FindByVIN(ctx context.Context, vin string) (*domain.Auction, error)
+	Update(ctx context.Context, a *domain.Auction, bids ...*domain.Bid) error
+	List(ctx context.Context, status string) ([]*domain.Auction, error)
+	ListDue(ctx context.Context, now time.Time) ([]*domain.Auction, error)
+	Bids(ctx context.Context, auctionID string) ([]*domain.Bid, error)
+}
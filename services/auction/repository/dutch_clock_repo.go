@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// DutchClockRepository defines the interface for Dutch clock data operations
+type DutchClockRepository interface {
+	Save(ctx context.Context, c *domain.DutchClock) error
+	FindByID(ctx context.Context, id string) (*domain.DutchClock, error)
+	Update(ctx context.Context, c *domain.DutchClock) error
+	List(ctx context.Context, status string) ([]*domain.DutchClock, error)
+	ListDue(ctx context.Context, now time.Time) ([]*domain.DutchClock, error)
+}
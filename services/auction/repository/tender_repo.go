@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// TenderRepository defines the interface for sealed-bid tender data operations
+type TenderRepository interface {
+	Save(ctx context.Context, t *domain.Tender) error
+	FindByID(ctx context.Context, id string) (*domain.Tender, error)
+	Update(ctx context.Context, t *domain.Tender) error
+	SaveBid(ctx context.Context, t *domain.Tender, b *domain.SealedBid) error
+	List(ctx context.Context, status string) ([]*domain.Tender, error)
+	ListDue(ctx context.Context, now time.Time) ([]*domain.Tender, error)
+	Bids(ctx context.Context, tenderID string) ([]*domain.SealedBid, error)
+}
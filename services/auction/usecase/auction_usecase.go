@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/repository"
+)
+
+// maxBidAttempts limits the retries of a bid racing with other bids
+const maxBidAttempts = 5
+
+// AuctionUsecase defines the interface for auction-related business logic
+type AuctionUsecase interface {
+	Open(ctx context.Context, vin string, startPrice uint64, reserve domain.Reserve, startsAt, endsAt time.Time) (*domain.Auction, error)
+	Get(ctx context.Context, id string) (*domain.Auction, error)
+	List(ctx context.Context, status string) ([]*domain.Auction, error)
+	PlaceBid(ctx context.Context, id string, b *domain.Bid) (*domain.Auction, error)
+	PlaceMaxBid(ctx context.Context, id string, m *domain.MaxBid) (*domain.Auction, []*domain.Bid, error)
+	CounterOffer(ctx context.Context, id string, amount uint64) (*domain.Auction, error)
+	AcceptCounterOffer(ctx context.Context, id, buyer string) (*domain.Auction, error)
+	DeclineCounterOffer(ctx context.Context, id, buyer string) (*domain.Auction, error)
+	Bids(ctx context.Context, id string) ([]*domain.Bid, error)
+	CloseDue(ctx context.Context) (int, error)
+	Watch(ctx context.Context, vin string, lastEventID int64) (<-chan *domain.AuctionEvent, error)
+}
+
+// auctionUsecase is the implementation of AuctionUsecase interface
+type auctionUsecase struct {
+	repo            repository.AuctionRepository
+	sales           repository.ActiveSaleRepository
+	vehicleProvider VehicleProvider
+	events          EventBroker
+	clock           Clock
+	softClose       domain.SoftClose
+}
+
+// NewAuctionUC is the constructor for auctionUsecase, new auctions get the soft close policy
+// and claim their vehicles among the sales of all modes
+func NewAuctionUC(r repository.AuctionRepository, sales repository.ActiveSaleRepository, vehicleProvider VehicleProvider, events EventBroker, clock Clock, softClose domain.SoftClose) *auctionUsecase {
+	return &auctionUsecase{
+		repo:            r,
+		sales:           sales,
+		vehicleProvider: vehicleProvider,
+		events:          events,
+		clock:           clock,
+		softClose:       softClose,
+	}
+}
+
+// newAuctionID generates a random auction ID
+func newAuctionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Open opens a new auction of a listed vehicle that is not on sale yet, a zero start time opens it right away,
+// a reserve given as a percentage applies to the price recommended by the pricing service and requires one
+func (uc *auctionUsecase) Open(ctx context.Context, vin string, startPrice uint64, reserve domain.Reserve, startsAt, endsAt time.Time) (*domain.Auction, error) {
+
+	// Verify the vehicle through the vehicle service
+	if err := reserve.Validate(); err != nil {
+		return nil, domain.ErrValidation
+	}
+	v, err := sellableVehicle(ctx, uc.vehicleProvider, vin)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare and validate the auction
+	id, err := newAuctionID()
+	if err != nil {
+		return nil, err
+	}
+	if startsAt.IsZero() {
+		startsAt = uc.clock.Now()
+	}
+	a := domain.NewAuction(id, v.VIN, v.Price, startPrice, startsAt, endsAt)
+	a.SoftClose = uc.softClose
+	if reserve.Percent > 0 && v.Price == 0 {
+		return nil, domain.ErrValidation
+	}
+	a.SetReserve(reserve.Amount(v.Price))
+	if err := a.Validate(); err != nil {
+		return nil, domain.ErrValidation
+	}
+
+	// Claim the vehicle and save the auction
+	err = openSale(ctx, uc.sales, uc.vehicleProvider, a.VIN, domain.SaleAuction, a.ID, uc.clock.Now(), func() error {
+		return uc.repo.Save(ctx, a)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Get retrieves an auction by its ID, closing it first when its end time has passed
+func (uc *auctionUsecase) Get(ctx context.Context, id string) (*domain.Auction, error) {
+	a, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.closeIfDue(ctx, a, uc.clock.Now())
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return uc.repo.FindByID(ctx, id) // closed concurrently
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// List lists auctions, optionally of the given status only
+func (uc *auctionUsecase) List(ctx context.Context, status string) ([]*domain.Auction, error) {
+	if status != "" && status != domain.AuctionOpen && status != domain.AuctionIfSale && status != domain.AuctionClosed {
+		return nil, domain.ErrValidation
+	}
+	return uc.repo.List(ctx, status)
+}
+
+// PlaceBid places a bid in the auction, the maximum bid of the leader may counter it
+func (uc *auctionUsecase) PlaceBid(ctx context.Context, id string, b *domain.Bid) (*domain.Auction, error) {
+	a, _, err := uc.apply(ctx, id, func(a *domain.Auction, now time.Time) ([]*domain.Bid, error) {
+		return a.PlaceBid(b, now)
+	})
+	return a, err
+}
+
+// PlaceMaxBid places a hidden maximum bid in the auction and returns the bids placed on behalf of the maximums
+func (uc *auctionUsecase) PlaceMaxBid(ctx context.Context, id string, m *domain.MaxBid) (*domain.Auction, []*domain.Bid, error) {
+	return uc.apply(ctx, id, func(a *domain.Auction, now time.Time) ([]*domain.Bid, error) {
+		return a.PlaceMaxBid(m, now)
+	})
+}
+
+// CounterOffer makes the seller's counter offer to the leading buyer of an auction closed below its reserve
+func (uc *auctionUsecase) CounterOffer(ctx context.Context, id string, amount uint64) (*domain.Auction, error) {
+	a, _, err := uc.apply(ctx, id, func(a *domain.Auction, now time.Time) ([]*domain.Bid, error) {
+		return nil, a.MakeCounterOffer(amount)
+	})
+	return a, err
+}
+
+// AcceptCounterOffer sells the vehicle to the leading buyer at the seller's counter offer
+func (uc *auctionUsecase) AcceptCounterOffer(ctx context.Context, id, buyer string) (*domain.Auction, error) {
+	a, _, err := uc.apply(ctx, id, func(a *domain.Auction, now time.Time) ([]*domain.Bid, error) {
+		return nil, a.AcceptCounterOffer(buyer, now)
+	})
+	return a, err
+}
+
+// DeclineCounterOffer ends the if-sale negotiation of the auction without a sale
+func (uc *auctionUsecase) DeclineCounterOffer(ctx context.Context, id, buyer string) (*domain.Auction, error) {
+	a, _, err := uc.apply(ctx, id, func(a *domain.Auction, now time.Time) ([]*domain.Bid, error) {
+		return nil, a.DeclineCounterOffer(buyer, now)
+	})
+	return a, err
+}
+
+// apply applies the change to the auction and saves the bids it placed, changes racing with each other
+// are retried against the latest state, so concurrent bids resolve in the order they are saved
+func (uc *auctionUsecase) apply(ctx context.Context, id string, place func(*domain.Auction, time.Time) ([]*domain.Bid, error)) (*domain.Auction, []*domain.Bid, error) {
+	for attempt := 1; ; attempt++ {
+
+		// Apply the change to the latest state of the auction
+		a, err := uc.Get(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		before := *a
+		bids, err := place(a, uc.clock.Now())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Save the change, guarded by the version it was applied to
+		err = uc.update(ctx, &before, a, bids...)
+		if errors.Is(err, domain.ErrVersionConflict) && attempt < maxBidAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return a, bids, nil
+	}
+}
+
+// Bids lists the bids of an auction, oldest first
+func (uc *auctionUsecase) Bids(ctx context.Context, id string) ([]*domain.Bid, error) {
+	if _, err := uc.repo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return uc.repo.Bids(ctx, id)
+}
+
+// CloseDue closes all open auctions whose end time has passed and returns how many were closed
+func (uc *auctionUsecase) CloseDue(ctx context.Context) (int, error) {
+	now := uc.clock.Now()
+	auctions, err := uc.repo.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	closed := 0
+	for _, a := range auctions {
+		err := uc.closeIfDue(ctx, a, now)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			continue // closed or changed concurrently, picked up by the next run if still due
+		}
+		if err != nil {
+			return closed, err
+		}
+		closed++
+	}
+	return closed, nil
+}
+
+// closeIfDue closes the auction when its end time has passed
+func (uc *auctionUsecase) closeIfDue(ctx context.Context, a *domain.Auction, now time.Time) error {
+	if !a.IsDue(now) {
+		return nil
+	}
+	before := *a
+	a.Close(now)
+	return uc.update(ctx, &before, a)
+}
+
+// update saves the change of the auction and publishes it to the watchers of the vehicle,
+// a closed auction is settled in the vehicle service, sold to the winner or unsold
+func (uc *auctionUsecase) update(ctx context.Context, before, after *domain.Auction, bids ...*domain.Bid) error {
+	if err := uc.repo.Update(ctx, after, bids...); err != nil {
+		return err
+	}
+	if before.Status != domain.AuctionClosed && after.Status == domain.AuctionClosed {
+		settleSale(ctx, uc.sales, uc.vehicleProvider, after.VIN, domain.SaleAuction, after.ID, after.Winner, after.SalePrice, uc.clock.Now()) // nolint:errcheck
+	}
+	for _, e := range domain.AuctionEvents(before, after, bids, uc.clock.Now()) {
+		uc.events.Publish(e)
+	}
+	return nil
+}
+
+// Watch streams the events of the auctions of a vehicle on sale or in negotiation, events published after lastEventID are replayed first
+func (uc *auctionUsecase) Watch(ctx context.Context, vin string, lastEventID int64) (<-chan *domain.AuctionEvent, error) {
+	a, err := uc.repo.FindByVIN(ctx, vin)
+	if err != nil {
+		return nil, err
+	}
+	if a.Status == domain.AuctionClosed && lastEventID == 0 {
+		return nil, domain.ErrAuctionNotOpen
+	}
+	return uc.events.Subscribe(ctx, vin, lastEventID), nil
+}
@@ -0,0 +1,462 @@
+package usecase_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// testVIN is the VIN of the auctioned test vehicle
+const testVIN = "1HGCM82633A123456"
+
+// newTestAuctionUC creates an auction usecase over an in-memory repository and a mock vehicle service
+func newTestAuctionUC() (usecase.AuctionUsecase, *infrastructure.MemoryAuctionRepo) {
+	repo := infrastructure.NewMemoryAuctionRepo()
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	return usecase.NewAuctionUC(repo, infrastructure.NewMemoryActiveSaleRepo(), vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), infrastructure.SystemClock{}, domain.SoftClose{}), repo
+}
+
+// TestAuctionUsecase_Open tests the Open method of AuctionUsecase
+func TestAuctionUsecase_Open(t *testing.T) {
+	uc, _ := newTestAuctionUC()
+
+	t.Run("valid auction", func(t *testing.T) {
+		a, err := uc.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, a.ID)
+		assert.Equal(t, uint64(25_000), a.RecommendedPrice)
+		assert.Equal(t, uint64(12_500), a.StartPrice)
+		assert.Equal(t, int64(1), a.Version)
+	})
+
+	t.Run("vehicle already auctioned", func(t *testing.T) {
+		_, err := uc.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, domain.ErrSaleExists)
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		_, err := uc.Open(t.Context(), "1HGCM82633A000001", 0, domain.Reserve{}, time.Time{}, time.Now().Add(time.Second))
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("unknown vehicle", func(t *testing.T) {
+		repo := infrastructure.NewMemoryAuctionRepo()
+		uc := usecase.NewAuctionUC(repo, infrastructure.NewMemoryActiveSaleRepo(), &infrastructure.MockVehicleProvider{Err: domain.ErrVehicleNotFound}, infrastructure.NewMemoryEventBroker(100, 64), infrastructure.SystemClock{}, domain.SoftClose{})
+		_, err := uc.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, domain.ErrVehicleNotFound)
+	})
+
+	t.Run("vehicle not for sale", func(t *testing.T) {
+		for _, v := range []*domain.Vehicle{
+			{Price: 25_000, Lifecycle: "withdrawn"},
+			{Price: 25_000, Lifecycle: domain.VehicleListed, SaleStatus: "available"},
+		} {
+			uc := usecase.NewAuctionUC(infrastructure.NewMemoryAuctionRepo(), infrastructure.NewMemoryActiveSaleRepo(), &infrastructure.MockVehicleProvider{Data: v}, infrastructure.NewMemoryEventBroker(100, 64), infrastructure.SystemClock{}, domain.SoftClose{})
+			_, err := uc.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+			assert.ErrorIs(t, err, domain.ErrNotForSale)
+		}
+	})
+}
+
+// TestAuctionUsecase_PlaceBid tests the PlaceBid method of AuctionUsecase
+func TestAuctionUsecase_PlaceBid(t *testing.T) {
+	uc, _ := newTestAuctionUC()
+	a, err := uc.Open(t.Context(), testVIN, 10_000, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	t.Run("valid bids", func(t *testing.T) {
+		b := &domain.Bid{Bidder: "alice", Amount: 10_000}
+		got, err := uc.PlaceBid(t.Context(), a.ID, b)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), b.ID)
+		assert.Equal(t, "alice", got.Leader)
+
+		got, err = uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "bob", Amount: 10_300})
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", got.Leader)
+		assert.Equal(t, int64(3), got.Version)
+	})
+
+	t.Run("too low", func(t *testing.T) {
+		_, err := uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "carol", Amount: 10_400})
+		assert.ErrorIs(t, err, domain.ErrBidTooLow)
+	})
+
+	t.Run("unknown auction", func(t *testing.T) {
+		_, err := uc.PlaceBid(t.Context(), "unknown", &domain.Bid{Bidder: "carol", Amount: 20_000})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("bids", func(t *testing.T) {
+		bids, err := uc.Bids(t.Context(), a.ID)
+		assert.NoError(t, err)
+		assert.Len(t, bids, 2)
+		assert.Equal(t, "alice", bids[0].Bidder)
+
+		_, err = uc.Bids(t.Context(), "unknown")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+// TestAuctionUsecase_PlaceBid_Concurrent tests that racing bids are all recorded against a consistent auction
+func TestAuctionUsecase_PlaceBid_Concurrent(t *testing.T) {
+	uc, _ := newTestAuctionUC()
+	a, err := uc.Open(t.Context(), testVIN, 10_000, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: fmt.Sprintf("bidder-%d", i), Amount: 10_000 + uint64(i)*1_000})
+		}()
+	}
+	wg.Wait()
+
+	got, err := uc.Get(t.Context(), a.ID)
+	assert.NoError(t, err)
+	bids, err := uc.Bids(t.Context(), a.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, len(bids), got.BidCount)
+	assert.Equal(t, bids[len(bids)-1].Amount, got.HighBid)
+	assert.Equal(t, bids[len(bids)-1].Bidder, got.Leader)
+	for i := 1; i < len(bids); i++ {
+		assert.Greater(t, bids[i].Amount, bids[i-1].Amount)
+	}
+}
+
+// TestAuctionUsecase_CloseDue tests the closing of ended auctions
+func TestAuctionUsecase_CloseDue(t *testing.T) {
+	uc, repo := newTestAuctionUC()
+
+	// Ended auction with a bid, ended auction without bids and a running auction
+	start := time.Now().Add(-2 * time.Hour)
+	sold := domain.NewAuction("sold", testVIN, 25_000, 0, start, start.Add(time.Hour))
+	sold.HighBid, sold.Leader, sold.BidCount = 12_500, "alice", 1
+	unsold := domain.NewAuction("unsold", "1HGCM82633A000001", 25_000, 0, start, start.Add(time.Hour))
+	running := domain.NewAuction("running", "1HGCM82633A000002", 25_000, 0, start, time.Now().Add(time.Hour))
+	for _, a := range []*domain.Auction{sold, unsold, running} {
+		assert.NoError(t, repo.Save(t.Context(), a))
+	}
+
+	n, err := uc.CloseDue(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	got, err := uc.Get(t.Context(), "sold")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AuctionClosed, got.Status)
+	assert.Equal(t, "alice", got.Winner)
+
+	got, err = uc.Get(t.Context(), "unsold")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AuctionClosed, got.Status)
+	assert.Empty(t, got.Winner)
+
+	_, err = uc.PlaceBid(t.Context(), "unsold", &domain.Bid{Bidder: "bob", Amount: 20_000})
+	assert.ErrorIs(t, err, domain.ErrAuctionNotOpen)
+
+	open, err := uc.List(t.Context(), domain.AuctionOpen)
+	assert.NoError(t, err)
+	assert.Len(t, open, 1)
+	assert.Equal(t, "running", open[0].ID)
+
+	// The vehicle of a closed auction can be auctioned again
+	_, err = uc.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+}
+
+// TestAuctionUsecase_Watch tests the live events of the auctions of a vehicle
+func TestAuctionUsecase_Watch(t *testing.T) {
+	uc, repo := newTestAuctionUC()
+
+	t.Run("not on sale", func(t *testing.T) {
+		_, err := uc.Watch(t.Context(), testVIN, 0)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	a, err := uc.Open(t.Context(), testVIN, 10_000, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	events, err := uc.Watch(t.Context(), testVIN, 0)
+	assert.NoError(t, err)
+	var first int64
+
+	t.Run("bid", func(t *testing.T) {
+		_, err := uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "alice", Amount: 10_000})
+		assert.NoError(t, err)
+		e := <-events
+		first = e.ID
+		assert.Equal(t, domain.EventBidPlaced, e.Type)
+		assert.Equal(t, "alice", e.Bidder)
+		e = <-events
+		assert.Equal(t, domain.EventPriceChanged, e.Type)
+		assert.Equal(t, uint64(10_000), e.HighBid)
+		assert.Equal(t, uint64(10_300), e.MinBid)
+	})
+
+	t.Run("close", func(t *testing.T) {
+		ended, err := repo.FindByID(t.Context(), a.ID)
+		assert.NoError(t, err)
+		ended.EndsAt = time.Now().Add(-time.Second)
+		assert.NoError(t, repo.Update(t.Context(), ended))
+
+		n, err := uc.CloseDue(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		e := <-events
+		assert.Equal(t, domain.EventClosed, e.Type)
+		assert.Equal(t, "alice", e.Winner)
+	})
+
+	t.Run("resume closed", func(t *testing.T) {
+		_, err := uc.Watch(t.Context(), testVIN, 0)
+		assert.ErrorIs(t, err, domain.ErrAuctionNotOpen)
+
+		missed, err := uc.Watch(t.Context(), testVIN, first+1)
+		assert.NoError(t, err)
+		e := <-missed
+		assert.Equal(t, first+2, e.ID)
+		assert.Equal(t, domain.EventClosed, e.Type)
+	})
+}
+
+// TestAuctionUsecase_Watch_SlowConsumer tests that a watcher falling behind is dropped and can resume
+func TestAuctionUsecase_Watch_SlowConsumer(t *testing.T) {
+	repo := infrastructure.NewMemoryAuctionRepo()
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	uc := usecase.NewAuctionUC(repo, infrastructure.NewMemoryActiveSaleRepo(), vehicleProvider, infrastructure.NewMemoryEventBroker(100, 1), infrastructure.SystemClock{}, domain.SoftClose{})
+	a, err := uc.Open(t.Context(), testVIN, 10_000, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	events, err := uc.Watch(t.Context(), testVIN, 0)
+	assert.NoError(t, err)
+
+	// Two events per bid overflow the buffer of one event
+	_, err = uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "alice", Amount: 10_000})
+	assert.NoError(t, err)
+	e := <-events
+	assert.Equal(t, domain.EventBidPlaced, e.Type)
+	_, ok := <-events
+	assert.False(t, ok)
+
+	// Resume from the last received event
+	events, err = uc.Watch(t.Context(), testVIN, e.ID)
+	assert.NoError(t, err)
+	first := e.ID
+	e = <-events
+	assert.Equal(t, first+1, e.ID)
+	assert.Equal(t, domain.EventPriceChanged, e.Type)
+}
+
+// TestAuctionUsecase_PlaceMaxBid_Concurrent tests that racing maximum bids resolve to the same outcome every time
+func TestAuctionUsecase_PlaceMaxBid_Concurrent(t *testing.T) {
+	maxBids := map[string]uint64{"alice": 14_000, "bob": 16_000, "carol": 15_000}
+	for range 20 {
+		uc, _ := newTestAuctionUC()
+		a, err := uc.Open(t.Context(), testVIN, 10_000, domain.Reserve{}, time.Time{}, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+
+		var wg sync.WaitGroup
+		for bidder, amount := range maxBids {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, err := uc.PlaceMaxBid(t.Context(), a.ID, &domain.MaxBid{Bidder: bidder, Amount: amount})
+				if err != nil {
+					assert.ErrorIs(t, err, domain.ErrBidTooLow)
+				}
+			}()
+		}
+		wg.Wait()
+
+		got, err := uc.Get(t.Context(), a.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", got.Leader)
+		assert.Equal(t, uint64(15_300), got.HighBid)
+		bids, err := uc.Bids(t.Context(), a.ID)
+		assert.NoError(t, err)
+		assert.Len(t, bids, got.BidCount)
+	}
+}
+
+// TestAuctionUsecase_SoftClose tests that late bids extend the auction on the injected clock
+func TestAuctionUsecase_SoftClose(t *testing.T) {
+	clock := infrastructure.NewMockClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	repo := infrastructure.NewMemoryAuctionRepo()
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	policy := domain.SoftClose{Window: 2 * time.Minute, Extension: 2 * time.Minute, MaxExtensions: 1}
+	uc := usecase.NewAuctionUC(repo, infrastructure.NewMemoryActiveSaleRepo(), vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), clock, policy)
+	end := clock.Now().Add(time.Hour)
+	a, err := uc.Open(t.Context(), testVIN, 10_000, domain.Reserve{}, time.Time{}, end)
+	assert.NoError(t, err)
+	assert.Equal(t, policy, a.SoftClose)
+	events, err := uc.Watch(t.Context(), testVIN, 0)
+	assert.NoError(t, err)
+
+	t.Run("early bid", func(t *testing.T) {
+		clock.Advance(30 * time.Minute)
+		got, err := uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "alice", Amount: 10_000})
+		assert.NoError(t, err)
+		assert.Equal(t, end, got.EndsAt)
+		assert.Equal(t, domain.EventBidPlaced, (<-events).Type)
+		assert.Equal(t, domain.EventPriceChanged, (<-events).Type)
+	})
+
+	t.Run("late bid", func(t *testing.T) {
+		clock.Advance(29 * time.Minute)
+		got, err := uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "bob", Amount: 10_300})
+		assert.NoError(t, err)
+		assert.Equal(t, end.Add(2*time.Minute), got.EndsAt)
+		assert.Equal(t, 1, got.Extensions)
+		<-events
+		<-events
+		e := <-events
+		assert.Equal(t, domain.EventExtended, e.Type)
+		assert.Equal(t, end.Add(2*time.Minute), e.EndsAt)
+
+		stored, err := repo.FindByID(t.Context(), a.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, end.Add(2*time.Minute), stored.EndsAt)
+		assert.Equal(t, 1, stored.Extensions)
+	})
+
+	t.Run("capped", func(t *testing.T) {
+		clock.Advance(2 * time.Minute)
+		got, err := uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "alice", Amount: 10_600})
+		assert.NoError(t, err)
+		assert.Equal(t, end.Add(2*time.Minute), got.EndsAt)
+	})
+
+	t.Run("closed at the extended end", func(t *testing.T) {
+		clock.Advance(time.Minute)
+		n, err := uc.CloseDue(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		got, err := uc.Get(t.Context(), a.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", got.Winner)
+	})
+}
+
+// TestAuctionUsecase_IfSale tests the if-sale negotiation of an auction closed below its reserve
+func TestAuctionUsecase_IfSale(t *testing.T) {
+	clock := infrastructure.NewMockClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	repo := infrastructure.NewMemoryAuctionRepo()
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	uc := usecase.NewAuctionUC(repo, infrastructure.NewMemoryActiveSaleRepo(), vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), clock, domain.SoftClose{})
+
+	t.Run("invalid reserve", func(t *testing.T) {
+		_, err := uc.Open(t.Context(), testVIN, 0, domain.Reserve{Price: 20_000, Percent: 80}, time.Time{}, clock.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("percent reserve without recommended price", func(t *testing.T) {
+		unpriced := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Lifecycle: domain.VehicleListed}}
+		uc := usecase.NewAuctionUC(infrastructure.NewMemoryAuctionRepo(), infrastructure.NewMemoryActiveSaleRepo(), unpriced, infrastructure.NewMemoryEventBroker(100, 64), clock, domain.SoftClose{})
+		_, err := uc.Open(t.Context(), testVIN, 0, domain.Reserve{Percent: 80}, time.Time{}, clock.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, domain.ErrValidation)
+		a, err := uc.Open(t.Context(), testVIN, 0, domain.Reserve{Price: 20_000}, time.Time{}, clock.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(20_000), a.ReservePrice)
+	})
+
+	// Reserve of 80% of the recommended price of 25000
+	a, err := uc.Open(t.Context(), testVIN, 0, domain.Reserve{Percent: 80}, time.Time{}, clock.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(20_000), a.ReservePrice)
+	assert.False(t, a.ReserveMet)
+	events, err := uc.Watch(t.Context(), testVIN, 0)
+	assert.NoError(t, err)
+	got, err := uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "alice", Amount: 15_000})
+	assert.NoError(t, err)
+	assert.False(t, got.ReserveMet)
+	<-events
+	<-events
+
+	t.Run("closed below reserve", func(t *testing.T) {
+		clock.Advance(time.Hour)
+		got, err := uc.Get(t.Context(), a.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AuctionIfSale, got.Status)
+		assert.Empty(t, got.Winner)
+		assert.Equal(t, domain.EventIfSale, (<-events).Type)
+
+		_, err = uc.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, clock.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, domain.ErrSaleExists)
+		ifSale, err := uc.List(t.Context(), domain.AuctionIfSale)
+		assert.NoError(t, err)
+		assert.Len(t, ifSale, 1)
+	})
+
+	t.Run("counter offer", func(t *testing.T) {
+		got, err := uc.CounterOffer(t.Context(), a.ID, 18_000)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(18_000), got.CounterOffer)
+		e := <-events
+		assert.Equal(t, domain.EventCounterOffer, e.Type)
+		assert.Equal(t, "alice", e.Bidder)
+		assert.Zero(t, e.Amount, "the counter offer is not public")
+
+		_, err = uc.AcceptCounterOffer(t.Context(), a.ID, "bob")
+		assert.ErrorIs(t, err, domain.ErrNotBuyer)
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		got, err := uc.AcceptCounterOffer(t.Context(), a.ID, "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AuctionClosed, got.Status)
+		assert.Equal(t, "alice", got.Winner)
+		assert.Equal(t, uint64(18_000), got.SalePrice)
+		e := <-events
+		assert.Equal(t, domain.EventClosed, e.Type)
+		assert.Equal(t, uint64(18_000), e.Amount)
+
+		_, err = uc.DeclineCounterOffer(t.Context(), a.ID, "alice")
+		assert.ErrorIs(t, err, domain.ErrNotNegotiating)
+	})
+}
+
+// TestAuctionUsecase_IfSaleDeadline tests that an if-sale negotiation is declined once its deadline passes
+func TestAuctionUsecase_IfSaleDeadline(t *testing.T) {
+	clock := infrastructure.NewMockClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	uc := usecase.NewAuctionUC(infrastructure.NewMemoryAuctionRepo(), infrastructure.NewMemoryActiveSaleRepo(), vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), clock, domain.SoftClose{})
+
+	a, err := uc.Open(t.Context(), testVIN, 0, domain.Reserve{Percent: 80}, time.Time{}, clock.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	_, err = uc.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "alice", Amount: 15_000})
+	assert.NoError(t, err)
+	clock.Advance(time.Hour)
+	n, err := uc.CloseDue(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	_, err = uc.CounterOffer(t.Context(), a.ID, 18_000)
+	assert.NoError(t, err)
+
+	// Still negotiating before the deadline
+	clock.Advance(domain.IfSaleDuration - time.Minute)
+	n, err = uc.CloseDue(t.Context())
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+
+	// Declined at the deadline, the vehicle may be put on sale again
+	clock.Advance(time.Minute)
+	n, err = uc.CloseDue(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	got, err := uc.Get(t.Context(), a.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AuctionClosed, got.Status)
+	assert.Empty(t, got.Winner)
+	_, err = uc.AcceptCounterOffer(t.Context(), a.ID, "alice")
+	assert.ErrorIs(t, err, domain.ErrNotNegotiating)
+	_, err = uc.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, clock.Now().Add(time.Hour))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,8 @@
+package usecase
+
+import "time"
+
+// Clock defines the interface for telling the current time
+type Clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/repository"
+)
+
+// DutchUsecase defines the interface for Dutch clock business logic
+type DutchUsecase interface {
+	Open(ctx context.Context, vin string, floorPrice, step uint64, stepSeconds int64, startsAt time.Time) (*domain.DutchClock, error)
+	Get(ctx context.Context, id string) (*domain.DutchClock, error)
+	List(ctx context.Context, status string) ([]*domain.DutchClock, error)
+	Accept(ctx context.Context, id, buyer string) (*domain.DutchClock, error)
+	ExpireDue(ctx context.Context) (int, error)
+}
+
+// dutchUsecase is the implementation of DutchUsecase interface
+type dutchUsecase struct {
+	repo            repository.DutchClockRepository
+	sales           repository.ActiveSaleRepository
+	vehicleProvider VehicleProvider
+	clock           Clock
+	startPercent    uint64
+	stepSeconds     int64
+}
+
+// NewDutchUC is the constructor for dutchUsecase, new clocks start at startPercent of the recommended price
+// and drop every stepSeconds unless told otherwise, they claim their vehicles among the sales of all modes
+func NewDutchUC(r repository.DutchClockRepository, sales repository.ActiveSaleRepository, vehicleProvider VehicleProvider, clock Clock, startPercent uint64, stepSeconds int64) *dutchUsecase {
+	return &dutchUsecase{
+		repo:            r,
+		sales:           sales,
+		vehicleProvider: vehicleProvider,
+		clock:           clock,
+		startPercent:    startPercent,
+		stepSeconds:     stepSeconds,
+	}
+}
+
+// Open starts a new clock of a listed vehicle that is not on sale yet, a zero start time starts it right away
+func (uc *dutchUsecase) Open(ctx context.Context, vin string, floorPrice, step uint64, stepSeconds int64, startsAt time.Time) (*domain.DutchClock, error) {
+
+	// Verify the vehicle through the vehicle service
+	v, err := sellableVehicle(ctx, uc.vehicleProvider, vin)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare and validate the clock
+	id, err := newAuctionID()
+	if err != nil {
+		return nil, err
+	}
+	if startsAt.IsZero() {
+		startsAt = uc.clock.Now()
+	}
+	if stepSeconds == 0 {
+		stepSeconds = uc.stepSeconds
+	}
+	c := domain.NewDutchClock(id, v.VIN, v.Price, uc.startPercent, floorPrice, step, stepSeconds, startsAt)
+	if err := c.Validate(); err != nil {
+		return nil, domain.ErrValidation
+	}
+
+	// Claim the vehicle and save the clock
+	err = openSale(ctx, uc.sales, uc.vehicleProvider, c.VIN, domain.SaleDutch, c.ID, uc.clock.Now(), func() error {
+		return uc.repo.Save(ctx, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Get retrieves a clock by its ID at its current price, expiring it first when it has passed the floor
+func (uc *dutchUsecase) Get(ctx context.Context, id string) (*domain.DutchClock, error) {
+	c, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := uc.clock.Now()
+	err = uc.expireIfDue(ctx, c, now)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		c, err = uc.repo.FindByID(ctx, id) // sold or expired concurrently
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.Tick(now)
+	return c, nil
+}
+
+// List lists clocks at their current prices, optionally of the given status only
+func (uc *dutchUsecase) List(ctx context.Context, status string) ([]*domain.DutchClock, error) {
+	if status != "" && status != domain.ClockRunning && status != domain.ClockSold && status != domain.ClockExpired {
+		return nil, domain.ErrValidation
+	}
+	clocks, err := uc.repo.List(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+	now := uc.clock.Now()
+	for _, c := range clocks {
+		c.Tick(now)
+	}
+	return clocks, nil
+}
+
+// Accept sells the vehicle to the buyer at the current price, the first acceptance saved wins
+// and the acceptances racing with it find the clock sold
+func (uc *dutchUsecase) Accept(ctx context.Context, id, buyer string) (*domain.DutchClock, error) {
+	for attempt := 1; ; attempt++ {
+
+		// Accept the latest state of the clock
+		c, err := uc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.Accept(buyer, uc.clock.Now()); err != nil {
+			return nil, err
+		}
+
+		// Save the sale, guarded by the version it was accepted on, and settle it in the vehicle service
+		err = uc.repo.Update(ctx, c)
+		if errors.Is(err, domain.ErrVersionConflict) && attempt < maxBidAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		settleSale(ctx, uc.sales, uc.vehicleProvider, c.VIN, domain.SaleDutch, c.ID, c.Buyer, c.SalePrice, uc.clock.Now()) // nolint:errcheck
+		return c, nil
+	}
+}
+
+// ExpireDue expires all running clocks that have passed their floor unsold and returns how many were expired,
+// prices are derived from the start times, so the clocks resume where they were after a restart
+func (uc *dutchUsecase) ExpireDue(ctx context.Context) (int, error) {
+	now := uc.clock.Now()
+	clocks, err := uc.repo.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	expired := 0
+	for _, c := range clocks {
+		err := uc.expireIfDue(ctx, c, now)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			continue // sold or expired concurrently
+		}
+		if err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// expireIfDue expires the clock when it has passed its floor unsold and settles it unsold in the vehicle service
+func (uc *dutchUsecase) expireIfDue(ctx context.Context, c *domain.DutchClock, now time.Time) error {
+	if !c.IsDue(now) {
+		return nil
+	}
+	c.Expire(now)
+	if err := uc.repo.Update(ctx, c); err != nil {
+		return err
+	}
+	settleSale(ctx, uc.sales, uc.vehicleProvider, c.VIN, domain.SaleDutch, c.ID, "", 0, now) // nolint:errcheck
+	return nil
+}
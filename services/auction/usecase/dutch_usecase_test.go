@@ -0,0 +1,117 @@
+package usecase_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// newTestDutchUC creates a Dutch clock usecase over the repository, a mock vehicle service and the clock,
+// clocks start at 120% of the recommended price of 25,000 and drop every minute
+func newTestDutchUC(repo *infrastructure.MemoryDutchClockRepo, clock usecase.Clock) usecase.DutchUsecase {
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	return usecase.NewDutchUC(repo, infrastructure.NewMemoryActiveSaleRepo(), vehicleProvider, clock, 120, 60)
+}
+
+// TestDutchUsecase_Open tests the Open method of DutchUsecase
+func TestDutchUsecase_Open(t *testing.T) {
+	uc := newTestDutchUC(infrastructure.NewMemoryDutchClockRepo(), infrastructure.SystemClock{})
+
+	c, err := uc.Open(t.Context(), testVIN, 20_000, 1_000, 0, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(30_000), c.StartPrice)
+	assert.Equal(t, int64(60), c.StepSeconds, "the configured default")
+
+	_, err = uc.Open(t.Context(), testVIN, 20_000, 1_000, 0, time.Time{})
+	assert.ErrorIs(t, err, domain.ErrSaleExists)
+	_, err = uc.Open(t.Context(), "1HGCM82633A000001", 40_000, 0, 0, time.Time{})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	_, err = uc.List(t.Context(), "open")
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+// TestDutchUsecase_Accept tests that the price drops over time and the first acceptance wins
+func TestDutchUsecase_Accept(t *testing.T) {
+	repo := infrastructure.NewMemoryDutchClockRepo()
+	clock := infrastructure.NewMockClock(time.Now())
+	uc := newTestDutchUC(repo, clock)
+	c, err := uc.Open(t.Context(), testVIN, 20_000, 1_000, 0, time.Time{})
+	assert.NoError(t, err)
+
+	t.Run("price drops", func(t *testing.T) {
+		clock.Advance(3*time.Minute + time.Second)
+		got, err := uc.Get(t.Context(), c.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(27_000), got.Price)
+	})
+
+	t.Run("survives a restart", func(t *testing.T) {
+		restarted := newTestDutchUC(repo, clock)
+		got, err := restarted.Get(t.Context(), c.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(27_000), got.Price)
+	})
+
+	t.Run("first acceptance wins", func(t *testing.T) {
+		var wg sync.WaitGroup
+		errs := make([]error, 8)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = uc.Accept(t.Context(), c.ID, fmt.Sprintf("buyer-%d", i))
+			}()
+		}
+		wg.Wait()
+		won := 0
+		for _, err := range errs {
+			if err == nil {
+				won++
+				continue
+			}
+			assert.ErrorIs(t, err, domain.ErrClockNotRunning)
+		}
+		assert.Equal(t, 1, won)
+
+		clock.Advance(time.Minute)
+		got, err := uc.Get(t.Context(), c.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ClockSold, got.Status)
+		assert.Equal(t, uint64(27_000), got.Price, "sold clocks keep the sale price")
+		_, err = uc.Open(t.Context(), testVIN, 20_000, 1_000, 0, time.Time{})
+		assert.ErrorIs(t, err, domain.ErrNotForSale, "sold vehicles are settled in the vehicle service")
+	})
+}
+
+// TestDutchUsecase_ExpireDue tests the ExpireDue method of DutchUsecase
+func TestDutchUsecase_ExpireDue(t *testing.T) {
+	clock := infrastructure.NewMockClock(time.Now())
+	uc := newTestDutchUC(infrastructure.NewMemoryDutchClockRepo(), clock)
+	c, err := uc.Open(t.Context(), testVIN, 20_000, 1_000, 0, time.Time{})
+	assert.NoError(t, err)
+
+	clock.Advance(10 * time.Minute)
+	n, err := uc.ExpireDue(t.Context())
+	assert.NoError(t, err)
+	assert.Zero(t, n, "still running at the floor")
+
+	clock.Advance(time.Minute)
+	n, err = uc.ExpireDue(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	_, err = uc.Accept(t.Context(), c.ID, "alice")
+	assert.ErrorIs(t, err, domain.ErrClockNotRunning)
+
+	expired, err := uc.List(t.Context(), domain.ClockExpired)
+	assert.NoError(t, err)
+	assert.Len(t, expired, 1)
+	_, err = uc.Open(t.Context(), testVIN, 20_000, 1_000, 0, time.Time{})
+	assert.NoError(t, err, "expired clocks release the vehicle")
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// EventBroker defines the interface for publishing auction events and watching them per VIN
+type EventBroker interface {
+	Publish(e *domain.AuctionEvent)
+	Subscribe(ctx context.Context, vin string, lastEventID int64) <-chan *domain.AuctionEvent
+}
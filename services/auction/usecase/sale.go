@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/repository"
+)
+
+// sellableVehicle verifies the vehicle through the vehicle service and checks it may be put on sale
+func sellableVehicle(ctx context.Context, vehicleProvider VehicleProvider, vin string) (*domain.Vehicle, error) {
+	v, err := vehicleProvider.GetVehicle(ctx, vin)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.CheckSellable(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// openSale claims the vehicle for the sale of the mode, first among the sales of all modes and then
+// in the vehicle service so that it is not sold buy-now meanwhile, and saves the sale;
+// both claims are dropped when the sale cannot be opened
+func openSale(ctx context.Context, sales repository.ActiveSaleRepository, vehicleProvider VehicleProvider, vin, mode, id string, now time.Time, save func() error) error {
+	if err := sales.Claim(ctx, &domain.ActiveSale{VIN: vin, Mode: mode, SaleID: id, CreatedAt: now}); err != nil {
+		return err
+	}
+	if err := vehicleProvider.ClaimSale(ctx, vin, mode, id); err != nil {
+		abandonSale(ctx, sales, vehicleProvider, vin, id) // nolint:errcheck
+		return err
+	}
+	if err := save(); err != nil {
+		abandonSale(ctx, sales, vehicleProvider, vin, id) // nolint:errcheck
+		return err
+	}
+	return nil
+}
+
+// abandonSale drops the claims of a sale that was never saved, the claim among the sales is kept
+// until the vehicle service has released the vehicle, so that SettleDue retries it
+func abandonSale(ctx context.Context, sales repository.ActiveSaleRepository, vehicleProvider VehicleProvider, vin, id string) error {
+	err := vehicleProvider.ReleaseSale(ctx, vin, id)
+	if err != nil && !errors.Is(err, domain.ErrVehicleNotFound) {
+		return err
+	}
+	return sales.Release(ctx, vin, id)
+}
+
+// settleSale records the outcome of an ended sale in the vehicle service, an empty buyer settles it unsold;
+// the vehicle of an unsold sale may be put on sale again, while a sold one stays claimed so it cannot be sold twice,
+// a failed settlement leaves the claim unsettled for SettleDue
+func settleSale(ctx context.Context, sales repository.ActiveSaleRepository, vehicleProvider VehicleProvider, vin, mode, id, buyer string, price uint64, now time.Time) error {
+
+	// A vehicle the vehicle service refuses to settle, e.g. deleted meanwhile, is not retried
+	err := vehicleProvider.SettleSale(ctx, vin, mode, id, buyer, price)
+	if err != nil && !errors.Is(err, domain.ErrNotForSale) && !errors.Is(err, domain.ErrVehicleNotFound) {
+		return err
+	}
+	if buyer == "" {
+		return sales.Release(ctx, vin, id)
+	}
+	return sales.Settle(ctx, vin, id, now)
+}
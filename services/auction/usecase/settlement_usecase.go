@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/repository"
+)
+
+// errSaleNotSaved reports a claim whose sale has not been saved
+var errSaleNotSaved = errors.New("sale not saved")
+
+// SettlementUsecase defines the interface for settling the ended sales of all modes in the vehicle service
+type SettlementUsecase interface {
+	SettleDue(ctx context.Context) (int, error)
+}
+
+// settlementUsecase is the implementation of SettlementUsecase interface
+type settlementUsecase struct {
+	sales           repository.ActiveSaleRepository
+	vehicleProvider VehicleProvider
+	auctions        repository.AuctionRepository
+	tenders         repository.TenderRepository
+	clocks          repository.DutchClockRepository
+	clock           Clock
+}
+
+// NewSettlementUC is the constructor for settlementUsecase
+func NewSettlementUC(sales repository.ActiveSaleRepository, vehicleProvider VehicleProvider, auctions repository.AuctionRepository, tenders repository.TenderRepository, clocks repository.DutchClockRepository, clock Clock) *settlementUsecase {
+	return &settlementUsecase{
+		sales:           sales,
+		vehicleProvider: vehicleProvider,
+		auctions:        auctions,
+		tenders:         tenders,
+		clocks:          clocks,
+		clock:           clock,
+	}
+}
+
+// SettleDue settles the unsettled claims of the ended sales, e.g. after a failed call to the vehicle service,
+// and abandons the claims whose sale was not saved within the stale claim age; returns how many were handled
+func (uc *settlementUsecase) SettleDue(ctx context.Context) (int, error) {
+	claims, err := uc.sales.ListUnsettled(ctx)
+	if err != nil {
+		return 0, err
+	}
+	now := uc.clock.Now()
+	settled := 0
+	for _, s := range claims {
+		ended, buyer, price, err := uc.outcome(ctx, s)
+		switch {
+		case errors.Is(err, errSaleNotSaved):
+			if now.Sub(s.CreatedAt) < domain.StaleClaimAge {
+				continue // the sale may still be saved
+			}
+			err = abandonSale(ctx, uc.sales, uc.vehicleProvider, s.VIN, s.SaleID)
+		case err != nil:
+		case !ended:
+			continue
+		default:
+			err = settleSale(ctx, uc.sales, uc.vehicleProvider, s.VIN, s.Mode, s.SaleID, buyer, price, now)
+		}
+		if err != nil {
+			return settled, err
+		}
+		settled++
+	}
+	return settled, nil
+}
+
+// outcome reports whether the sale of the claim has ended and its buyer and price, if sold
+func (uc *settlementUsecase) outcome(ctx context.Context, s *domain.ActiveSale) (bool, string, uint64, error) {
+	switch s.Mode {
+	case domain.SaleAuction:
+		a, err := uc.auctions.FindByID(ctx, s.SaleID)
+		if errors.Is(err, domain.ErrNotFound) {
+			return false, "", 0, errSaleNotSaved
+		}
+		if err != nil {
+			return false, "", 0, err
+		}
+		return a.Status == domain.AuctionClosed, a.Winner, a.SalePrice, nil
+	case domain.SaleTender:
+		t, err := uc.tenders.FindByID(ctx, s.SaleID)
+		if errors.Is(err, domain.ErrTenderNotFound) {
+			return false, "", 0, errSaleNotSaved
+		}
+		if err != nil {
+			return false, "", 0, err
+		}
+		return t.Status == domain.TenderClosed, t.Winner, t.SalePrice, nil
+	case domain.SaleDutch:
+		c, err := uc.clocks.FindByID(ctx, s.SaleID)
+		if errors.Is(err, domain.ErrClockNotFound) {
+			return false, "", 0, errSaleNotSaved
+		}
+		if err != nil {
+			return false, "", 0, err
+		}
+		return c.Status != domain.ClockRunning, c.Buyer, c.SalePrice, nil
+	}
+	return false, "", 0, errSaleNotSaved
+}
@@ -0,0 +1,73 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// TestSettlementUsecase_SettleDue tests the SettleDue method of SettlementUsecase
+func TestSettlementUsecase_SettleDue(t *testing.T) {
+	clock := infrastructure.NewMockClock(time.Now())
+	sales := infrastructure.NewMemoryActiveSaleRepo()
+	auctionRepo := infrastructure.NewMemoryAuctionRepo()
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Price: 25_000, Lifecycle: domain.VehicleListed}}
+	auctions := usecase.NewAuctionUC(auctionRepo, sales, vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), clock, domain.SoftClose{})
+	uc := usecase.NewSettlementUC(sales, vehicleProvider, auctionRepo, infrastructure.NewMemoryTenderRepo(), infrastructure.NewMemoryDutchClockRepo(), clock)
+
+	t.Run("retries a failed settlement", func(t *testing.T) {
+		a, err := auctions.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, clock.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		_, err = auctions.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "alice", Amount: 20_000})
+		assert.NoError(t, err)
+		n, err := uc.SettleDue(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n, "the open auction is not due")
+
+		// The vehicle service is down when the auction closes
+		vehicleProvider.SettleErr = errors.New("unavailable")
+		clock.Advance(time.Hour)
+		_, err = auctions.CloseDue(t.Context())
+		assert.NoError(t, err)
+		_, settled, _ := vehicleProvider.Sale(testVIN)
+		assert.False(t, settled)
+		_, err = uc.SettleDue(t.Context())
+		assert.Error(t, err)
+
+		vehicleProvider.SettleErr = nil
+		n, err = uc.SettleDue(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		id, settled, buyer := vehicleProvider.Sale(testVIN)
+		assert.Equal(t, a.ID, id)
+		assert.True(t, settled)
+		assert.Equal(t, "alice", buyer)
+		n, err = uc.SettleDue(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n, "settled once")
+	})
+
+	t.Run("abandons a stale claim", func(t *testing.T) {
+		const vin = "2HGCM82633A654321"
+		assert.NoError(t, sales.Claim(t.Context(), &domain.ActiveSale{VIN: vin, Mode: domain.SaleAuction, SaleID: "lost", CreatedAt: clock.Now()}))
+		assert.NoError(t, vehicleProvider.ClaimSale(t.Context(), vin, domain.SaleAuction, "lost"))
+		n, err := uc.SettleDue(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n, "the sale may still be saved")
+
+		clock.Advance(domain.StaleClaimAge)
+		n, err = uc.SettleDue(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		id, _, _ := vehicleProvider.Sale(vin)
+		assert.Empty(t, id, "released in the vehicle service")
+		_, err = auctions.Open(t.Context(), vin, 0, domain.Reserve{}, time.Time{}, clock.Now().Add(time.Hour))
+		assert.NoError(t, err)
+	})
+}
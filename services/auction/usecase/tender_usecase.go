@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/repository"
+)
+
+// TenderUsecase defines the interface for sealed-bid tender business logic
+type TenderUsecase interface {
+	Open(ctx context.Context, vin, settlement string, minPrice uint64, startsAt, endsAt time.Time) (*domain.Tender, error)
+	Get(ctx context.Context, id string) (*domain.Tender, error)
+	List(ctx context.Context, status string) ([]*domain.Tender, error)
+	SubmitBid(ctx context.Context, id string, b *domain.SealedBid) (*domain.Tender, error)
+	Results(ctx context.Context, id string) (*domain.TenderResult, error)
+	CloseDue(ctx context.Context) (int, error)
+}
+
+// tenderUsecase is the implementation of TenderUsecase interface
+type tenderUsecase struct {
+	repo            repository.TenderRepository
+	sales           repository.ActiveSaleRepository
+	vehicleProvider VehicleProvider
+	clock           Clock
+}
+
+// NewTenderUC is the constructor for tenderUsecase, new tenders claim their vehicles among the sales of all modes
+func NewTenderUC(r repository.TenderRepository, sales repository.ActiveSaleRepository, vehicleProvider VehicleProvider, clock Clock) *tenderUsecase {
+	return &tenderUsecase{
+		repo:            r,
+		sales:           sales,
+		vehicleProvider: vehicleProvider,
+		clock:           clock,
+	}
+}
+
+// Open opens a new tender of a listed vehicle that is not on sale yet, a zero start time opens it right away
+func (uc *tenderUsecase) Open(ctx context.Context, vin, settlement string, minPrice uint64, startsAt, endsAt time.Time) (*domain.Tender, error) {
+
+	// Verify the vehicle through the vehicle service
+	v, err := sellableVehicle(ctx, uc.vehicleProvider, vin)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare and validate the tender
+	id, err := newAuctionID()
+	if err != nil {
+		return nil, err
+	}
+	if startsAt.IsZero() {
+		startsAt = uc.clock.Now()
+	}
+	t := domain.NewTender(id, v.VIN, settlement, v.Price, minPrice, startsAt, endsAt)
+	if err := t.Validate(); err != nil {
+		return nil, domain.ErrValidation
+	}
+
+	// Claim the vehicle and save the tender
+	err = openSale(ctx, uc.sales, uc.vehicleProvider, t.VIN, domain.SaleTender, t.ID, uc.clock.Now(), func() error {
+		return uc.repo.Save(ctx, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Get retrieves a tender by its ID, closing it first when its end time has passed
+func (uc *tenderUsecase) Get(ctx context.Context, id string) (*domain.Tender, error) {
+	t, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.closeIfDue(ctx, t, uc.clock.Now())
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return uc.repo.FindByID(ctx, id) // closed or bid on concurrently
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// List lists tenders, optionally of the given status only
+func (uc *tenderUsecase) List(ctx context.Context, status string) ([]*domain.Tender, error) {
+	if status != "" && status != domain.TenderOpen && status != domain.TenderClosed {
+		return nil, domain.ErrValidation
+	}
+	return uc.repo.List(ctx, status)
+}
+
+// SubmitBid submits the single sealed bid of a buyer, bids racing with each other or with the close are retried
+// against the latest state of the tender
+func (uc *tenderUsecase) SubmitBid(ctx context.Context, id string, b *domain.SealedBid) (*domain.Tender, error) {
+	for attempt := 1; ; attempt++ {
+
+		// Accept the bid into the latest state of the tender
+		t, err := uc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.SubmitBid(b, uc.clock.Now()); err != nil {
+			return nil, err
+		}
+
+		// Save the bid, guarded by the version it was accepted into
+		err = uc.repo.SaveBid(ctx, t, b)
+		if errors.Is(err, domain.ErrVersionConflict) && attempt < maxBidAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+}
+
+// Results publishes the outcome of a closed tender with all of its bids, bids stay sealed while the tender is open
+func (uc *tenderUsecase) Results(ctx context.Context, id string) (*domain.TenderResult, error) {
+	t, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status != domain.TenderClosed {
+		return nil, domain.ErrTenderSealed
+	}
+	bids, err := uc.repo.Bids(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return t.Results(bids)
+}
+
+// CloseDue closes all open tenders whose end time has passed and returns how many were closed
+func (uc *tenderUsecase) CloseDue(ctx context.Context) (int, error) {
+	now := uc.clock.Now()
+	tenders, err := uc.repo.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	closed := 0
+	for _, t := range tenders {
+		err := uc.closeIfDue(ctx, t, now)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			continue // closed or bid on concurrently, picked up by the next run if still due
+		}
+		if err != nil {
+			return closed, err
+		}
+		closed++
+	}
+	return closed, nil
+}
+
+// closeIfDue reveals the bids and settles the tender when its end time has passed,
+// the closed tender is settled in the vehicle service, sold to the winner or unsold
+func (uc *tenderUsecase) closeIfDue(ctx context.Context, t *domain.Tender, now time.Time) error {
+	if !t.IsDue(now) {
+		return nil
+	}
+	bids, err := uc.repo.Bids(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+	t.Close(bids, now)
+	if err := uc.repo.Update(ctx, t); err != nil {
+		return err
+	}
+	settleSale(ctx, uc.sales, uc.vehicleProvider, t.VIN, domain.SaleTender, t.ID, t.Winner, t.SalePrice, now) // nolint:errcheck
+	return nil
+}
@@ -0,0 +1,165 @@
+package usecase_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+	"github.com/alechekz/online-car-auction/services/auction/infrastructure"
+	"github.com/alechekz/online-car-auction/services/auction/usecase"
+)
+
+// newTestTenderUC creates a tender usecase over an in-memory repository, a mock vehicle service and a mock clock
+func newTestTenderUC() (usecase.TenderUsecase, *infrastructure.MockClock) {
+	clock := infrastructure.NewMockClock(time.Now())
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Brand: "Kia", Year: 2020, Price: 25_000, Lifecycle: domain.VehicleListed}}
+	return usecase.NewTenderUC(infrastructure.NewMemoryTenderRepo(), infrastructure.NewMemoryActiveSaleRepo(), vehicleProvider, clock), clock
+}
+
+// TestTenderUsecase_Open tests the Open method of TenderUsecase
+func TestTenderUsecase_Open(t *testing.T) {
+	uc, clock := newTestTenderUC()
+
+	tender, err := uc.Open(t.Context(), testVIN, domain.SecondPrice, 0, time.Time{}, clock.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12_500), tender.MinPrice)
+	assert.Equal(t, int64(1), tender.Version)
+
+	_, err = uc.Open(t.Context(), testVIN, domain.FirstPrice, 0, time.Time{}, clock.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrSaleExists)
+	_, err = uc.Open(t.Context(), "1HGCM82633A000001", "dutch", 0, time.Time{}, clock.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	_, err = uc.List(t.Context(), "pending")
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+// TestTenderUsecase_OpenExclusive tests that a vehicle is on sale in one mode at a time
+func TestTenderUsecase_OpenExclusive(t *testing.T) {
+	clock := infrastructure.NewMockClock(time.Now())
+	sales := infrastructure.NewMemoryActiveSaleRepo()
+	vehicleProvider := &infrastructure.MockVehicleProvider{Data: &domain.Vehicle{Price: 25_000, Lifecycle: domain.VehicleListed}}
+	auctions := usecase.NewAuctionUC(infrastructure.NewMemoryAuctionRepo(), sales, vehicleProvider, infrastructure.NewMemoryEventBroker(100, 64), clock, domain.SoftClose{})
+	tenders := usecase.NewTenderUC(infrastructure.NewMemoryTenderRepo(), sales, vehicleProvider, clock)
+
+	// The open auction holds the vehicle
+	first, err := auctions.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, clock.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	id, settled, _ := vehicleProvider.Sale(testVIN)
+	assert.Equal(t, first.ID, id, "claimed in the vehicle service")
+	assert.False(t, settled)
+	_, err = tenders.Open(t.Context(), testVIN, domain.SecondPrice, 0, time.Time{}, clock.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrSaleExists)
+
+	// The closed auction releases it
+	clock.Advance(time.Hour)
+	closed, err := auctions.CloseDue(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, closed)
+	tender, err := tenders.Open(t.Context(), testVIN, domain.SecondPrice, 0, time.Time{}, clock.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	_, err = auctions.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, clock.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrSaleExists)
+
+	// The tender closed unsold releases it too
+	clock.Advance(time.Hour)
+	got, err := tenders.Get(t.Context(), tender.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.TenderClosed, got.Status)
+	a, err := auctions.Open(t.Context(), testVIN, 0, domain.Reserve{}, time.Time{}, clock.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	// The sold vehicle is settled in the vehicle service and stays claimed
+	_, err = auctions.PlaceBid(t.Context(), a.ID, &domain.Bid{Bidder: "alice", Amount: 20_000})
+	assert.NoError(t, err)
+	clock.Advance(time.Hour)
+	sold, err := auctions.Get(t.Context(), a.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", sold.Winner)
+	id, settled, buyer := vehicleProvider.Sale(testVIN)
+	assert.Equal(t, a.ID, id)
+	assert.True(t, settled)
+	assert.Equal(t, "alice", buyer)
+	_, err = tenders.Open(t.Context(), testVIN, domain.SecondPrice, 0, time.Time{}, clock.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrNotForSale)
+}
+
+// TestTenderUsecase_SealedBids tests that bids stay sealed until the tender closes and are settled then
+func TestTenderUsecase_SealedBids(t *testing.T) {
+	uc, clock := newTestTenderUC()
+	tender, err := uc.Open(t.Context(), testVIN, domain.SecondPrice, 0, time.Time{}, clock.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	t.Run("concurrent bids", func(t *testing.T) {
+		var wg sync.WaitGroup
+		errs := make([]error, 8)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = uc.SubmitBid(t.Context(), tender.ID, &domain.SealedBid{Bidder: fmt.Sprintf("buyer-%d", i), Amount: uint64(20_000 + i*100)})
+			}()
+		}
+		wg.Wait()
+		for _, err := range errs {
+			assert.NoError(t, err)
+		}
+		got, err := uc.Get(t.Context(), tender.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 8, got.BidCount)
+	})
+
+	t.Run("one bid per buyer", func(t *testing.T) {
+		_, err := uc.SubmitBid(t.Context(), tender.ID, &domain.SealedBid{Bidder: "buyer-0", Amount: 30_000})
+		assert.ErrorIs(t, err, domain.ErrAlreadyBid)
+	})
+
+	t.Run("sealed while open", func(t *testing.T) {
+		_, err := uc.Results(t.Context(), tender.ID)
+		assert.ErrorIs(t, err, domain.ErrTenderSealed)
+	})
+
+	t.Run("closed when due", func(t *testing.T) {
+		clock.Advance(time.Hour)
+		_, err := uc.SubmitBid(t.Context(), tender.ID, &domain.SealedBid{Bidder: "late", Amount: 30_000})
+		assert.ErrorIs(t, err, domain.ErrTenderNotOpen)
+
+		res, err := uc.Results(t.Context(), tender.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TenderClosed, res.Tender.Status)
+		assert.Equal(t, "buyer-7", res.Tender.Winner)
+		assert.Equal(t, uint64(20_600), res.Tender.SalePrice, "the second highest bid")
+		assert.Len(t, res.Bids, 8)
+		assert.Equal(t, uint64(20_700), res.Bids[0].Amount)
+	})
+
+	t.Run("unknown tender", func(t *testing.T) {
+		_, err := uc.Results(t.Context(), "missing")
+		assert.ErrorIs(t, err, domain.ErrTenderNotFound)
+	})
+}
+
+// TestTenderUsecase_CloseDue tests the CloseDue method of TenderUsecase
+func TestTenderUsecase_CloseDue(t *testing.T) {
+	uc, clock := newTestTenderUC()
+	tender, err := uc.Open(t.Context(), testVIN, domain.FirstPrice, 0, time.Time{}, clock.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	_, err = uc.SubmitBid(t.Context(), tender.ID, &domain.SealedBid{Bidder: "alice", Amount: 20_000})
+	assert.NoError(t, err)
+
+	n, err := uc.CloseDue(t.Context())
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+
+	clock.Advance(time.Hour)
+	n, err = uc.CloseDue(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	closed, err := uc.List(t.Context(), domain.TenderClosed)
+	assert.NoError(t, err)
+	assert.Len(t, closed, 1)
+	assert.Equal(t, uint64(20_000), closed[0].SalePrice)
+}
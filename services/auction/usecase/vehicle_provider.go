@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/alechekz/online-car-auction/services/auction/domain"
+)
+
+// VehicleProvider defines the interface for verifying vehicles through the vehicle service
+// and for claiming them for the sales, settling and releasing them
+type VehicleProvider interface {
+	GetVehicle(ctx context.Context, vin string) (*domain.Vehicle, error)
+	ClaimSale(ctx context.Context, vin, mode, saleID string) error
+	SettleSale(ctx context.Context, vin, mode, saleID, buyer string, price uint64) error
+	ReleaseSale(ctx context.Context, vin, saleID string) error
+}
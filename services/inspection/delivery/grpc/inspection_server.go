@@ -25,14 +25,36 @@ func (s *InspectionServer) GetBuildData(ctx context.Context, req *pb.GetBuildDat
 		return nil, err
 	}
 	return &pb.BuildDataResponse{
-		Vin:          data.VIN,
-		Brand:        data.Brand,
-		Engine:       data.Engine,
-		Transmission: data.Transmission,
-		Msrp:         uint64(data.MSRP),
+		Vin:                  data.VIN,
+		Brand:                data.Brand,
+		Engine:               data.Engine,
+		Transmission:         data.Transmission,
+		Msrp:                 uint64(data.MSRP),
+		Model:                data.Model,
+		Trim:                 data.Trim,
+		BodyClass:            data.BodyClass,
+		DriveType:            data.DriveType,
+		FuelType:             data.FuelType,
+		Displacement:         data.Displacement,
+		Cylinders:            int32(data.Cylinders), //nolint:gosec // engine cylinders
+		Doors:                int32(data.Doors),     //nolint:gosec // vehicle doors
+		ElectrificationLevel: data.ElectrificationLevel,
+		DecodeQuality:        toProtoDecodeQuality(data.DecodeQuality),
 	}, nil
 }
 
+// toProtoDecodeQuality converts the decode quality of the build data to its protobuf message
+func toProtoDecodeQuality(q *domain.DecodeQuality) *pb.DecodeQuality {
+	if q == nil {
+		return nil
+	}
+	codes := make([]int32, len(q.Codes))
+	for i, c := range q.Codes {
+		codes[i] = int32(c) //nolint:gosec // NHTSA error codes are small
+	}
+	return &pb.DecodeQuality{Status: q.Status, Codes: codes, Message: q.Message}
+}
+
 // InspectVehicle inspects a vehicle and returns its grade
 func (s *InspectionServer) InspectVehicle(ctx context.Context, req *pb.InspectVehicleRequest) (*pb.InspectVehicleResponse, error) {
 	v := &domain.Vehicle{
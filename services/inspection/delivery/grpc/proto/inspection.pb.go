@@ -66,14 +66,24 @@ func (x *GetBuildDataRequest) GetVin() string {
 }
 
 type BuildDataResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
-	Brand         string                 `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"`
-	Engine        string                 `protobuf:"bytes,3,opt,name=engine,proto3" json:"engine,omitempty"`
-	Transmission  string                 `protobuf:"bytes,4,opt,name=transmission,proto3" json:"transmission,omitempty"`
-	Msrp          uint64                 `protobuf:"varint,5,opt,name=msrp,proto3" json:"msrp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Vin                  string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Brand                string                 `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"`
+	Engine               string                 `protobuf:"bytes,3,opt,name=engine,proto3" json:"engine,omitempty"`
+	Transmission         string                 `protobuf:"bytes,4,opt,name=transmission,proto3" json:"transmission,omitempty"`
+	Msrp                 uint64                 `protobuf:"varint,5,opt,name=msrp,proto3" json:"msrp,omitempty"`
+	Model                string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	Trim                 string                 `protobuf:"bytes,7,opt,name=trim,proto3" json:"trim,omitempty"`
+	BodyClass            string                 `protobuf:"bytes,8,opt,name=body_class,json=bodyClass,proto3" json:"body_class,omitempty"`
+	DriveType            string                 `protobuf:"bytes,9,opt,name=drive_type,json=driveType,proto3" json:"drive_type,omitempty"`
+	FuelType             string                 `protobuf:"bytes,10,opt,name=fuel_type,json=fuelType,proto3" json:"fuel_type,omitempty"`
+	Displacement         float64                `protobuf:"fixed64,11,opt,name=displacement,proto3" json:"displacement,omitempty"`
+	Cylinders            int32                  `protobuf:"varint,12,opt,name=cylinders,proto3" json:"cylinders,omitempty"`
+	Doors                int32                  `protobuf:"varint,13,opt,name=doors,proto3" json:"doors,omitempty"`
+	ElectrificationLevel string                 `protobuf:"bytes,14,opt,name=electrification_level,json=electrificationLevel,proto3" json:"electrification_level,omitempty"`
+	DecodeQuality        *DecodeQuality         `protobuf:"bytes,15,opt,name=decode_quality,json=decodeQuality,proto3" json:"decode_quality,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *BuildDataResponse) Reset() {
@@ -141,6 +151,136 @@ func (x *BuildDataResponse) GetMsrp() uint64 {
 	return 0
 }
 
+func (x *BuildDataResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *BuildDataResponse) GetTrim() string {
+	if x != nil {
+		return x.Trim
+	}
+	return ""
+}
+
+func (x *BuildDataResponse) GetBodyClass() string {
+	if x != nil {
+		return x.BodyClass
+	}
+	return ""
+}
+
+func (x *BuildDataResponse) GetDriveType() string {
+	if x != nil {
+		return x.DriveType
+	}
+	return ""
+}
+
+func (x *BuildDataResponse) GetFuelType() string {
+	if x != nil {
+		return x.FuelType
+	}
+	return ""
+}
+
+func (x *BuildDataResponse) GetDisplacement() float64 {
+	if x != nil {
+		return x.Displacement
+	}
+	return 0
+}
+
+func (x *BuildDataResponse) GetCylinders() int32 {
+	if x != nil {
+		return x.Cylinders
+	}
+	return 0
+}
+
+func (x *BuildDataResponse) GetDoors() int32 {
+	if x != nil {
+		return x.Doors
+	}
+	return 0
+}
+
+func (x *BuildDataResponse) GetElectrificationLevel() string {
+	if x != nil {
+		return x.ElectrificationLevel
+	}
+	return ""
+}
+
+func (x *BuildDataResponse) GetDecodeQuality() *DecodeQuality {
+	if x != nil {
+		return x.DecodeQuality
+	}
+	return nil
+}
+
+type DecodeQuality struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Codes         []int32                `protobuf:"varint,2,rep,packed,name=codes,proto3" json:"codes,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeQuality) Reset() {
+	*x = DecodeQuality{}
+	mi := &file_inspection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeQuality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeQuality) ProtoMessage() {}
+
+func (x *DecodeQuality) ProtoReflect() protoreflect.Message {
+	mi := &file_inspection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeQuality.ProtoReflect.Descriptor instead.
+func (*DecodeQuality) Descriptor() ([]byte, []int) {
+	return file_inspection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DecodeQuality) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DecodeQuality) GetCodes() []int32 {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *DecodeQuality) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type InspectVehicleRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Vin             string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
@@ -156,7 +296,7 @@ type InspectVehicleRequest struct {
 
 func (x *InspectVehicleRequest) Reset() {
 	*x = InspectVehicleRequest{}
-	mi := &file_inspection_proto_msgTypes[2]
+	mi := &file_inspection_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -168,7 +308,7 @@ func (x *InspectVehicleRequest) String() string {
 func (*InspectVehicleRequest) ProtoMessage() {}
 
 func (x *InspectVehicleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inspection_proto_msgTypes[2]
+	mi := &file_inspection_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -181,7 +321,7 @@ func (x *InspectVehicleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectVehicleRequest.ProtoReflect.Descriptor instead.
 func (*InspectVehicleRequest) Descriptor() ([]byte, []int) {
-	return file_inspection_proto_rawDescGZIP(), []int{2}
+	return file_inspection_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *InspectVehicleRequest) GetVin() string {
@@ -243,7 +383,7 @@ type InspectVehicleResponse struct {
 
 func (x *InspectVehicleResponse) Reset() {
 	*x = InspectVehicleResponse{}
-	mi := &file_inspection_proto_msgTypes[3]
+	mi := &file_inspection_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -255,7 +395,7 @@ func (x *InspectVehicleResponse) String() string {
 func (*InspectVehicleResponse) ProtoMessage() {}
 
 func (x *InspectVehicleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inspection_proto_msgTypes[3]
+	mi := &file_inspection_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +408,7 @@ func (x *InspectVehicleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectVehicleResponse.ProtoReflect.Descriptor instead.
 func (*InspectVehicleResponse) Descriptor() ([]byte, []int) {
-	return file_inspection_proto_rawDescGZIP(), []int{3}
+	return file_inspection_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InspectVehicleResponse) GetVin() string {
@@ -292,13 +432,30 @@ const file_inspection_proto_rawDesc = "" +
 	"\x10inspection.proto\x12\n" +
 	"inspection\"'\n" +
 	"\x13GetBuildDataRequest\x12\x10\n" +
-	"\x03vin\x18\x01 \x01(\tR\x03vin\"\x8b\x01\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\"\xdf\x03\n" +
 	"\x11BuildDataResponse\x12\x10\n" +
 	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x14\n" +
 	"\x05brand\x18\x02 \x01(\tR\x05brand\x12\x16\n" +
 	"\x06engine\x18\x03 \x01(\tR\x06engine\x12\"\n" +
 	"\ftransmission\x18\x04 \x01(\tR\ftransmission\x12\x12\n" +
-	"\x04msrp\x18\x05 \x01(\x04R\x04msrp\"\xfb\x01\n" +
+	"\x04msrp\x18\x05 \x01(\x04R\x04msrp\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12\x12\n" +
+	"\x04trim\x18\a \x01(\tR\x04trim\x12\x1d\n" +
+	"\n" +
+	"body_class\x18\b \x01(\tR\tbodyClass\x12\x1d\n" +
+	"\n" +
+	"drive_type\x18\t \x01(\tR\tdriveType\x12\x1b\n" +
+	"\tfuel_type\x18\n" +
+	" \x01(\tR\bfuelType\x12\"\n" +
+	"\fdisplacement\x18\v \x01(\x01R\fdisplacement\x12\x1c\n" +
+	"\tcylinders\x18\f \x01(\x05R\tcylinders\x12\x14\n" +
+	"\x05doors\x18\r \x01(\x05R\x05doors\x123\n" +
+	"\x15electrification_level\x18\x0e \x01(\tR\x14electrificationLevel\x12@\n" +
+	"\x0edecode_quality\x18\x0f \x01(\v2\x19.inspection.DecodeQualityR\rdecodeQuality\"W\n" +
+	"\rDecodeQuality\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05codes\x18\x02 \x03(\x05R\x05codes\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xfb\x01\n" +
 	"\x15InspectVehicleRequest\x12\x10\n" +
 	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x12\n" +
 	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x1a\n" +
@@ -326,23 +483,25 @@ func file_inspection_proto_rawDescGZIP() []byte {
 	return file_inspection_proto_rawDescData
 }
 
-var file_inspection_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_inspection_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_inspection_proto_goTypes = []any{
 	(*GetBuildDataRequest)(nil),    // 0: inspection.GetBuildDataRequest
 	(*BuildDataResponse)(nil),      // 1: inspection.BuildDataResponse
-	(*InspectVehicleRequest)(nil),  // 2: inspection.InspectVehicleRequest
-	(*InspectVehicleResponse)(nil), // 3: inspection.InspectVehicleResponse
+	(*DecodeQuality)(nil),          // 2: inspection.DecodeQuality
+	(*InspectVehicleRequest)(nil),  // 3: inspection.InspectVehicleRequest
+	(*InspectVehicleResponse)(nil), // 4: inspection.InspectVehicleResponse
 }
 var file_inspection_proto_depIdxs = []int32{
-	0, // 0: inspection.InspectionService.GetBuildData:input_type -> inspection.GetBuildDataRequest
-	2, // 1: inspection.InspectionService.InspectVehicle:input_type -> inspection.InspectVehicleRequest
-	1, // 2: inspection.InspectionService.GetBuildData:output_type -> inspection.BuildDataResponse
-	3, // 3: inspection.InspectionService.InspectVehicle:output_type -> inspection.InspectVehicleResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: inspection.BuildDataResponse.decode_quality:type_name -> inspection.DecodeQuality
+	0, // 1: inspection.InspectionService.GetBuildData:input_type -> inspection.GetBuildDataRequest
+	3, // 2: inspection.InspectionService.InspectVehicle:input_type -> inspection.InspectVehicleRequest
+	1, // 3: inspection.InspectionService.GetBuildData:output_type -> inspection.BuildDataResponse
+	4, // 4: inspection.InspectionService.InspectVehicle:output_type -> inspection.InspectVehicleResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_inspection_proto_init() }
@@ -356,7 +515,7 @@ func file_inspection_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inspection_proto_rawDesc), len(file_inspection_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
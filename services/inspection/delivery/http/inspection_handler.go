@@ -30,6 +30,9 @@ func writeError(w http.ResponseWriter, err error) {
 	case errors.Is(err, domain.ErrNotFound):
 		status = http.StatusNotFound
 		msg = err.Error()
+	case errors.Is(err, domain.ErrUnavailable):
+		status = http.StatusServiceUnavailable
+		msg = domain.ErrUnavailable.Error()
 	}
 
 	// Write response
@@ -39,8 +39,8 @@ func TestHandler_InspectVehicle(t *testing.T) {
 	// Valid case
 	t.Run("valid request", func(t *testing.T) {
 		v := domain.Vehicle{
-			VIN:      "1HGCM82633A123456",
-			Year:     2020,
+			VIN:      "1HGCM8263NA123456",
+			Year:     2022,
 			Odometer: 15000,
 		}
 		body, _ := json.Marshal(v)
@@ -72,7 +72,7 @@ func TestHandler_GetBuildData(t *testing.T) {
 
 	// Valid case
 	t.Run("valid VIN", func(t *testing.T) {
-		vin := "1HGCM82633A004352"
+		vin := "1HGCM826XNA004352"
 		req := httptest.NewRequest(http.MethodGet, "/inspections/get-build-data/"+vin, nil)
 		rec := httptest.NewRecorder()
 
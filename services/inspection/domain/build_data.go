@@ -0,0 +1,54 @@
+package domain
+
+import "slices"
+
+// Decode quality statuses of the build data
+const (
+	DecodeClean   = "clean"   // decoded without any error
+	DecodePartial = "partial" // decoded with errors, some attributes may be missing or wrong
+	DecodeFailed  = "failed"  // nothing could be decoded
+	DecodeOffline = "offline" // decoded from the VIN itself without the provider
+)
+
+// BuildData holds the factory build data of a vehicle decoded from its VIN
+type BuildData struct {
+	Brand                string         `json:"brand"`
+	Model                string         `json:"model"`
+	Trim                 string         `json:"trim"`
+	BodyClass            string         `json:"body_class"`
+	DriveType            string         `json:"drive_type"`
+	FuelType             string         `json:"fuel_type"`
+	Engine               string         `json:"engine"`
+	Displacement         float64        `json:"displacement"`
+	Cylinders            int            `json:"cylinders"`
+	Transmission         string         `json:"transmission"`
+	Doors                int            `json:"doors"`
+	ElectrificationLevel string         `json:"electrification_level"`
+	DecodeQuality        *DecodeQuality `json:"decode_quality,omitempty"`
+}
+
+// Decoded reports whether any attribute of the build data is known
+func (b *BuildData) Decoded() bool {
+	return b.Brand != "" || b.Model != "" || b.Trim != "" || b.BodyClass != "" || b.DriveType != "" || b.FuelType != "" ||
+		b.Engine != "" || b.Displacement != 0 || b.Cylinders != 0 || b.Transmission != "" || b.Doors != 0 || b.ElectrificationLevel != ""
+}
+
+// DecodeQuality tells how reliable the build data is, with the error codes and message of the provider
+type DecodeQuality struct {
+	Status  string `json:"status"`
+	Codes   []int  `json:"codes,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// NewDecodeQuality creates the decode quality of the build data from the error codes of the provider,
+// no codes other than 0 is a clean decode
+func NewDecodeQuality(b *BuildData, codes []int, message string) *DecodeQuality {
+	q := &DecodeQuality{Status: DecodeClean, Codes: codes, Message: message}
+	switch {
+	case !b.Decoded():
+		q.Status = DecodeFailed
+	case slices.ContainsFunc(codes, func(c int) bool { return c != 0 }):
+		q.Status = DecodePartial
+	}
+	return q
+}
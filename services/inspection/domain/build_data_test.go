@@ -0,0 +1,34 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/inspection/domain"
+)
+
+// TestNewDecodeQuality tests the NewDecodeQuality function
+func TestNewDecodeQuality(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     domain.BuildData
+		codes    []int
+		expected string
+	}{
+		{name: "clean", data: domain.BuildData{Brand: "TESLA"}, codes: []int{0}, expected: domain.DecodeClean},
+		{name: "no codes", data: domain.BuildData{Brand: "TESLA"}, expected: domain.DecodeClean},
+		{name: "check digit error", data: domain.BuildData{Brand: "TESLA", Model: "Model S"}, codes: []int{1}, expected: domain.DecodePartial},
+		{name: "clean and warning", data: domain.BuildData{Doors: 4}, codes: []int{0, 14}, expected: domain.DecodePartial},
+		{name: "nothing decoded", codes: []int{8}, expected: domain.DecodeFailed},
+		{name: "nothing decoded without codes", expected: domain.DecodeFailed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q := domain.NewDecodeQuality(&test.data, test.codes, "message")
+			assert.Equal(t, test.expected, q.Status)
+			assert.Equal(t, test.codes, q.Codes)
+			assert.Equal(t, "message", q.Message)
+		})
+	}
+}
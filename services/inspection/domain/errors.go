@@ -3,6 +3,7 @@ package domain
 import "errors"
 
 var (
-	ErrNotFound   = errors.New("vehicle not found")
-	ErrValidation = errors.New("validation failed")
+	ErrNotFound    = errors.New("vehicle not found")
+	ErrValidation  = errors.New("validation failed")
+	ErrUnavailable = errors.New("build data service unavailable")
 )
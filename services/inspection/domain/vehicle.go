@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/alechekz/online-car-auction/pkg/vin"
 )
 
 // Vehicle represents a vehicle entity in the system
@@ -11,10 +13,8 @@ type Vehicle struct {
 	VIN string `json:"vin"`
 
 	//build data
-	Brand        string `json:"brand"`
-	Engine       string `json:"engine"`
-	Transmission string `json:"transmission"`
-	MSRP         uint64 `json:"msrp"`
+	BuildData
+	MSRP uint64 `json:"msrp"`
 
 	//inspection data
 	Year            int  `json:"year"`
@@ -26,14 +26,16 @@ type Vehicle struct {
 	SuspensionFail  bool `json:"suspension_fail"`
 }
 
-// Validate checks if the VIN is valid
+// ValidateVIN checks if the VIN is valid, the VIN is normalized to upper case first
 func (v *Vehicle) ValidateVIN() error {
+	v.VIN = vin.Normalize(v.VIN)
 	return validation.ValidateStruct(
 		v,
 		validation.Field(
 			&v.VIN,
 			validation.Required,
 			validation.Length(17, 17),
+			vin.Rule,
 		),
 	)
 }
@@ -50,6 +52,7 @@ func (v *Vehicle) Validate() error {
 			validation.Required,
 			validation.Min(1900),
 			validation.Max(time.Now().Year()),
+			vin.YearRule(v.VIN),
 		),
 		validation.Field(
 			&v.Odometer,
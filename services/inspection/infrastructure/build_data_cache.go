@@ -0,0 +1,196 @@
+package infrastructure
+
+import (
+	"expvar"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/alechekz/online-car-auction/services/inspection/domain"
+	"github.com/alechekz/online-car-auction/services/inspection/usecase"
+)
+
+// BuildDataFormat is the format version of the cached build data, entries of older formats are misses
+// and are fetched again, the entries before the decode quality have no version
+const BuildDataFormat = 2
+
+// BuildDataEntry is the cached build data of a VIN
+type BuildDataEntry struct {
+	Format int    `json:"format"`
+	VIN    string `json:"vin"`
+	domain.BuildData
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// Negative reports whether the provider knew nothing about the VIN
+func (e *BuildDataEntry) Negative() bool {
+	return !e.Decoded()
+}
+
+// apply copies the build data to the vehicle
+func (e *BuildDataEntry) apply(v *domain.Vehicle) {
+	v.BuildData = e.BuildData
+}
+
+// BuildDataStore is the durable tier of the build data cache
+type BuildDataStore interface {
+	Get(vin string) (*BuildDataEntry, bool, error)
+	Put(e *BuildDataEntry) error
+}
+
+// BuildDataCacheConfig holds the settings of the build data cache
+type BuildDataCacheConfig struct {
+	Size        int           // number of entries kept in memory
+	NegativeTTL time.Duration // how long an empty decode is trusted
+	Store       BuildDataStore
+	Now         func() time.Time
+}
+
+// BuildDataCacheStats holds the counters of the build data cache
+type BuildDataCacheStats struct {
+	Entries      int    `json:"entries"`
+	MemoryHits   uint64 `json:"memory_hits"`
+	StoreHits    uint64 `json:"store_hits"`
+	Misses       uint64 `json:"misses"`
+	NegativeHits uint64 `json:"negative_hits"`
+	Shared       uint64 `json:"shared"`
+	Errors       uint64 `json:"errors"`
+}
+
+// BuildDataCache is a read-through cache in front of a build data provider,
+// positive results never expire while negative results expire after the negative TTL
+type BuildDataCache struct {
+	provider usecase.BuildDataProvider
+	cfg      BuildDataCacheConfig
+	memory   *lruCache[string, *BuildDataEntry]
+	group    singleflight.Group
+
+	memoryHits   atomic.Uint64
+	storeHits    atomic.Uint64
+	misses       atomic.Uint64
+	negativeHits atomic.Uint64
+	shared       atomic.Uint64
+	errors       atomic.Uint64
+}
+
+// currentCache is the build data cache whose stats are published
+var currentCache atomic.Pointer[BuildDataCache]
+
+// init publishes the stats of the latest build data cache as the build_data_cache expvar
+func init() {
+	expvar.Publish("build_data_cache", expvar.Func(func() any {
+		if c := currentCache.Load(); c != nil {
+			return c.Stats()
+		}
+		return nil
+	}))
+}
+
+// NewBuildDataCache creates a new BuildDataCache in front of the provider, a nil store keeps entries in memory only
+func NewBuildDataCache(provider usecase.BuildDataProvider, cfg BuildDataCacheConfig) *BuildDataCache {
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	c := &BuildDataCache{
+		provider: provider,
+		cfg:      cfg,
+		memory:   newLRUCache[string, *BuildDataEntry](cfg.Size),
+	}
+	currentCache.Store(c)
+	return c
+}
+
+// Fetch fills the build data of the vehicle from the cache, asking the provider once per VIN on a miss
+func (c *BuildDataCache) Fetch(v *domain.Vehicle) error {
+	key := strings.ToUpper(v.VIN)
+
+	// Serve from memory
+	if e, ok := c.memory.Get(key); ok && c.fresh(e) {
+		c.memoryHits.Add(1)
+		c.hit(e, v)
+		return nil
+	}
+
+	// Load once for all concurrent callers of the same VIN
+	res, err, shared := c.group.Do(key, func() (any, error) {
+		return c.load(key)
+	})
+	if shared {
+		c.shared.Add(1)
+	}
+	if err != nil {
+		return err
+	}
+	res.(*BuildDataEntry).apply(v)
+	return nil
+}
+
+// load reads the entry from the durable tier, falling back to the provider
+func (c *BuildDataCache) load(key string) (*BuildDataEntry, error) {
+
+	// Read the durable tier, a broken store only costs a provider call
+	if c.cfg.Store != nil {
+		if e, ok, err := c.cfg.Store.Get(key); err == nil && ok && c.fresh(e) {
+			c.storeHits.Add(1)
+			c.memory.Add(key, e)
+			c.hit(e, nil)
+			return e, nil
+		}
+	}
+
+	// Ask the provider, errors are never cached
+	c.misses.Add(1)
+	v := &domain.Vehicle{VIN: key}
+	if err := c.provider.Fetch(v); err != nil {
+		c.errors.Add(1)
+		return nil, err
+	}
+	e := &BuildDataEntry{
+		Format:    BuildDataFormat,
+		VIN:       key,
+		BuildData: v.BuildData,
+		FetchedAt: c.cfg.Now(),
+	}
+
+	// Keep the entry in both tiers
+	c.memory.Add(key, e)
+	if c.cfg.Store != nil {
+		if err := c.cfg.Store.Put(e); err != nil {
+			c.errors.Add(1)
+		}
+	}
+	return e, nil
+}
+
+// fresh reports whether the entry may still be served, entries of older formats never are
+func (c *BuildDataCache) fresh(e *BuildDataEntry) bool {
+	if e.Format != BuildDataFormat {
+		return false
+	}
+	return !e.Negative() || c.cfg.Now().Sub(e.FetchedAt) < c.cfg.NegativeTTL
+}
+
+// hit counts a served entry and copies it to the vehicle if any
+func (c *BuildDataCache) hit(e *BuildDataEntry, v *domain.Vehicle) {
+	if e.Negative() {
+		c.negativeHits.Add(1)
+	}
+	if v != nil {
+		e.apply(v)
+	}
+}
+
+// Stats returns the counters of the cache
+func (c *BuildDataCache) Stats() BuildDataCacheStats {
+	return BuildDataCacheStats{
+		Entries:      c.memory.Len(),
+		MemoryHits:   c.memoryHits.Load(),
+		StoreHits:    c.storeHits.Load(),
+		Misses:       c.misses.Load(),
+		NegativeHits: c.negativeHits.Load(),
+		Shared:       c.shared.Load(),
+		Errors:       c.errors.Load(),
+	}
+}
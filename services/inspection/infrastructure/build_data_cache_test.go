@@ -0,0 +1,265 @@
+package infrastructure_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alechekz/online-car-auction/services/inspection/domain"
+	"github.com/alechekz/online-car-auction/services/inspection/infrastructure"
+)
+
+// fakeBuildDataProvider is a build data provider counting its calls
+type fakeBuildDataProvider struct {
+	calls   atomic.Int64
+	brand   string
+	err     error
+	release chan struct{} // blocks the calls until closed when set
+}
+
+// Fetch sets the brand of the vehicle or returns the configured error
+func (p *fakeBuildDataProvider) Fetch(v *domain.Vehicle) error {
+	p.calls.Add(1)
+	if p.release != nil {
+		<-p.release
+	}
+	if p.err != nil {
+		return p.err
+	}
+	v.Brand = p.brand
+	if p.brand != "" {
+		v.Model = "Model S"
+		v.Doors = 4
+		v.DecodeQuality = domain.NewDecodeQuality(&v.BuildData, []int{0}, "VIN decoded clean")
+	}
+	return nil
+}
+
+// testClock is a settable clock for the cache
+type testClock struct{ now time.Time }
+
+// Now returns the current time of the clock
+func (c *testClock) Now() time.Time { return c.now }
+
+// fetch fills the build data of a new vehicle with the VIN through the cache
+func fetch(c *infrastructure.BuildDataCache, vin string) (*domain.Vehicle, error) {
+	v := &domain.Vehicle{VIN: vin}
+	if err := c.Fetch(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TestBuildDataCache_Cached tests that repeated lookups are served from memory
+func TestBuildDataCache_Cached(t *testing.T) {
+	p := &fakeBuildDataProvider{brand: "Tesla"}
+	cache := infrastructure.NewBuildDataCache(p, infrastructure.BuildDataCacheConfig{Size: 10, NegativeTTL: time.Hour})
+
+	for range 3 {
+		v, err := fetch(cache, "5YJSA1E20NF168123")
+		require.NoError(t, err)
+		assert.Equal(t, "Tesla", v.Brand)
+	}
+
+	assert.EqualValues(t, 1, p.calls.Load())
+	stats := cache.Stats()
+	assert.EqualValues(t, 1, stats.Misses)
+	assert.EqualValues(t, 2, stats.MemoryHits)
+	assert.Equal(t, 1, stats.Entries)
+}
+
+// TestBuildDataCache_Singleflight tests that concurrent lookups of a VIN share one provider call
+func TestBuildDataCache_Singleflight(t *testing.T) {
+	p := &fakeBuildDataProvider{brand: "Tesla", release: make(chan struct{})}
+	cache := infrastructure.NewBuildDataCache(p, infrastructure.BuildDataCacheConfig{Size: 10, NegativeTTL: time.Hour})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := fetch(cache, "5YJSA1E20NF168123")
+			assert.NoError(t, err)
+			assert.Equal(t, "Tesla", v.Brand)
+		}()
+	}
+	assert.Eventually(t, func() bool { return p.calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	close(p.release)
+	wg.Wait()
+
+	assert.EqualValues(t, 1, p.calls.Load())
+	assert.EqualValues(t, 1, cache.Stats().Misses)
+}
+
+// TestBuildDataCache_Negative tests that empty decodes are cached until the negative TTL passes
+func TestBuildDataCache_Negative(t *testing.T) {
+	clock := &testClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	p := &fakeBuildDataProvider{}
+	cache := infrastructure.NewBuildDataCache(p, infrastructure.BuildDataCacheConfig{Size: 10, NegativeTTL: time.Hour, Now: clock.Now})
+
+	_, err := fetch(cache, "5YJSA1E20NF168123")
+	require.NoError(t, err)
+	clock.now = clock.now.Add(30 * time.Minute)
+	_, err = fetch(cache, "5YJSA1E20NF168123")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, p.calls.Load())
+	assert.EqualValues(t, 1, cache.Stats().NegativeHits)
+
+	// The expired entry is replaced in place
+	p.brand = "Tesla"
+	clock.now = clock.now.Add(time.Hour)
+	v, err := fetch(cache, "5YJSA1E20NF168123")
+	require.NoError(t, err)
+	assert.Equal(t, "Tesla", v.Brand)
+	assert.EqualValues(t, 2, p.calls.Load())
+	assert.Equal(t, 1, cache.Stats().Entries)
+}
+
+// TestBuildDataCache_ErrorNotCached tests that provider errors are not cached
+func TestBuildDataCache_ErrorNotCached(t *testing.T) {
+	p := &fakeBuildDataProvider{brand: "Tesla", err: errors.New("vpic down")}
+	cache := infrastructure.NewBuildDataCache(p, infrastructure.BuildDataCacheConfig{Size: 10, NegativeTTL: time.Hour})
+
+	_, err := fetch(cache, "5YJSA1E20NF168123")
+	assert.Error(t, err)
+	assert.Zero(t, cache.Stats().Entries)
+
+	p.err = nil
+	v, err := fetch(cache, "5YJSA1E20NF168123")
+	require.NoError(t, err)
+	assert.Equal(t, "Tesla", v.Brand)
+	assert.EqualValues(t, 2, p.calls.Load())
+	assert.EqualValues(t, 1, cache.Stats().Errors)
+}
+
+// TestBuildDataCache_Eviction tests that the memory tier evicts the least recently used VIN
+func TestBuildDataCache_Eviction(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int
+		vins    []string
+		calls   int64
+		entries int
+	}{
+		{
+			name:    "recently used vin is kept",
+			size:    2,
+			vins:    []string{"5YJSA1E28NF168001", "5YJSA1E2XNF168002", "5YJSA1E28NF168001", "5YJSA1E21NF168003", "5YJSA1E28NF168001", "5YJSA1E2XNF168002"},
+			calls:   4,
+			entries: 2,
+		},
+		{
+			name:    "oldest vin is evicted",
+			size:    2,
+			vins:    []string{"5YJSA1E28NF168001", "5YJSA1E2XNF168002", "5YJSA1E21NF168003", "5YJSA1E28NF168001"},
+			calls:   4,
+			entries: 2,
+		},
+		{
+			name:    "lookups within the size are hits",
+			size:    3,
+			vins:    []string{"5YJSA1E28NF168001", "5YJSA1E2XNF168002", "5YJSA1E21NF168003", "5YJSA1E28NF168001", "5YJSA1E2XNF168002", "5YJSA1E21NF168003"},
+			calls:   3,
+			entries: 3,
+		},
+		{
+			name:    "zero size keeps one vin",
+			size:    0,
+			vins:    []string{"5YJSA1E28NF168001", "5YJSA1E28NF168001", "5YJSA1E2XNF168002", "5YJSA1E28NF168001"},
+			calls:   3,
+			entries: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &fakeBuildDataProvider{brand: "Tesla"}
+			cache := infrastructure.NewBuildDataCache(p, infrastructure.BuildDataCacheConfig{Size: test.size, NegativeTTL: time.Hour})
+			for _, vin := range test.vins {
+				_, err := fetch(cache, vin)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.calls, p.calls.Load())
+			assert.Equal(t, test.entries, cache.Stats().Entries)
+		})
+	}
+}
+
+// TestBuildDataCache_FileStore tests that the durable tier survives a restart
+func TestBuildDataCache_FileStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := infrastructure.NewFileBuildDataStore(dir)
+	require.NoError(t, err)
+	p := &fakeBuildDataProvider{brand: "Tesla"}
+	cache := infrastructure.NewBuildDataCache(p, infrastructure.BuildDataCacheConfig{Size: 10, NegativeTTL: time.Hour, Store: store})
+
+	_, err = fetch(cache, "5yjsa1e20nf168123")
+	require.NoError(t, err)
+
+	// A new cache over the same directory acts as a restarted service
+	store, err = infrastructure.NewFileBuildDataStore(dir)
+	require.NoError(t, err)
+	cache = infrastructure.NewBuildDataCache(p, infrastructure.BuildDataCacheConfig{Size: 10, NegativeTTL: time.Hour, Store: store})
+	v, err := fetch(cache, "5YJSA1E20NF168123")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Tesla", v.Brand)
+	assert.Equal(t, "Model S", v.Model)
+	assert.Equal(t, 4, v.Doors)
+	assert.Equal(t, &domain.DecodeQuality{Status: domain.DecodeClean, Codes: []int{0}, Message: "VIN decoded clean"}, v.DecodeQuality)
+	assert.EqualValues(t, 1, p.calls.Load())
+	assert.EqualValues(t, 1, cache.Stats().StoreHits)
+}
+
+// TestBuildDataCache_CorruptStore tests that a corrupt stored entry costs a provider call and is replaced
+func TestBuildDataCache_CorruptStore(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "5YJSA1E20NF168123.json"), []byte(`{"vin":`), 0o600))
+	store, err := infrastructure.NewFileBuildDataStore(dir)
+	require.NoError(t, err)
+	p := &fakeBuildDataProvider{brand: "Tesla"}
+	cache := infrastructure.NewBuildDataCache(p, infrastructure.BuildDataCacheConfig{Size: 10, NegativeTTL: time.Hour, Store: store})
+
+	v, err := fetch(cache, "5YJSA1E20NF168123")
+	require.NoError(t, err)
+	assert.Equal(t, "Tesla", v.Brand)
+	assert.EqualValues(t, 1, p.calls.Load())
+	assert.Zero(t, cache.Stats().StoreHits)
+
+	e, ok, err := store.Get("5YJSA1E20NF168123")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "Tesla", e.Brand)
+}
+
+// TestBuildDataCache_OldFormat tests that entries stored before the format version are misses
+// and are replaced by fresh ones
+func TestBuildDataCache_OldFormat(t *testing.T) {
+	dir := t.TempDir()
+	old := `{"vin":"5YJSA1E20NF168123","brand":"Tesla","engine":"","transmission":"","fetched_at":"2025-01-01T00:00:00Z"}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "5YJSA1E20NF168123.json"), []byte(old), 0o600))
+	store, err := infrastructure.NewFileBuildDataStore(dir)
+	require.NoError(t, err)
+	p := &fakeBuildDataProvider{brand: "Tesla"}
+	cache := infrastructure.NewBuildDataCache(p, infrastructure.BuildDataCacheConfig{Size: 10, NegativeTTL: time.Hour, Store: store})
+
+	v, err := fetch(cache, "5YJSA1E20NF168123")
+	require.NoError(t, err)
+	assert.Equal(t, "Model S", v.Model)
+	assert.Equal(t, domain.DecodeClean, v.DecodeQuality.Status)
+	assert.EqualValues(t, 1, p.calls.Load())
+	assert.Zero(t, cache.Stats().StoreHits)
+
+	e, ok, err := store.Get("5YJSA1E20NF168123")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, infrastructure.BuildDataFormat, e.Format)
+}
@@ -0,0 +1,79 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// storableVIN matches the VINs safe to use as file names
+var storableVIN = regexp.MustCompile(`^[A-Z0-9]{17}$`)
+
+// FileBuildDataStore is an embedded durable tier of the build data cache keeping one JSON file per VIN
+type FileBuildDataStore struct {
+	dir string
+}
+
+// NewFileBuildDataStore creates a new FileBuildDataStore in the directory, creating the directory if needed
+func NewFileBuildDataStore(dir string) (*FileBuildDataStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &FileBuildDataStore{dir: dir}, nil
+}
+
+// path returns the file of the VIN, VINs unsafe as file names are not stored
+func (s *FileBuildDataStore) path(vin string) (string, bool) {
+	if !storableVIN.MatchString(vin) {
+		return "", false
+	}
+	return filepath.Join(s.dir, vin+".json"), true
+}
+
+// Get reads the stored build data of the VIN, it reports false if there is none
+func (s *FileBuildDataStore) Get(vin string) (*BuildDataEntry, bool, error) {
+	path, ok := s.path(vin)
+	if !ok {
+		return nil, false, nil
+	}
+	b, err := os.ReadFile(path) // nolint:gosec
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	var e BuildDataEntry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, false, err
+	}
+	return &e, true, nil
+}
+
+// Put stores the build data of the VIN, the file is replaced atomically
+func (s *FileBuildDataStore) Put(e *BuildDataEntry) error {
+	path, ok := s.path(e.VIN)
+	if !ok {
+		return nil
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, e.VIN+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // nolint:errcheck
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
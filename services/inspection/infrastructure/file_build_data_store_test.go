@@ -0,0 +1,81 @@
+package infrastructure_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alechekz/online-car-auction/services/inspection/domain"
+	"github.com/alechekz/online-car-auction/services/inspection/infrastructure"
+)
+
+// newTestEntry is a test build data entry of the VIN
+func newTestEntry(vin string) *infrastructure.BuildDataEntry {
+	e := &infrastructure.BuildDataEntry{
+		Format:    infrastructure.BuildDataFormat,
+		VIN:       vin,
+		FetchedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	e.Brand = "Tesla"
+	e.Model = "Model S"
+	e.Doors = 4
+	e.DecodeQuality = domain.NewDecodeQuality(&e.BuildData, []int{0}, "VIN decoded clean")
+	return e
+}
+
+// TestFileBuildDataStore tests the round trip of entries through the file store
+func TestFileBuildDataStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := infrastructure.NewFileBuildDataStore(filepath.Join(dir, "cache"))
+	require.NoError(t, err)
+
+	t.Run("round trip", func(t *testing.T) {
+		e := newTestEntry("5YJSA1E20NF168123")
+		require.NoError(t, store.Put(e))
+		got, ok, err := store.Get("5YJSA1E20NF168123")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, e, got)
+	})
+
+	t.Run("replaced entry", func(t *testing.T) {
+		e := newTestEntry("5YJSA1E20NF168123")
+		e.Brand = "Kia"
+		require.NoError(t, store.Put(e))
+		got, ok, err := store.Get("5YJSA1E20NF168123")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "Kia", got.Brand)
+
+		// No temporary files are left behind
+		tmp, err := filepath.Glob(filepath.Join(dir, "cache", "*.tmp"))
+		require.NoError(t, err)
+		assert.Empty(t, tmp)
+	})
+
+	t.Run("missing entry", func(t *testing.T) {
+		_, ok, err := store.Get("5YJSA1E21NF168124")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("unstorable vin", func(t *testing.T) {
+		require.NoError(t, store.Put(newTestEntry("../5YJSA1E20NF16")))
+		_, ok, err := store.Get("../5YJSA1E20NF16")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		_, err = os.Stat(filepath.Join(dir, "5YJSA1E20NF16.json"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("corrupt entry", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cache", "5YJSA1E22NF168125.json"), []byte("not json"), 0o600))
+		_, ok, err := store.Get("5YJSA1E22NF168125")
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
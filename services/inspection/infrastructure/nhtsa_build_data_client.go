@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/alechekz/online-car-auction/services/inspection/domain"
 )
@@ -31,29 +33,73 @@ type vpicResponse struct {
 // Fetch fetches the build data for a vehicle by its VIN
 func (c *NHTSABuildDataClient) Fetch(v *domain.Vehicle) error {
 
-	// Make the HTTP request to NHTSA API
+	// Make the HTTP request to NHTSA API, failures to get an answer mark the API as unavailable
 	resp, err := http.Get(
 		fmt.Sprintf("%s/DecodeVin/%s?format=json", c.baseURL, v.VIN),
 	)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %v", domain.ErrUnavailable, err)
 	}
 	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("%w: status %d", domain.ErrUnavailable, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("nhtsa: unexpected status %d", resp.StatusCode)
+	}
 
 	// Decode the response
 	var data vpicResponse
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return err
+		return fmt.Errorf("%w: %v", domain.ErrUnavailable, err)
 	}
+	var codes []int
+	var message string
 	for _, r := range data.Results {
 		switch r.Variable {
 		case "Make":
 			v.Brand = r.Value
+		case "Model":
+			v.Model = r.Value
+		case "Trim":
+			v.Trim = r.Value
+		case "Body Class":
+			v.BodyClass = r.Value
+		case "Drive Type":
+			v.DriveType = r.Value
+		case "Fuel Type - Primary":
+			v.FuelType = r.Value
 		case "Engine Model":
 			v.Engine = r.Value
+		case "Displacement (L)":
+			v.Displacement, _ = strconv.ParseFloat(r.Value, 64)
+		case "Engine Number of Cylinders":
+			v.Cylinders, _ = strconv.Atoi(r.Value)
 		case "Transmission Style":
 			v.Transmission = r.Value
+		case "Doors":
+			v.Doors, _ = strconv.Atoi(r.Value)
+		case "Electrification Level":
+			v.ElectrificationLevel = r.Value
+		case "Error Code":
+			codes = parseErrorCodes(r.Value)
+		case "Error Text":
+			message = r.Value
 		}
 	}
+
+	// Rate the decode by the NHTSA error codes
+	v.DecodeQuality = domain.NewDecodeQuality(&v.BuildData, codes, message)
 	return nil
 }
+
+// parseErrorCodes parses the comma separated NHTSA error codes, unknown codes are skipped
+func parseErrorCodes(value string) []int {
+	var codes []int
+	for _, s := range strings.Split(value, ",") {
+		if c, err := strconv.Atoi(strings.TrimSpace(s)); err == nil {
+			codes = append(codes, c)
+		}
+	}
+	return codes
+}
@@ -0,0 +1,50 @@
+package infrastructure
+
+import (
+	"errors"
+
+	"github.com/alechekz/online-car-auction/pkg/vin"
+	"github.com/alechekz/online-car-auction/services/inspection/domain"
+	"github.com/alechekz/online-car-auction/services/inspection/usecase"
+)
+
+// OfflineBuildDataClient decodes the build data from the VIN itself without calling any API,
+// only the brand is known offline
+type OfflineBuildDataClient struct{}
+
+// NewOfflineBuildDataClient creates a new OfflineBuildDataClient instance
+func NewOfflineBuildDataClient() *OfflineBuildDataClient {
+	return &OfflineBuildDataClient{}
+}
+
+// Fetch sets the brand of the vehicle from the manufacturer of its VIN and marks the build data as decoded offline
+func (c *OfflineBuildDataClient) Fetch(v *domain.Vehicle) error {
+	info, err := vin.Decode(v.VIN)
+	if err != nil {
+		return domain.ErrValidation
+	}
+	v.BuildData = domain.BuildData{Brand: info.Manufacturer}
+	v.DecodeQuality = &domain.DecodeQuality{Status: domain.DecodeOffline}
+	return nil
+}
+
+// FallbackBuildDataProvider asks the fallback provider when the primary provider is unavailable
+type FallbackBuildDataProvider struct {
+	primary  usecase.BuildDataProvider
+	fallback usecase.BuildDataProvider
+}
+
+// NewFallbackBuildDataProvider creates a new FallbackBuildDataProvider instance
+func NewFallbackBuildDataProvider(primary, fallback usecase.BuildDataProvider) *FallbackBuildDataProvider {
+	return &FallbackBuildDataProvider{primary: primary, fallback: fallback}
+}
+
+// Fetch fetches the build data from the primary provider, falling back while it is unavailable,
+// any other error of the primary provider is returned
+func (p *FallbackBuildDataProvider) Fetch(v *domain.Vehicle) error {
+	err := p.primary.Fetch(v)
+	if !errors.Is(err, domain.ErrUnavailable) {
+		return err
+	}
+	return p.fallback.Fetch(v)
+}
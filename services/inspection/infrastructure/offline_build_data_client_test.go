@@ -0,0 +1,29 @@
+package infrastructure_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alechekz/online-car-auction/services/inspection/domain"
+	"github.com/alechekz/online-car-auction/services/inspection/infrastructure"
+)
+
+// TestFallbackBuildDataProvider tests that the brand is decoded offline while the provider is unavailable
+// and that other provider errors are returned
+func TestFallbackBuildDataProvider(t *testing.T) {
+	p := &fakeBuildDataProvider{err: fmt.Errorf("%w: vpic down", domain.ErrUnavailable)}
+	provider := infrastructure.NewFallbackBuildDataProvider(p, infrastructure.NewOfflineBuildDataClient())
+
+	v := &domain.Vehicle{VIN: "5YJSA1E20NF168123"}
+	require.NoError(t, provider.Fetch(v))
+	assert.Equal(t, "Tesla", v.Brand)
+	assert.Equal(t, domain.DecodeOffline, v.DecodeQuality.Status)
+
+	p.err = errors.New("unexpected status 400")
+	err := provider.Fetch(&domain.Vehicle{VIN: "5YJSA1E20NF168123"})
+	assert.ErrorIs(t, err, p.err, "not an unavailability")
+}
@@ -1,19 +1,28 @@
 package server
 
-import "os"
+import (
+	"os"
+	"strconv"
+	"time"
+)
 
 // config holds the configuration for the Inspection Service server
 type config struct {
-	HttpAddress string
-	GrpcAddress string
-	DatabaseURL string
+	HttpAddress      string
+	GrpcAddress      string
+	DatabaseURL      string
+	CacheSize        int
+	CacheDir         string
+	CacheNegativeTTL time.Duration
 }
 
 // NewConfig creates a new server configuration with default values
 func NewConfig() *config {
 	cfg := &config{
-		HttpAddress: ":6062",
-		GrpcAddress: ":6063",
+		HttpAddress:      ":6062",
+		GrpcAddress:      ":6063",
+		CacheSize:        10000,
+		CacheNegativeTTL: time.Hour,
 	}
 	if os.Getenv("INSPECTION_HTTP") != "" {
 		cfg.HttpAddress = os.Getenv("INSPECTION_HTTP")
@@ -21,5 +30,14 @@ func NewConfig() *config {
 	if os.Getenv("INSPECTION_GRPC") != "" {
 		cfg.GrpcAddress = os.Getenv("INSPECTION_GRPC")
 	}
+	if n, err := strconv.Atoi(os.Getenv("INSPECTION_CACHE_SIZE")); err == nil && n > 0 {
+		cfg.CacheSize = n
+	}
+	if os.Getenv("INSPECTION_CACHE_DIR") != "" {
+		cfg.CacheDir = os.Getenv("INSPECTION_CACHE_DIR")
+	}
+	if d, err := time.ParseDuration(os.Getenv("INSPECTION_CACHE_NEGATIVE_TTL")); err == nil && d > 0 {
+		cfg.CacheNegativeTTL = d
+	}
 	return cfg
 }
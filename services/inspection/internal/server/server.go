@@ -1,6 +1,7 @@
 package server
 
 import (
+	"expvar"
 	"log/slog"
 	"net"
 	"net/http"
@@ -28,13 +29,26 @@ type Server struct {
 // NewServer creates and configures a new Server instance
 func NewServer(cfg *config) (*Server, error) {
 	// dependencies
-	provider := infrastructure.NewNHTSABuildDataClient()
+	cacheCfg := infrastructure.BuildDataCacheConfig{Size: cfg.CacheSize, NegativeTTL: cfg.CacheNegativeTTL}
+	if cfg.CacheDir != "" {
+		store, err := infrastructure.NewFileBuildDataStore(cfg.CacheDir)
+		if err != nil {
+			return nil, err
+		}
+		cacheCfg.Store = store
+	}
+	provider := infrastructure.NewFallbackBuildDataProvider(
+		infrastructure.NewBuildDataCache(infrastructure.NewNHTSABuildDataClient(), cacheCfg),
+		infrastructure.NewOfflineBuildDataClient(),
+	)
 	msrp := infrastructure.NewMockMSRPClient()
 	uc := usecase.NewInspectionUC(provider, msrp)
 
 	// HTTP handler
 	handler := &httpDelivery.InspectionHandler{UC: uc}
-	mux := httpDelivery.NewRouter(handler)
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.Handle("/", httpDelivery.NewRouter(handler))
 
 	// gRPC handler
 	grpcSrv := grpc.NewServer()
@@ -29,3 +29,19 @@ func TestNewServer_HandlerResponds(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
+
+// TestNewServer_CacheMetrics checks that the build data cache stats are published
+func TestNewServer_CacheMetrics(t *testing.T) {
+	t.Setenv("INSPECTION_GRPC", ":0")
+	t.Setenv("INSPECTION_CACHE_DIR", t.TempDir())
+	srv, err := server.NewServer(server.NewConfig())
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/debug/vars", nil)
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"build_data_cache": {"entries":0`)
+}
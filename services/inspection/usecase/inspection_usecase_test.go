@@ -20,7 +20,7 @@ type test struct {
 // newTestVehicle is a test valid vehicle instance
 func newTestVehicle() *domain.Vehicle {
 	return &domain.Vehicle{
-		VIN:      "1HGCM82633A123456",
+		VIN:      "1HGCM8263NA123456",
 		Year:     2022,
 		Odometer: 15000,
 	}
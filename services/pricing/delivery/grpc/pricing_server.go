@@ -27,7 +27,7 @@ func (s *PricingServer) GetRecommendedPrice(ctx context.Context, req *pb.PriceRe
 		ExteriorColor: req.ExteriorColor,
 		InteriorColor: req.InteriorColor,
 	}
-	err := s.uc.GetRecommendedPrice(v)
+	err := s.uc.GetRecommendedPrice(ctx, v)
 	if err != nil {
 		return nil, err
 	}
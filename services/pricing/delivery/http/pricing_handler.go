@@ -44,7 +44,7 @@ func (h *PricingHandler) GetRecommendedPrice(w http.ResponseWriter, r *http.Requ
 		writeError(w, domain.ErrValidation)
 		return
 	}
-	err := h.UC.GetRecommendedPrice(&v)
+	err := h.UC.GetRecommendedPrice(r.Context(), &v)
 	if err != nil {
 		writeError(w, err)
 		return
@@ -43,7 +43,7 @@ func TestHandler_GetRecommendedPrice(t *testing.T) {
 	// Valid case
 	t.Run("valid request", func(t *testing.T) {
 		v := domain.Vehicle{
-			VIN:      "1HGCM82633A123456",
+			VIN:      "1HGCM82673A123456",
 			Grade:    47,
 			Odometer: 30_000,
 		}
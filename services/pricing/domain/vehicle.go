@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/alechekz/online-car-auction/pkg/vin"
 )
 
 // Vehicle represents a vehicle entity in the system
@@ -18,14 +20,16 @@ type Vehicle struct {
 	Price         uint64 `json:"price"`
 }
 
-// Validate checks if the data for inspection is valid
+// Validate checks if the data for inspection is valid, the VIN is normalized to upper case first
 func (v *Vehicle) Validate() error {
+	v.VIN = vin.Normalize(v.VIN)
 	return validation.ValidateStruct(
 		v,
 		validation.Field(
 			&v.VIN,
 			validation.Required,
 			validation.Length(17, 17),
+			vin.Rule,
 		),
 		validation.Field(
 			&v.Grade,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/alechekz/online-car-auction/pkg/resilience"
 	pb "github.com/alechekz/online-car-auction/services/inspection/delivery/grpc/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -11,18 +12,24 @@ import (
 
 // InspectionGRPCClient is a gRPC client for the Inspection Service
 type InspectionGRPCClient struct {
-	client pb.InspectionServiceClient
-	conn   *grpc.ClientConn
+	client  pb.InspectionServiceClient
+	conn    *grpc.ClientConn
+	timeout time.Duration
 }
 
-// NewInspectionGRPCClient creates a new InspectionGRPCClient instance
-func NewInspectionGRPCClient(address string) (*InspectionGRPCClient, error) {
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewInspectionGRPCClient creates a new InspectionGRPCClient instance, the timeout limits every call with all of its retries
+// and the breaker, if any, guards the Inspection Service
+func NewInspectionGRPCClient(address string, timeout time.Duration, policy resilience.RetryPolicy, breaker *resilience.Breaker) (*InspectionGRPCClient, error) {
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		resilience.DialOption(policy, breaker, pb.InspectionService_GetBuildData_FullMethodName),
+	)
 	if err != nil {
 		return nil, err
 	}
 	client := pb.NewInspectionServiceClient(conn)
-	return &InspectionGRPCClient{client: client, conn: conn}, nil
+	return &InspectionGRPCClient{client: client, conn: conn, timeout: timeout}, nil
 }
 
 // Close closes the gRPC connection
@@ -31,8 +38,8 @@ func (c *InspectionGRPCClient) Close() error {
 }
 
 // GetMsrp retrieves MSRP from the Inspection Service
-func (c *InspectionGRPCClient) GetMsrp(vin string) (uint64, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (c *InspectionGRPCClient) GetMsrp(ctx context.Context, vin string) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 	req := &pb.GetBuildDataRequest{Vin: vin}
 	resp, err := c.client.GetBuildData(ctx, req)
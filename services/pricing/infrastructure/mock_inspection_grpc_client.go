@@ -1,6 +1,10 @@
 package infrastructure
 
-import "github.com/alechekz/online-car-auction/services/pricing/domain"
+import (
+	"context"
+
+	"github.com/alechekz/online-car-auction/services/pricing/domain"
+)
 
 // MockInspectionProvider is a mock implementation of the InspectionProvider interface for testing purposes
 type MockInspectionProvider struct {
@@ -9,7 +13,10 @@ type MockInspectionProvider struct {
 }
 
 // GetMsrp simulates fetching build data for a vehicle
-func (m *MockInspectionProvider) GetMsrp(vin string) (uint64, error) {
+func (m *MockInspectionProvider) GetMsrp(ctx context.Context, vin string) (uint64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
 	if m.Err != nil {
 		return 0, m.Err
 	}
@@ -1,6 +1,11 @@
 package server
 
-import "os"
+import (
+	"os"
+	"time"
+
+	"github.com/alechekz/online-car-auction/pkg/resilience"
+)
 
 // config holds the configuration for the Inspection Service server
 type config struct {
@@ -8,6 +13,13 @@ type config struct {
 	GrpcAddress   string
 	DatabaseURL   string
 	InspectionURL string
+
+	// Limit of every call to the Inspection Service with all of its retries
+	InspectionTimeout time.Duration
+
+	// Retries and circuit breaker of the calls to the Inspection Service
+	InspectionRetry   resilience.RetryPolicy
+	InspectionBreaker resilience.BreakerConfig
 }
 
 // NewConfig creates a new server configuration with default values
@@ -16,6 +28,11 @@ func NewConfig() *config {
 		HttpAddress:   ":6064",
 		GrpcAddress:   ":6065",
 		InspectionURL: ":6063",
+
+		InspectionTimeout: 10 * time.Second,
+
+		InspectionRetry:   resilience.RetryPolicyFromEnv("INSPECTION"),
+		InspectionBreaker: resilience.BreakerConfigFromEnv("INSPECTION"),
 	}
 	if os.Getenv("PRICING_HTTP") != "" {
 		cfg.HttpAddress = os.Getenv("PRICING_HTTP")
@@ -26,5 +43,8 @@ func NewConfig() *config {
 	if os.Getenv("INSPECTION_URL") != "" {
 		cfg.InspectionURL = os.Getenv("INSPECTION_URL")
 	}
+	if d, err := time.ParseDuration(os.Getenv("INSPECTION_TIMEOUT")); err == nil && d > 0 {
+		cfg.InspectionTimeout = d
+	}
 	return cfg
 }
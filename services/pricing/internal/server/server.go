@@ -1,6 +1,7 @@
 package server
 
 import (
+	"expvar"
 	"log/slog"
 	"net"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/alechekz/online-car-auction/pkg/resilience"
 	grpcDelivery "github.com/alechekz/online-car-auction/services/pricing/delivery/grpc"
 	pb "github.com/alechekz/online-car-auction/services/pricing/delivery/grpc/proto"
 	httpDelivery "github.com/alechekz/online-car-auction/services/pricing/delivery/http"
@@ -29,7 +31,11 @@ type Server struct {
 func NewServer(cfg *config) (*Server, error) {
 
 	// Dependencies
-	provider, err := infrastructure.NewInspectionGRPCClient(cfg.InspectionURL)
+	cfg.InspectionBreaker.OnStateChange = func(name, from, to string) {
+		logger.Log.Warn("circuit breaker state changed", slog.String("dependency", name), slog.String("from", from), slog.String("to", to))
+	}
+	breaker := resilience.NewBreaker("inspection", cfg.InspectionBreaker)
+	provider, err := infrastructure.NewInspectionGRPCClient(cfg.InspectionURL, cfg.InspectionTimeout, cfg.InspectionRetry, breaker)
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +43,9 @@ func NewServer(cfg *config) (*Server, error) {
 
 	// HTTP handler
 	handler := &httpDelivery.PricingHandler{UC: uc}
-	mux := httpDelivery.NewRouter(handler)
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.Handle("/", httpDelivery.NewRouter(handler))
 
 	// gRPC handler
 	grpcSrv := grpc.NewServer()
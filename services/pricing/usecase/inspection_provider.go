@@ -1,6 +1,8 @@
 package usecase
 
+import "context"
+
 // InspectionProvider defines the interface for fetching vehicle build data
 type InspectionProvider interface {
-	GetMsrp(vin string) (uint64, error)
+	GetMsrp(ctx context.Context, vin string) (uint64, error)
 }
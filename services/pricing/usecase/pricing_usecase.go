@@ -1,12 +1,14 @@
 package usecase
 
 import (
+	"context"
+
 	"github.com/alechekz/online-car-auction/services/pricing/domain"
 )
 
 // Pricing usecase defines the interface for pricing-related business logic
 type PricingUsecase interface {
-	GetRecommendedPrice(v *domain.Vehicle) error
+	GetRecommendedPrice(ctx context.Context, v *domain.Vehicle) error
 }
 
 // pricingUsecase is the implementation of PricingUsecase interface
@@ -20,7 +22,7 @@ func NewPricingUC(provider InspectionProvider) *pricingUsecase {
 }
 
 // GetRecommendedPrice calculates the recommended price for a vehicle
-func (uc *pricingUsecase) GetRecommendedPrice(v *domain.Vehicle) error {
+func (uc *pricingUsecase) GetRecommendedPrice(ctx context.Context, v *domain.Vehicle) error {
 
 	// Validate the vehicle data
 	if err := v.Validate(); err != nil {
@@ -28,7 +30,7 @@ func (uc *pricingUsecase) GetRecommendedPrice(v *domain.Vehicle) error {
 	}
 
 	// Fetch MRSP
-	msrp, err := uc.provider.GetMsrp(v.VIN)
+	msrp, err := uc.provider.GetMsrp(ctx, v.VIN)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package usecase_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ type test struct {
 // newTestVehicle is a test valid vehicle instance
 func newTestVehicle() *domain.Vehicle {
 	return &domain.Vehicle{
-		VIN:      "1HGCM82633A123456",
+		VIN:      "1HGCM82673A123456",
 		Odometer: 15000,
 		Grade:    47,
 	}
@@ -65,7 +66,7 @@ func TestPricingUsecase_GetRecommendedPrice(t *testing.T) {
 	// Run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := uc.GetRecommendedPrice(test.data())
+			err := uc.GetRecommendedPrice(t.Context(), test.data())
 			if test.isValid {
 				assert.NoError(t, err)
 			} else {
@@ -74,3 +75,11 @@ func TestPricingUsecase_GetRecommendedPrice(t *testing.T) {
 		})
 	}
 }
+
+// TestPricingUsecase_GetRecommendedPrice_Cancelled tests that the caller's context reaches the Inspection Service
+func TestPricingUsecase_GetRecommendedPrice_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	err := newTestUC().GetRecommendedPrice(ctx, newTestVehicle())
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// statusError maps domain errors to gRPC status errors
+func statusError(err error) error {
+
+	// Default to internal error
+	code := codes.Internal
+	msg := "internal server error"
+
+	// Determine specific error type
+	switch {
+	case errors.Is(err, domain.ErrValidation):
+		code = codes.InvalidArgument
+		msg = err.Error()
+	case errors.Is(err, domain.ErrNotFound), errors.Is(err, domain.ErrJobNotFound), errors.Is(err, domain.ErrOfferNotFound):
+		code = codes.NotFound
+		msg = err.Error()
+	case errors.Is(err, domain.ErrAlreadyExists):
+		code = codes.AlreadyExists
+		msg = err.Error()
+	case errors.Is(err, domain.ErrNotDeleted), errors.Is(err, domain.ErrJobFinished), errors.Is(err, domain.ErrSalePending),
+		errors.Is(err, domain.ErrInvalidTransition), errors.Is(err, domain.ErrNotForSale), errors.Is(err, domain.ErrSaleClaimed):
+		code = codes.FailedPrecondition
+		msg = err.Error()
+	case errors.Is(err, domain.ErrPreconditionRequired):
+		code = codes.FailedPrecondition
+		msg = "vehicle version required"
+	case errors.Is(err, domain.ErrVersionConflict):
+		code = codes.Aborted
+		msg = err.Error()
+	case errors.Is(err, domain.ErrUnavailable):
+		code = codes.Unavailable
+		msg = domain.ErrUnavailable.Error()
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+		msg = err.Error()
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+		msg = err.Error()
+	}
+	return status.Error(code, msg)
+}
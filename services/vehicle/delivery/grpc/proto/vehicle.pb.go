@@ -0,0 +1,1536 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: vehicle.proto
+
+package proto_proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Vehicle struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Vin                  string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Year                 int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Odometer             int32                  `protobuf:"varint,3,opt,name=odometer,proto3" json:"odometer,omitempty"`
+	ExteriorColor        string                 `protobuf:"bytes,4,opt,name=exterior_color,json=exteriorColor,proto3" json:"exterior_color,omitempty"`
+	InteriorColor        string                 `protobuf:"bytes,5,opt,name=interior_color,json=interiorColor,proto3" json:"interior_color,omitempty"`
+	Msrp                 uint64                 `protobuf:"varint,6,opt,name=msrp,proto3" json:"msrp,omitempty"`
+	Price                uint64                 `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`
+	Grade                int32                  `protobuf:"varint,8,opt,name=grade,proto3" json:"grade,omitempty"`
+	SmallScratches       bool                   `protobuf:"varint,9,opt,name=small_scratches,json=smallScratches,proto3" json:"small_scratches,omitempty"`
+	StrongScratches      bool                   `protobuf:"varint,10,opt,name=strong_scratches,json=strongScratches,proto3" json:"strong_scratches,omitempty"`
+	ElectricFail         bool                   `protobuf:"varint,11,opt,name=electric_fail,json=electricFail,proto3" json:"electric_fail,omitempty"`
+	SuspensionFail       bool                   `protobuf:"varint,12,opt,name=suspension_fail,json=suspensionFail,proto3" json:"suspension_fail,omitempty"`
+	Brand                string                 `protobuf:"bytes,13,opt,name=brand,proto3" json:"brand,omitempty"`
+	Engine               string                 `protobuf:"bytes,14,opt,name=engine,proto3" json:"engine,omitempty"`
+	Transmission         string                 `protobuf:"bytes,15,opt,name=transmission,proto3" json:"transmission,omitempty"`
+	Version              int64                  `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
+	Lifecycle            string                 `protobuf:"bytes,17,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`                     // read-only, changed by lifecycle transitions
+	SaleStatus           string                 `protobuf:"bytes,18,opt,name=sale_status,json=saleStatus,proto3" json:"sale_status,omitempty"` // read-only, changed by the buy-now sale flow
+	Model                string                 `protobuf:"bytes,19,opt,name=model,proto3" json:"model,omitempty"`
+	Trim                 string                 `protobuf:"bytes,20,opt,name=trim,proto3" json:"trim,omitempty"`
+	BodyClass            string                 `protobuf:"bytes,21,opt,name=body_class,json=bodyClass,proto3" json:"body_class,omitempty"`
+	DriveType            string                 `protobuf:"bytes,22,opt,name=drive_type,json=driveType,proto3" json:"drive_type,omitempty"`
+	FuelType             string                 `protobuf:"bytes,23,opt,name=fuel_type,json=fuelType,proto3" json:"fuel_type,omitempty"`
+	Displacement         float64                `protobuf:"fixed64,24,opt,name=displacement,proto3" json:"displacement,omitempty"`
+	Cylinders            int32                  `protobuf:"varint,25,opt,name=cylinders,proto3" json:"cylinders,omitempty"`
+	Doors                int32                  `protobuf:"varint,26,opt,name=doors,proto3" json:"doors,omitempty"`
+	ElectrificationLevel string                 `protobuf:"bytes,27,opt,name=electrification_level,json=electrificationLevel,proto3" json:"electrification_level,omitempty"`
+	DecodeQuality        *DecodeQuality         `protobuf:"bytes,28,opt,name=decode_quality,json=decodeQuality,proto3" json:"decode_quality,omitempty"` // read-only, follows the decoded build data
+	BuyNowPrice          uint64                 `protobuf:"varint,29,opt,name=buy_now_price,json=buyNowPrice,proto3" json:"buy_now_price,omitempty"`    // read-only, set when listed for sale
+	Buyer                string                 `protobuf:"bytes,30,opt,name=buyer,proto3" json:"buyer,omitempty"`                                      // read-only, set when sold
+	SalePrice            uint64                 `protobuf:"varint,31,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`            // read-only, set when sold
+	Enrichment           *Enrichment            `protobuf:"bytes,32,opt,name=enrichment,proto3" json:"enrichment,omitempty"`                            // read-only, set while inspection or pricing data is pending
+	SaleChannel          string                 `protobuf:"bytes,33,opt,name=sale_channel,json=saleChannel,proto3" json:"sale_channel,omitempty"`       // read-only, the channel of the sale that claimed the vehicle
+	SaleId               string                 `protobuf:"bytes,34,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`                      // read-only, the sale that claimed the vehicle
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Vehicle) Reset() {
+	*x = Vehicle{}
+	mi := &file_vehicle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vehicle) ProtoMessage() {}
+
+func (x *Vehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vehicle.ProtoReflect.Descriptor instead.
+func (*Vehicle) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vehicle) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *Vehicle) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Vehicle) GetOdometer() int32 {
+	if x != nil {
+		return x.Odometer
+	}
+	return 0
+}
+
+func (x *Vehicle) GetExteriorColor() string {
+	if x != nil {
+		return x.ExteriorColor
+	}
+	return ""
+}
+
+func (x *Vehicle) GetInteriorColor() string {
+	if x != nil {
+		return x.InteriorColor
+	}
+	return ""
+}
+
+func (x *Vehicle) GetMsrp() uint64 {
+	if x != nil {
+		return x.Msrp
+	}
+	return 0
+}
+
+func (x *Vehicle) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Vehicle) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *Vehicle) GetSmallScratches() bool {
+	if x != nil {
+		return x.SmallScratches
+	}
+	return false
+}
+
+func (x *Vehicle) GetStrongScratches() bool {
+	if x != nil {
+		return x.StrongScratches
+	}
+	return false
+}
+
+func (x *Vehicle) GetElectricFail() bool {
+	if x != nil {
+		return x.ElectricFail
+	}
+	return false
+}
+
+func (x *Vehicle) GetSuspensionFail() bool {
+	if x != nil {
+		return x.SuspensionFail
+	}
+	return false
+}
+
+func (x *Vehicle) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *Vehicle) GetEngine() string {
+	if x != nil {
+		return x.Engine
+	}
+	return ""
+}
+
+func (x *Vehicle) GetTransmission() string {
+	if x != nil {
+		return x.Transmission
+	}
+	return ""
+}
+
+func (x *Vehicle) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Vehicle) GetLifecycle() string {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return ""
+}
+
+func (x *Vehicle) GetSaleStatus() string {
+	if x != nil {
+		return x.SaleStatus
+	}
+	return ""
+}
+
+func (x *Vehicle) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Vehicle) GetTrim() string {
+	if x != nil {
+		return x.Trim
+	}
+	return ""
+}
+
+func (x *Vehicle) GetBodyClass() string {
+	if x != nil {
+		return x.BodyClass
+	}
+	return ""
+}
+
+func (x *Vehicle) GetDriveType() string {
+	if x != nil {
+		return x.DriveType
+	}
+	return ""
+}
+
+func (x *Vehicle) GetFuelType() string {
+	if x != nil {
+		return x.FuelType
+	}
+	return ""
+}
+
+func (x *Vehicle) GetDisplacement() float64 {
+	if x != nil {
+		return x.Displacement
+	}
+	return 0
+}
+
+func (x *Vehicle) GetCylinders() int32 {
+	if x != nil {
+		return x.Cylinders
+	}
+	return 0
+}
+
+func (x *Vehicle) GetDoors() int32 {
+	if x != nil {
+		return x.Doors
+	}
+	return 0
+}
+
+func (x *Vehicle) GetElectrificationLevel() string {
+	if x != nil {
+		return x.ElectrificationLevel
+	}
+	return ""
+}
+
+func (x *Vehicle) GetDecodeQuality() *DecodeQuality {
+	if x != nil {
+		return x.DecodeQuality
+	}
+	return nil
+}
+
+func (x *Vehicle) GetBuyNowPrice() uint64 {
+	if x != nil {
+		return x.BuyNowPrice
+	}
+	return 0
+}
+
+func (x *Vehicle) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *Vehicle) GetSalePrice() uint64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *Vehicle) GetEnrichment() *Enrichment {
+	if x != nil {
+		return x.Enrichment
+	}
+	return nil
+}
+
+func (x *Vehicle) GetSaleChannel() string {
+	if x != nil {
+		return x.SaleChannel
+	}
+	return ""
+}
+
+func (x *Vehicle) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+type DecodeQuality struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Codes         []int32                `protobuf:"varint,2,rep,packed,name=codes,proto3" json:"codes,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeQuality) Reset() {
+	*x = DecodeQuality{}
+	mi := &file_vehicle_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeQuality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeQuality) ProtoMessage() {}
+
+func (x *DecodeQuality) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeQuality.ProtoReflect.Descriptor instead.
+func (*DecodeQuality) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DecodeQuality) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DecodeQuality) GetCodes() []int32 {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *DecodeQuality) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Enrichment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempts      int32                  `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	RetryAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrichment) Reset() {
+	*x = Enrichment{}
+	mi := &file_vehicle_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrichment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrichment) ProtoMessage() {}
+
+func (x *Enrichment) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrichment.ProtoReflect.Descriptor instead.
+func (*Enrichment) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Enrichment) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Enrichment) GetRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAt
+	}
+	return nil
+}
+
+func (x *Enrichment) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CreateVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vehicle       *Vehicle               `protobuf:"bytes,1,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVehicleRequest) Reset() {
+	*x = CreateVehicleRequest{}
+	mi := &file_vehicle_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVehicleRequest) ProtoMessage() {}
+
+func (x *CreateVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVehicleRequest.ProtoReflect.Descriptor instead.
+func (*CreateVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateVehicleRequest) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+type GetVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVehicleRequest) Reset() {
+	*x = GetVehicleRequest{}
+	mi := &file_vehicle_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehicleRequest) ProtoMessage() {}
+
+func (x *GetVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehicleRequest.ProtoReflect.Descriptor instead.
+func (*GetVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetVehicleRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+// The vehicle version is required and must match the stored one
+type UpdateVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vehicle       *Vehicle               `protobuf:"bytes,1,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVehicleRequest) Reset() {
+	*x = UpdateVehicleRequest{}
+	mi := &file_vehicle_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVehicleRequest) ProtoMessage() {}
+
+func (x *UpdateVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVehicleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateVehicleRequest) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+type DeleteVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVehicleRequest) Reset() {
+	*x = DeleteVehicleRequest{}
+	mi := &file_vehicle_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVehicleRequest) ProtoMessage() {}
+
+func (x *DeleteVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVehicleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteVehicleRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+type DeleteVehicleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVehicleResponse) Reset() {
+	*x = DeleteVehicleResponse{}
+	mi := &file_vehicle_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVehicleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVehicleResponse) ProtoMessage() {}
+
+func (x *DeleteVehicleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVehicleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVehicleResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteVehicleResponse) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+type VehicleFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brand         string                 `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	Colors        []string               `protobuf:"bytes,2,rep,name=colors,proto3" json:"colors,omitempty"`
+	YearMin       int32                  `protobuf:"varint,3,opt,name=year_min,json=yearMin,proto3" json:"year_min,omitempty"`
+	YearMax       int32                  `protobuf:"varint,4,opt,name=year_max,json=yearMax,proto3" json:"year_max,omitempty"`
+	OdometerMin   int32                  `protobuf:"varint,5,opt,name=odometer_min,json=odometerMin,proto3" json:"odometer_min,omitempty"`
+	OdometerMax   int32                  `protobuf:"varint,6,opt,name=odometer_max,json=odometerMax,proto3" json:"odometer_max,omitempty"`
+	GradeMin      int32                  `protobuf:"varint,7,opt,name=grade_min,json=gradeMin,proto3" json:"grade_min,omitempty"`
+	GradeMax      int32                  `protobuf:"varint,8,opt,name=grade_max,json=gradeMax,proto3" json:"grade_max,omitempty"`
+	PriceMin      uint64                 `protobuf:"varint,9,opt,name=price_min,json=priceMin,proto3" json:"price_min,omitempty"`
+	PriceMax      uint64                 `protobuf:"varint,10,opt,name=price_max,json=priceMax,proto3" json:"price_max,omitempty"`
+	Sort          string                 `protobuf:"bytes,11,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,12,opt,name=order,proto3" json:"order,omitempty"`
+	Lifecycle     string                 `protobuf:"bytes,13,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	SaleStatus    string                 `protobuf:"bytes,14,opt,name=sale_status,json=saleStatus,proto3" json:"sale_status,omitempty"`
+	BodyClass     string                 `protobuf:"bytes,15,opt,name=body_class,json=bodyClass,proto3" json:"body_class,omitempty"`
+	FuelType      string                 `protobuf:"bytes,16,opt,name=fuel_type,json=fuelType,proto3" json:"fuel_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VehicleFilter) Reset() {
+	*x = VehicleFilter{}
+	mi := &file_vehicle_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleFilter) ProtoMessage() {}
+
+func (x *VehicleFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleFilter.ProtoReflect.Descriptor instead.
+func (*VehicleFilter) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VehicleFilter) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *VehicleFilter) GetColors() []string {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+func (x *VehicleFilter) GetYearMin() int32 {
+	if x != nil {
+		return x.YearMin
+	}
+	return 0
+}
+
+func (x *VehicleFilter) GetYearMax() int32 {
+	if x != nil {
+		return x.YearMax
+	}
+	return 0
+}
+
+func (x *VehicleFilter) GetOdometerMin() int32 {
+	if x != nil {
+		return x.OdometerMin
+	}
+	return 0
+}
+
+func (x *VehicleFilter) GetOdometerMax() int32 {
+	if x != nil {
+		return x.OdometerMax
+	}
+	return 0
+}
+
+func (x *VehicleFilter) GetGradeMin() int32 {
+	if x != nil {
+		return x.GradeMin
+	}
+	return 0
+}
+
+func (x *VehicleFilter) GetGradeMax() int32 {
+	if x != nil {
+		return x.GradeMax
+	}
+	return 0
+}
+
+func (x *VehicleFilter) GetPriceMin() uint64 {
+	if x != nil {
+		return x.PriceMin
+	}
+	return 0
+}
+
+func (x *VehicleFilter) GetPriceMax() uint64 {
+	if x != nil {
+		return x.PriceMax
+	}
+	return 0
+}
+
+func (x *VehicleFilter) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *VehicleFilter) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *VehicleFilter) GetLifecycle() string {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return ""
+}
+
+func (x *VehicleFilter) GetSaleStatus() string {
+	if x != nil {
+		return x.SaleStatus
+	}
+	return ""
+}
+
+func (x *VehicleFilter) GetBodyClass() string {
+	if x != nil {
+		return x.BodyClass
+	}
+	return ""
+}
+
+func (x *VehicleFilter) GetFuelType() string {
+	if x != nil {
+		return x.FuelType
+	}
+	return ""
+}
+
+type ListVehiclesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *VehicleFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVehiclesRequest) Reset() {
+	*x = ListVehiclesRequest{}
+	mi := &file_vehicle_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesRequest) ProtoMessage() {}
+
+func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*ListVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListVehiclesRequest) GetFilter() *VehicleFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListVehiclesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListVehiclesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vehicles      []*Vehicle             `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVehiclesResponse) Reset() {
+	*x = ListVehiclesResponse{}
+	mi := &file_vehicle_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesResponse) ProtoMessage() {}
+
+func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*ListVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListVehiclesResponse) GetVehicles() []*Vehicle {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+func (x *ListVehiclesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamVehiclesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *VehicleFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamVehiclesRequest) Reset() {
+	*x = StreamVehiclesRequest{}
+	mi := &file_vehicle_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamVehiclesRequest) ProtoMessage() {}
+
+func (x *StreamVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*StreamVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StreamVehiclesRequest) GetFilter() *VehicleFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type BulkItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Vehicle       *Vehicle               `protobuf:"bytes,4,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkItemResult) Reset() {
+	*x = BulkItemResult{}
+	mi := &file_vehicle_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkItemResult) ProtoMessage() {}
+
+func (x *BulkItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkItemResult.ProtoReflect.Descriptor instead.
+func (*BulkItemResult) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BulkItemResult) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *BulkItemResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkItemResult) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+type BulkCreateVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Succeeded     int32                  `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateVehiclesResponse) Reset() {
+	*x = BulkCreateVehiclesResponse{}
+	mi := &file_vehicle_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateVehiclesResponse) ProtoMessage() {}
+
+func (x *BulkCreateVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BulkCreateVehiclesResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkCreateVehiclesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkCreateVehiclesResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ClaimSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	SaleId        string                 `protobuf:"bytes,3,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimSaleRequest) Reset() {
+	*x = ClaimSaleRequest{}
+	mi := &file_vehicle_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimSaleRequest) ProtoMessage() {}
+
+func (x *ClaimSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimSaleRequest.ProtoReflect.Descriptor instead.
+func (*ClaimSaleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ClaimSaleRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *ClaimSaleRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ClaimSaleRequest) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+// An empty buyer settles the sale unsold
+type SettleSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	SaleId        string                 `protobuf:"bytes,3,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	Buyer         string                 `protobuf:"bytes,4,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Price         uint64                 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleSaleRequest) Reset() {
+	*x = SettleSaleRequest{}
+	mi := &file_vehicle_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleSaleRequest) ProtoMessage() {}
+
+func (x *SettleSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleSaleRequest.ProtoReflect.Descriptor instead.
+func (*SettleSaleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SettleSaleRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *SettleSaleRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SettleSaleRequest) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+func (x *SettleSaleRequest) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *SettleSaleRequest) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ReleaseSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	SaleId        string                 `protobuf:"bytes,2,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSaleRequest) Reset() {
+	*x = ReleaseSaleRequest{}
+	mi := &file_vehicle_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSaleRequest) ProtoMessage() {}
+
+func (x *ReleaseSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSaleRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSaleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReleaseSaleRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *ReleaseSaleRequest) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+var File_vehicle_proto protoreflect.FileDescriptor
+
+const file_vehicle_proto_rawDesc = "" +
+	"\n" +
+	"\rvehicle.proto\x12\avehicle\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\b\n" +
+	"\aVehicle\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x1a\n" +
+	"\bodometer\x18\x03 \x01(\x05R\bodometer\x12%\n" +
+	"\x0eexterior_color\x18\x04 \x01(\tR\rexteriorColor\x12%\n" +
+	"\x0einterior_color\x18\x05 \x01(\tR\rinteriorColor\x12\x12\n" +
+	"\x04msrp\x18\x06 \x01(\x04R\x04msrp\x12\x14\n" +
+	"\x05price\x18\a \x01(\x04R\x05price\x12\x14\n" +
+	"\x05grade\x18\b \x01(\x05R\x05grade\x12'\n" +
+	"\x0fsmall_scratches\x18\t \x01(\bR\x0esmallScratches\x12)\n" +
+	"\x10strong_scratches\x18\n" +
+	" \x01(\bR\x0fstrongScratches\x12#\n" +
+	"\relectric_fail\x18\v \x01(\bR\felectricFail\x12'\n" +
+	"\x0fsuspension_fail\x18\f \x01(\bR\x0esuspensionFail\x12\x14\n" +
+	"\x05brand\x18\r \x01(\tR\x05brand\x12\x16\n" +
+	"\x06engine\x18\x0e \x01(\tR\x06engine\x12\"\n" +
+	"\ftransmission\x18\x0f \x01(\tR\ftransmission\x12\x18\n" +
+	"\aversion\x18\x10 \x01(\x03R\aversion\x12\x1c\n" +
+	"\tlifecycle\x18\x11 \x01(\tR\tlifecycle\x12\x1f\n" +
+	"\vsale_status\x18\x12 \x01(\tR\n" +
+	"saleStatus\x12\x14\n" +
+	"\x05model\x18\x13 \x01(\tR\x05model\x12\x12\n" +
+	"\x04trim\x18\x14 \x01(\tR\x04trim\x12\x1d\n" +
+	"\n" +
+	"body_class\x18\x15 \x01(\tR\tbodyClass\x12\x1d\n" +
+	"\n" +
+	"drive_type\x18\x16 \x01(\tR\tdriveType\x12\x1b\n" +
+	"\tfuel_type\x18\x17 \x01(\tR\bfuelType\x12\"\n" +
+	"\fdisplacement\x18\x18 \x01(\x01R\fdisplacement\x12\x1c\n" +
+	"\tcylinders\x18\x19 \x01(\x05R\tcylinders\x12\x14\n" +
+	"\x05doors\x18\x1a \x01(\x05R\x05doors\x123\n" +
+	"\x15electrification_level\x18\x1b \x01(\tR\x14electrificationLevel\x12=\n" +
+	"\x0edecode_quality\x18\x1c \x01(\v2\x16.vehicle.DecodeQualityR\rdecodeQuality\x12\"\n" +
+	"\rbuy_now_price\x18\x1d \x01(\x04R\vbuyNowPrice\x12\x14\n" +
+	"\x05buyer\x18\x1e \x01(\tR\x05buyer\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\x1f \x01(\x04R\tsalePrice\x123\n" +
+	"\n" +
+	"enrichment\x18  \x01(\v2\x13.vehicle.EnrichmentR\n" +
+	"enrichment\x12!\n" +
+	"\fsale_channel\x18! \x01(\tR\vsaleChannel\x12\x17\n" +
+	"\asale_id\x18\" \x01(\tR\x06saleId\"W\n" +
+	"\rDecodeQuality\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05codes\x18\x02 \x03(\x05R\x05codes\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"u\n" +
+	"\n" +
+	"Enrichment\x12\x1a\n" +
+	"\battempts\x18\x01 \x01(\x05R\battempts\x125\n" +
+	"\bretry_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aretryAt\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"B\n" +
+	"\x14CreateVehicleRequest\x12*\n" +
+	"\avehicle\x18\x01 \x01(\v2\x10.vehicle.VehicleR\avehicle\"%\n" +
+	"\x11GetVehicleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\"B\n" +
+	"\x14UpdateVehicleRequest\x12*\n" +
+	"\avehicle\x18\x01 \x01(\v2\x10.vehicle.VehicleR\avehicle\"(\n" +
+	"\x14DeleteVehicleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\")\n" +
+	"\x15DeleteVehicleResponse\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\"\xd2\x03\n" +
+	"\rVehicleFilter\x12\x14\n" +
+	"\x05brand\x18\x01 \x01(\tR\x05brand\x12\x16\n" +
+	"\x06colors\x18\x02 \x03(\tR\x06colors\x12\x19\n" +
+	"\byear_min\x18\x03 \x01(\x05R\ayearMin\x12\x19\n" +
+	"\byear_max\x18\x04 \x01(\x05R\ayearMax\x12!\n" +
+	"\fodometer_min\x18\x05 \x01(\x05R\vodometerMin\x12!\n" +
+	"\fodometer_max\x18\x06 \x01(\x05R\vodometerMax\x12\x1b\n" +
+	"\tgrade_min\x18\a \x01(\x05R\bgradeMin\x12\x1b\n" +
+	"\tgrade_max\x18\b \x01(\x05R\bgradeMax\x12\x1b\n" +
+	"\tprice_min\x18\t \x01(\x04R\bpriceMin\x12\x1b\n" +
+	"\tprice_max\x18\n" +
+	" \x01(\x04R\bpriceMax\x12\x12\n" +
+	"\x04sort\x18\v \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\f \x01(\tR\x05order\x12\x1c\n" +
+	"\tlifecycle\x18\r \x01(\tR\tlifecycle\x12\x1f\n" +
+	"\vsale_status\x18\x0e \x01(\tR\n" +
+	"saleStatus\x12\x1d\n" +
+	"\n" +
+	"body_class\x18\x0f \x01(\tR\tbodyClass\x12\x1b\n" +
+	"\tfuel_type\x18\x10 \x01(\tR\bfuelType\"z\n" +
+	"\x13ListVehiclesRequest\x12.\n" +
+	"\x06filter\x18\x01 \x01(\v2\x16.vehicle.VehicleFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"l\n" +
+	"\x14ListVehiclesResponse\x12,\n" +
+	"\bvehicles\x18\x01 \x03(\v2\x10.vehicle.VehicleR\bvehicles\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"G\n" +
+	"\x15StreamVehiclesRequest\x12.\n" +
+	"\x06filter\x18\x01 \x01(\v2\x16.vehicle.VehicleFilterR\x06filter\"|\n" +
+	"\x0eBulkItemResult\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12*\n" +
+	"\avehicle\x18\x04 \x01(\v2\x10.vehicle.VehicleR\avehicle\"\x85\x01\n" +
+	"\x1aBulkCreateVehiclesResponse\x12\x1c\n" +
+	"\tsucceeded\x18\x01 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\x121\n" +
+	"\aresults\x18\x03 \x03(\v2\x17.vehicle.BulkItemResultR\aresults\"W\n" +
+	"\x10ClaimSaleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x17\n" +
+	"\asale_id\x18\x03 \x01(\tR\x06saleId\"\x84\x01\n" +
+	"\x11SettleSaleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x17\n" +
+	"\asale_id\x18\x03 \x01(\tR\x06saleId\x12\x14\n" +
+	"\x05buyer\x18\x04 \x01(\tR\x05buyer\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x04R\x05price\"?\n" +
+	"\x12ReleaseSaleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x17\n" +
+	"\asale_id\x18\x02 \x01(\tR\x06saleId2\xb6\x05\n" +
+	"\x0eVehicleService\x12@\n" +
+	"\rCreateVehicle\x12\x1d.vehicle.CreateVehicleRequest\x1a\x10.vehicle.Vehicle\x12:\n" +
+	"\n" +
+	"GetVehicle\x12\x1a.vehicle.GetVehicleRequest\x1a\x10.vehicle.Vehicle\x12@\n" +
+	"\rUpdateVehicle\x12\x1d.vehicle.UpdateVehicleRequest\x1a\x10.vehicle.Vehicle\x12N\n" +
+	"\rDeleteVehicle\x12\x1d.vehicle.DeleteVehicleRequest\x1a\x1e.vehicle.DeleteVehicleResponse\x12K\n" +
+	"\fListVehicles\x12\x1c.vehicle.ListVehiclesRequest\x1a\x1d.vehicle.ListVehiclesResponse\x12D\n" +
+	"\x0eStreamVehicles\x12\x1e.vehicle.StreamVehiclesRequest\x1a\x10.vehicle.Vehicle0\x01\x12M\n" +
+	"\x12BulkCreateVehicles\x12\x10.vehicle.Vehicle\x1a#.vehicle.BulkCreateVehiclesResponse(\x01\x128\n" +
+	"\tClaimSale\x12\x19.vehicle.ClaimSaleRequest\x1a\x10.vehicle.Vehicle\x12:\n" +
+	"\n" +
+	"SettleSale\x12\x1a.vehicle.SettleSaleRequest\x1a\x10.vehicle.Vehicle\x12<\n" +
+	"\vReleaseSale\x12\x1b.vehicle.ReleaseSaleRequest\x1a\x10.vehicle.VehicleBSZQgithub.com/alechekz/online-car-auction/services/vehicle/delivery/grpc/proto:protob\x06proto3"
+
+var (
+	file_vehicle_proto_rawDescOnce sync.Once
+	file_vehicle_proto_rawDescData []byte
+)
+
+func file_vehicle_proto_rawDescGZIP() []byte {
+	file_vehicle_proto_rawDescOnce.Do(func() {
+		file_vehicle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_vehicle_proto_rawDesc), len(file_vehicle_proto_rawDesc)))
+	})
+	return file_vehicle_proto_rawDescData
+}
+
+var file_vehicle_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_vehicle_proto_goTypes = []any{
+	(*Vehicle)(nil),                    // 0: vehicle.Vehicle
+	(*DecodeQuality)(nil),              // 1: vehicle.DecodeQuality
+	(*Enrichment)(nil),                 // 2: vehicle.Enrichment
+	(*CreateVehicleRequest)(nil),       // 3: vehicle.CreateVehicleRequest
+	(*GetVehicleRequest)(nil),          // 4: vehicle.GetVehicleRequest
+	(*UpdateVehicleRequest)(nil),       // 5: vehicle.UpdateVehicleRequest
+	(*DeleteVehicleRequest)(nil),       // 6: vehicle.DeleteVehicleRequest
+	(*DeleteVehicleResponse)(nil),      // 7: vehicle.DeleteVehicleResponse
+	(*VehicleFilter)(nil),              // 8: vehicle.VehicleFilter
+	(*ListVehiclesRequest)(nil),        // 9: vehicle.ListVehiclesRequest
+	(*ListVehiclesResponse)(nil),       // 10: vehicle.ListVehiclesResponse
+	(*StreamVehiclesRequest)(nil),      // 11: vehicle.StreamVehiclesRequest
+	(*BulkItemResult)(nil),             // 12: vehicle.BulkItemResult
+	(*BulkCreateVehiclesResponse)(nil), // 13: vehicle.BulkCreateVehiclesResponse
+	(*ClaimSaleRequest)(nil),           // 14: vehicle.ClaimSaleRequest
+	(*SettleSaleRequest)(nil),          // 15: vehicle.SettleSaleRequest
+	(*ReleaseSaleRequest)(nil),         // 16: vehicle.ReleaseSaleRequest
+	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
+}
+var file_vehicle_proto_depIdxs = []int32{
+	1,  // 0: vehicle.Vehicle.decode_quality:type_name -> vehicle.DecodeQuality
+	2,  // 1: vehicle.Vehicle.enrichment:type_name -> vehicle.Enrichment
+	17, // 2: vehicle.Enrichment.retry_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: vehicle.CreateVehicleRequest.vehicle:type_name -> vehicle.Vehicle
+	0,  // 4: vehicle.UpdateVehicleRequest.vehicle:type_name -> vehicle.Vehicle
+	8,  // 5: vehicle.ListVehiclesRequest.filter:type_name -> vehicle.VehicleFilter
+	0,  // 6: vehicle.ListVehiclesResponse.vehicles:type_name -> vehicle.Vehicle
+	8,  // 7: vehicle.StreamVehiclesRequest.filter:type_name -> vehicle.VehicleFilter
+	0,  // 8: vehicle.BulkItemResult.vehicle:type_name -> vehicle.Vehicle
+	12, // 9: vehicle.BulkCreateVehiclesResponse.results:type_name -> vehicle.BulkItemResult
+	3,  // 10: vehicle.VehicleService.CreateVehicle:input_type -> vehicle.CreateVehicleRequest
+	4,  // 11: vehicle.VehicleService.GetVehicle:input_type -> vehicle.GetVehicleRequest
+	5,  // 12: vehicle.VehicleService.UpdateVehicle:input_type -> vehicle.UpdateVehicleRequest
+	6,  // 13: vehicle.VehicleService.DeleteVehicle:input_type -> vehicle.DeleteVehicleRequest
+	9,  // 14: vehicle.VehicleService.ListVehicles:input_type -> vehicle.ListVehiclesRequest
+	11, // 15: vehicle.VehicleService.StreamVehicles:input_type -> vehicle.StreamVehiclesRequest
+	0,  // 16: vehicle.VehicleService.BulkCreateVehicles:input_type -> vehicle.Vehicle
+	14, // 17: vehicle.VehicleService.ClaimSale:input_type -> vehicle.ClaimSaleRequest
+	15, // 18: vehicle.VehicleService.SettleSale:input_type -> vehicle.SettleSaleRequest
+	16, // 19: vehicle.VehicleService.ReleaseSale:input_type -> vehicle.ReleaseSaleRequest
+	0,  // 20: vehicle.VehicleService.CreateVehicle:output_type -> vehicle.Vehicle
+	0,  // 21: vehicle.VehicleService.GetVehicle:output_type -> vehicle.Vehicle
+	0,  // 22: vehicle.VehicleService.UpdateVehicle:output_type -> vehicle.Vehicle
+	7,  // 23: vehicle.VehicleService.DeleteVehicle:output_type -> vehicle.DeleteVehicleResponse
+	10, // 24: vehicle.VehicleService.ListVehicles:output_type -> vehicle.ListVehiclesResponse
+	0,  // 25: vehicle.VehicleService.StreamVehicles:output_type -> vehicle.Vehicle
+	13, // 26: vehicle.VehicleService.BulkCreateVehicles:output_type -> vehicle.BulkCreateVehiclesResponse
+	0,  // 27: vehicle.VehicleService.ClaimSale:output_type -> vehicle.Vehicle
+	0,  // 28: vehicle.VehicleService.SettleSale:output_type -> vehicle.Vehicle
+	0,  // 29: vehicle.VehicleService.ReleaseSale:output_type -> vehicle.Vehicle
+	20, // [20:30] is the sub-list for method output_type
+	10, // [10:20] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_vehicle_proto_init() }
+func file_vehicle_proto_init() {
+	if File_vehicle_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vehicle_proto_rawDesc), len(file_vehicle_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vehicle_proto_goTypes,
+		DependencyIndexes: file_vehicle_proto_depIdxs,
+		MessageInfos:      file_vehicle_proto_msgTypes,
+	}.Build()
+	File_vehicle_proto = out.File
+	file_vehicle_proto_goTypes = nil
+	file_vehicle_proto_depIdxs = nil
+}
@@ -0,0 +1,316 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/alechekz/online-car-auction/services/vehicle/delivery/grpc/proto"
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+)
+
+// bulkChunkSize is the number of streamed vehicles created at once
+const bulkChunkSize = 100
+
+// VehicleServer implements the gRPC server for vehicle service
+type VehicleServer struct {
+	pb.UnimplementedVehicleServiceServer
+	uc     usecase.VehicleUsecase
+	bulkUC usecase.VehiclesBulkUsecase
+}
+
+// NewVehicleServer creates a new VehicleServer instance
+func NewVehicleServer(uc usecase.VehicleUsecase, bulkUC usecase.VehiclesBulkUsecase) *VehicleServer {
+	return &VehicleServer{uc: uc, bulkUC: bulkUC}
+}
+
+// actorOf returns the actor of the call recorded in the vehicle history
+func actorOf(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-actor"); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// CreateVehicle creates a new vehicle
+func (s *VehicleServer) CreateVehicle(ctx context.Context, req *pb.CreateVehicleRequest) (*pb.Vehicle, error) {
+	v := fromProto(req.GetVehicle())
+	v.Actor = actorOf(ctx)
+	if err := s.uc.Create(ctx, v); err != nil {
+		return nil, statusError(err)
+	}
+	return toProto(v), nil
+}
+
+// GetVehicle retrieves a vehicle by its VIN
+func (s *VehicleServer) GetVehicle(ctx context.Context, req *pb.GetVehicleRequest) (*pb.Vehicle, error) {
+	v, err := s.uc.Get(ctx, req.GetVin())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProto(v), nil
+}
+
+// UpdateVehicle replaces an existing vehicle, the version the vehicle was read with is required
+func (s *VehicleServer) UpdateVehicle(ctx context.Context, req *pb.UpdateVehicleRequest) (*pb.Vehicle, error) {
+	if req.GetVehicle().GetVersion() == 0 {
+		return nil, statusError(domain.ErrPreconditionRequired)
+	}
+	v := fromProto(req.GetVehicle())
+	v.Actor = actorOf(ctx)
+	if err := s.uc.Update(ctx, v); err != nil {
+		return nil, statusError(err)
+	}
+	return toProto(v), nil
+}
+
+// DeleteVehicle deletes a vehicle by its VIN
+func (s *VehicleServer) DeleteVehicle(ctx context.Context, req *pb.DeleteVehicleRequest) (*pb.DeleteVehicleResponse, error) {
+	if err := s.uc.Delete(ctx, req.GetVin(), actorOf(ctx)); err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.DeleteVehicleResponse{Vin: req.GetVin()}, nil
+}
+
+// ListVehicles lists vehicles matching the filter, one page at a time
+func (s *VehicleServer) ListVehicles(ctx context.Context, req *pb.ListVehiclesRequest) (*pb.ListVehiclesResponse, error) {
+	q := queryFromProto(req.GetFilter())
+	if req.GetLimit() != 0 {
+		q.Limit = int(req.GetLimit())
+	}
+	q.PageToken = req.GetPageToken()
+	page, err := s.uc.List(ctx, q)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &pb.ListVehiclesResponse{
+		Vehicles:      make([]*pb.Vehicle, len(page.Vehicles)),
+		NextPageToken: page.NextPageToken,
+	}
+	for i, v := range page.Vehicles {
+		res.Vehicles[i] = toProto(v)
+	}
+	return res, nil
+}
+
+// StreamVehicles streams all vehicles matching the filter
+func (s *VehicleServer) StreamVehicles(req *pb.StreamVehiclesRequest, stream grpc.ServerStreamingServer[pb.Vehicle]) error {
+	e := domain.NewVehicleExport(queryFromProto(req.GetFilter()))
+	err := s.uc.Export(stream.Context(), e, func(v *domain.Vehicle) error {
+		return stream.Send(toProto(v))
+	})
+	if err != nil {
+		return statusError(err)
+	}
+	return nil
+}
+
+// BulkCreateVehicles creates the streamed vehicles independently and reports per-vehicle results
+func (s *VehicleServer) BulkCreateVehicles(stream grpc.ClientStreamingServer[pb.Vehicle, pb.BulkCreateVehiclesResponse]) error {
+	ctx := stream.Context()
+	actor := actorOf(ctx)
+	res := &pb.BulkCreateVehiclesResponse{}
+
+	// Create the received vehicles chunk by chunk
+	chunk := make([]*domain.Vehicle, 0, bulkChunkSize)
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		br, err := s.bulkUC.CreateBestEffort(ctx, &domain.VehiclesBulk{Mode: domain.BulkModeBestEffort, Vehicles: chunk})
+		if err != nil {
+			return err
+		}
+		res.Succeeded += int32(br.Succeeded) //nolint:gosec // bounded by the chunk size
+		res.Failed += int32(br.Failed)       //nolint:gosec // bounded by the chunk size
+		for _, r := range br.Results {
+			item := &pb.BulkItemResult{Vin: r.VIN, Status: r.Status, Error: r.Error}
+			if r.Vehicle != nil {
+				item.Vehicle = toProto(r.Vehicle)
+			}
+			res.Results = append(res.Results, item)
+		}
+		chunk = make([]*domain.Vehicle, 0, bulkChunkSize)
+		return nil
+	}
+
+	// Receive the stream
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		v := fromProto(msg)
+		v.Actor = actor
+		chunk = append(chunk, v)
+		if len(chunk) == bulkChunkSize {
+			if err := flush(); err != nil {
+				return statusError(err)
+			}
+		}
+	}
+	if len(res.Results) == 0 && len(chunk) == 0 {
+		return statusError(domain.ErrValidation)
+	}
+	if err := flush(); err != nil {
+		return statusError(err)
+	}
+	return stream.SendAndClose(res)
+}
+
+// ClaimSale claims a listed vehicle for the sale of another channel
+func (s *VehicleServer) ClaimSale(ctx context.Context, req *pb.ClaimSaleRequest) (*pb.Vehicle, error) {
+	v, err := s.uc.ClaimSale(ctx, req.GetVin(), req.GetChannel(), req.GetSaleId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProto(v), nil
+}
+
+// SettleSale records the outcome of the sale that claimed the vehicle
+func (s *VehicleServer) SettleSale(ctx context.Context, req *pb.SettleSaleRequest) (*pb.Vehicle, error) {
+	v, err := s.uc.SettleSale(ctx, req.GetVin(), req.GetChannel(), req.GetSaleId(), req.GetBuyer(), req.GetPrice())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProto(v), nil
+}
+
+// ReleaseSale drops the claim of a sale that was never opened
+func (s *VehicleServer) ReleaseSale(ctx context.Context, req *pb.ReleaseSaleRequest) (*pb.Vehicle, error) {
+	v, err := s.uc.ReleaseSale(ctx, req.GetVin(), req.GetSaleId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProto(v), nil
+}
+
+// queryFromProto converts the listing filter into a vehicle query
+func queryFromProto(f *pb.VehicleFilter) *domain.VehicleQuery {
+	q := domain.NewVehicleQuery()
+	q.Brand = f.GetBrand()
+	q.BodyClass = f.GetBodyClass()
+	q.FuelType = f.GetFuelType()
+	q.Colors = f.GetColors()
+	q.YearFrom = f.GetYearMin()
+	q.YearTo = f.GetYearMax()
+	q.OdometerFrom = f.GetOdometerMin()
+	q.OdometerTo = f.GetOdometerMax()
+	q.GradeFrom = int(f.GetGradeMin())
+	q.GradeTo = int(f.GetGradeMax())
+	q.PriceFrom = f.GetPriceMin()
+	q.PriceTo = f.GetPriceMax()
+	q.Lifecycle = f.GetLifecycle()
+	q.Status = f.GetSaleStatus()
+	if f.GetSort() != "" {
+		q.SortBy = f.GetSort()
+	}
+	if f.GetOrder() != "" {
+		q.Order = f.GetOrder()
+	}
+	return q
+}
+
+// fromProto converts a protobuf vehicle into a domain vehicle
+func fromProto(v *pb.Vehicle) *domain.Vehicle {
+	return &domain.Vehicle{
+		VIN:             v.GetVin(),
+		Year:            v.GetYear(),
+		Odometer:        v.GetOdometer(),
+		ExteriorColor:   v.GetExteriorColor(),
+		InteriorColor:   v.GetInteriorColor(),
+		MSRP:            v.GetMsrp(),
+		Price:           v.GetPrice(),
+		Grade:           int(v.GetGrade()),
+		SmallScratches:  v.GetSmallScratches(),
+		StrongScratches: v.GetStrongScratches(),
+		ElectricFail:    v.GetElectricFail(),
+		SuspensionFail:  v.GetSuspensionFail(),
+		Brand:           v.GetBrand(),
+		Engine:          v.GetEngine(),
+		Transmission:    v.GetTransmission(),
+		BuildData: domain.BuildData{
+			Model:                v.GetModel(),
+			Trim:                 v.GetTrim(),
+			BodyClass:            v.GetBodyClass(),
+			DriveType:            v.GetDriveType(),
+			FuelType:             v.GetFuelType(),
+			Displacement:         v.GetDisplacement(),
+			Cylinders:            v.GetCylinders(),
+			Doors:                v.GetDoors(),
+			ElectrificationLevel: v.GetElectrificationLevel(),
+		},
+		Version: v.GetVersion(),
+	}
+}
+
+// toProto converts a domain vehicle into a protobuf vehicle
+func toProto(v *domain.Vehicle) *pb.Vehicle {
+	return &pb.Vehicle{
+		Vin:             v.VIN,
+		Year:            v.Year,
+		Odometer:        v.Odometer,
+		ExteriorColor:   v.ExteriorColor,
+		InteriorColor:   v.InteriorColor,
+		Msrp:            v.MSRP,
+		Price:           v.Price,
+		Grade:           int32(v.Grade), //nolint:gosec // always between 1 and 50
+		SmallScratches:  v.SmallScratches,
+		StrongScratches: v.StrongScratches,
+		ElectricFail:    v.ElectricFail,
+		SuspensionFail:  v.SuspensionFail,
+		Brand:           v.Brand,
+		Engine:          v.Engine,
+		Transmission:    v.Transmission,
+		Version:         v.Version,
+		Lifecycle:       v.Lifecycle,
+		SaleStatus:      v.Status,
+
+		Model:                v.Model,
+		Trim:                 v.Trim,
+		BodyClass:            v.BodyClass,
+		DriveType:            v.DriveType,
+		FuelType:             v.FuelType,
+		Displacement:         v.Displacement,
+		Cylinders:            v.Cylinders,
+		Doors:                v.Doors,
+		ElectrificationLevel: v.ElectrificationLevel,
+		DecodeQuality:        decodeQualityToProto(v.DecodeQuality),
+		BuyNowPrice:          v.BuyNowPrice,
+		Buyer:                v.Buyer,
+		SalePrice:            v.SalePrice,
+		Enrichment:           enrichmentToProto(v.Enrichment),
+		SaleChannel:          v.Channel,
+		SaleId:               v.SaleID,
+	}
+}
+
+// decodeQualityToProto converts the decode quality of the build data into a protobuf one
+func decodeQualityToProto(q *domain.DecodeQuality) *pb.DecodeQuality {
+	if q == nil {
+		return nil
+	}
+	return &pb.DecodeQuality{Status: q.Status, Codes: q.Codes, Message: q.Message}
+}
+
+// enrichmentToProto converts the pending enrichment of a vehicle into a protobuf one
+func enrichmentToProto(e *domain.Enrichment) *pb.Enrichment {
+	if e == nil {
+		return nil
+	}
+	return &pb.Enrichment{
+		Attempts: int32(e.Attempts), //nolint:gosec // bounded by the retries
+		RetryAt:  timestamppb.New(e.RetryAt),
+		Error:    e.Error,
+	}
+}
@@ -0,0 +1,234 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/alechekz/online-car-auction/pkg/vin"
+	vehiclegrpc "github.com/alechekz/online-car-auction/services/vehicle/delivery/grpc"
+	pb "github.com/alechekz/online-car-auction/services/vehicle/delivery/grpc/proto"
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/infrastructure"
+	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+)
+
+// newTestClient starts a gRPC server with in-memory dependencies and returns a client connected to it
+func newTestClient(t *testing.T) (pb.VehicleServiceClient, usecase.VehicleUsecase) {
+	repo := infrastructure.NewMemoryVehicleRepo()
+	inspectionProvider := &infrastructure.MockInspectionProvider{
+		Data: &domain.Vehicle{Brand: "Kia", Engine: "1.8L", Transmission: "Automatic"},
+	}
+	uc := usecase.NewVehicleUC(repo, inspectionProvider, &infrastructure.MockPricingProvider{}, 10)
+	bulkUc := usecase.NewVehiclesBulkUC(repo, uc)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterVehicleServiceServer(srv, vehiclegrpc.NewVehicleServer(uc, bulkUc))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewVehicleServiceClient(conn), uc
+}
+
+// newTestProtoVehicle is a test valid protobuf vehicle
+func newTestProtoVehicle(vin string) *pb.Vehicle {
+	return &pb.Vehicle{Vin: vin, Year: 2020, Odometer: 15000, ExteriorColor: "red"}
+}
+
+// TestVehicleServer_CRUD tests the unary methods of VehicleServer
+func TestVehicleServer_CRUD(t *testing.T) {
+	client, uc := newTestClient(t)
+	ctx := metadata.AppendToOutgoingContext(t.Context(), "x-actor", "alice")
+	vin := "1HGCM8267LA123456"
+
+	t.Run("create", func(t *testing.T) {
+		v, err := client.CreateVehicle(ctx, &pb.CreateVehicleRequest{Vehicle: newTestProtoVehicle(vin)})
+		assert.NoError(t, err)
+		assert.Equal(t, "Kia", v.Brand)
+		assert.Equal(t, int64(1), v.Version)
+
+		_, err = client.CreateVehicle(ctx, &pb.CreateVehicleRequest{Vehicle: newTestProtoVehicle(vin)})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		_, err = client.CreateVehicle(ctx, &pb.CreateVehicleRequest{Vehicle: newTestProtoVehicle("123")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		v, err := client.GetVehicle(ctx, &pb.GetVehicleRequest{Vin: vin})
+		assert.NoError(t, err)
+		assert.Equal(t, vin, v.Vin)
+
+		_, err = client.GetVehicle(ctx, &pb.GetVehicleRequest{Vin: "NONEXISTENTVIN12345"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		u := newTestProtoVehicle(vin)
+		u.Odometer = 20000
+		u.Version = 1
+		v, err := client.UpdateVehicle(ctx, &pb.UpdateVehicleRequest{Vehicle: u})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), v.Version)
+
+		_, err = client.UpdateVehicle(ctx, &pb.UpdateVehicleRequest{Vehicle: u})
+		assert.Equal(t, codes.Aborted, status.Code(err))
+
+		u.Version = 0
+		_, err = client.UpdateVehicle(ctx, &pb.UpdateVehicleRequest{Vehicle: u})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := client.DeleteVehicle(ctx, &pb.DeleteVehicleRequest{Vin: vin})
+		assert.NoError(t, err)
+
+		_, err = client.DeleteVehicle(ctx, &pb.DeleteVehicleRequest{Vin: vin})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("actor", func(t *testing.T) {
+		entries, err := uc.History(t.Context(), vin)
+		assert.NoError(t, err)
+		for _, e := range entries {
+			assert.Equal(t, "alice", e.Actor)
+		}
+	})
+}
+
+// TestVehicleServer_List tests the paginated and streaming listings of VehicleServer
+func TestVehicleServer_List(t *testing.T) {
+	client, _ := newTestClient(t)
+	for i := range 5 {
+		_, err := client.CreateVehicle(t.Context(), &pb.CreateVehicleRequest{Vehicle: newTestProtoVehicle(vin.WithCheckDigit(fmt.Sprintf("1HGCM82%dXLA123456", i)))})
+		assert.NoError(t, err)
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		var vins []string
+		req := &pb.ListVehiclesRequest{Limit: 2}
+		for {
+			res, err := client.ListVehicles(t.Context(), req)
+			assert.NoError(t, err)
+			for _, v := range res.Vehicles {
+				vins = append(vins, v.Vin)
+			}
+			if res.NextPageToken == "" {
+				break
+			}
+			req.PageToken = res.NextPageToken
+		}
+		assert.Len(t, vins, 5)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		_, err := client.ListVehicles(t.Context(), &pb.ListVehiclesRequest{Filter: &pb.VehicleFilter{Sort: "unknown"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		stream, err := client.StreamVehicles(t.Context(), &pb.StreamVehiclesRequest{Filter: &pb.VehicleFilter{Sort: "vin", Order: "desc"}})
+		assert.NoError(t, err)
+		var vins []string
+		for {
+			v, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			vins = append(vins, v.Vin)
+		}
+		assert.Equal(t, []string{"1HGCM8249LA123456", "1HGCM823XLA123456", "1HGCM8220LA123456", "1HGCM8211LA123456", "1HGCM8202LA123456"}, vins)
+	})
+}
+
+// TestVehicleServer_BulkCreateVehicles tests the client-streaming bulk creation of VehicleServer
+func TestVehicleServer_BulkCreateVehicles(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	t.Run("partial failure", func(t *testing.T) {
+		stream, err := client.BulkCreateVehicles(t.Context())
+		assert.NoError(t, err)
+		for i := range 150 {
+			assert.NoError(t, stream.Send(newTestProtoVehicle(vin.WithCheckDigit(fmt.Sprintf("1HGCM826XLA%06d", i)))))
+		}
+		assert.NoError(t, stream.Send(newTestProtoVehicle("123")))
+		res, err := stream.CloseAndRecv()
+		assert.NoError(t, err)
+		assert.Equal(t, int32(150), res.Succeeded)
+		assert.Equal(t, int32(1), res.Failed)
+		assert.Len(t, res.Results, 151)
+		assert.Equal(t, domain.BulkStatusFailed, res.Results[150].Status)
+	})
+
+	t.Run("empty stream", func(t *testing.T) {
+		stream, err := client.BulkCreateVehicles(t.Context())
+		assert.NoError(t, err)
+		_, err = stream.CloseAndRecv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestVehicleServer_Sale tests that the build data and the sale of vehicles are exposed and filtered on
+func TestVehicleServer_Sale(t *testing.T) {
+	client, uc := newTestClient(t)
+	vins := []string{"1HGCM8202LA123456", "1HGCM8211LA123456"}
+	for i, vin := range vins {
+		v := newTestProtoVehicle(vin)
+		v.Model = "Rio"
+		v.Doors = 4
+		v.BodyClass = []string{"Sedan/Saloon", "Hatchback"}[i]
+		v.FuelType = "Gasoline"
+		_, err := client.CreateVehicle(t.Context(), &pb.CreateVehicleRequest{Vehicle: v})
+		assert.NoError(t, err)
+	}
+
+	for _, to := range []string{domain.LifecycleInspected, domain.LifecyclePriced, domain.LifecycleListed} {
+		_, err := uc.Transition(t.Context(), vins[0], to, "seller")
+		assert.NoError(t, err)
+	}
+	_, err := uc.ListForSale(t.Context(), vins[0], 50_000, "seller")
+	assert.NoError(t, err)
+
+	res, err := client.ListVehicles(t.Context(), &pb.ListVehiclesRequest{
+		Filter: &pb.VehicleFilter{Lifecycle: domain.LifecycleListed, SaleStatus: domain.SaleAvailable},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Vehicles, 1) {
+		v := res.Vehicles[0]
+		assert.Equal(t, vins[0], v.Vin)
+		assert.Equal(t, domain.SaleAvailable, v.SaleStatus)
+		assert.Equal(t, uint64(50_000), v.BuyNowPrice)
+		assert.Equal(t, "Rio", v.Model)
+		assert.Equal(t, int32(4), v.Doors)
+	}
+
+	res, err = client.ListVehicles(t.Context(), &pb.ListVehiclesRequest{Filter: &pb.VehicleFilter{Lifecycle: domain.LifecycleDraft}})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Vehicles, 1) {
+		assert.Equal(t, vins[1], res.Vehicles[0].Vin)
+	}
+
+	res, err = client.ListVehicles(t.Context(), &pb.ListVehiclesRequest{Filter: &pb.VehicleFilter{BodyClass: "hatchback", FuelType: "gasoline"}})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Vehicles, 1) {
+		assert.Equal(t, vins[1], res.Vehicles[0].Vin)
+	}
+}
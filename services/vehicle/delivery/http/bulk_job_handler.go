@@ -0,0 +1,62 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+)
+
+// BulkJobHandler handles HTTP requests for asynchronous bulk vehicle operations
+type BulkJobHandler struct {
+	UC usecase.BulkJobUsecase
+}
+
+// POST /vehicles/bulk/jobs?operation=create|update
+func (h *BulkJobHandler) SubmitBulkJob(w http.ResponseWriter, r *http.Request) {
+	operation := r.URL.Query().Get("operation")
+	if operation == "" {
+		operation = domain.BulkJobCreate
+	}
+	var vb domain.VehiclesBulk
+	if err := json.NewDecoder(r.Body).Decode(&vb); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	setActor(&vb, actorOf(r))
+	job, err := h.UC.Submit(r.Context(), operation, &vb)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/vehicles/bulk/jobs/"+job.ID)
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(job)
+}
+
+// GET /vehicles/bulk/jobs/{id}
+func (h *BulkJobHandler) GetBulkJob(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/vehicles/bulk/jobs/")
+	job, err := h.UC.Get(r.Context(), id)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(job)
+}
+
+// DELETE /vehicles/bulk/jobs/{id}
+func (h *BulkJobHandler) CancelBulkJob(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/vehicles/bulk/jobs/")
+	job, err := h.UC.Cancel(r.Context(), id)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(job)
+}
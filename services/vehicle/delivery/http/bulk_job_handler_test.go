@@ -0,0 +1,74 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// TestBulkJobHandler_SubmitBulkJob tests the SubmitBulkJob and GetBulkJob HTTP handlers
+func TestBulkJobHandler_SubmitBulkJob(t *testing.T) {
+	router := NewTestRouter()
+
+	// Valid case
+	t.Run("valid request", func(t *testing.T) {
+		vb := domain.VehiclesBulk{
+			Vehicles: []*domain.Vehicle{
+				{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 15000},
+				{VIN: "123", Year: 2019, Odometer: 30000},
+			},
+		}
+		body, _ := json.Marshal(vb)
+
+		req := httptest.NewRequest(http.MethodPost, "/vehicles/bulk/jobs", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		location := rec.Header().Get("Location")
+		assert.NotEmpty(t, location)
+
+		// Poll the job until it is finished
+		var job domain.BulkJob
+		assert.Eventually(t, func() bool {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, location, nil))
+			_ = json.NewDecoder(rec.Body).Decode(&job)
+			return rec.Code == http.StatusOK && job.Status == domain.BulkJobCompleted
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 2, job.Processed)
+		assert.Equal(t, 1, job.Succeeded)
+		assert.Equal(t, 1, job.Failed)
+
+		// Finished job cannot be cancelled
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, location, nil))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	// Invalid cases
+	t.Run("unknown operation", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/vehicles/bulk/jobs?operation=delete", bytes.NewReader([]byte(`{"vehicles":[{"vin":"1HGCM8267LA123456"}]}`)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/vehicles/bulk/jobs/unknown", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
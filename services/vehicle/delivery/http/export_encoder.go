@@ -0,0 +1,138 @@
+package http
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// exportEncoder writes exported vehicles in a streaming format
+type exportEncoder interface {
+	Encode(v *domain.Vehicle) error
+	Flush() error
+	Close() error
+}
+
+// exportContentTypes maps export formats to response content types
+var exportContentTypes = map[string]string{
+	domain.ExportFormatCSV:    "text/csv",
+	domain.ExportFormatNDJSON: "application/x-ndjson",
+	domain.ExportFormatJSON:   "application/json",
+}
+
+// newExportEncoder creates an encoder of the format that writes the selected columns
+func newExportEncoder(w io.Writer, format string, columns []string) (exportEncoder, error) {
+	switch format {
+	case domain.ExportFormatNDJSON:
+		return &ndjsonEncoder{w: w, columns: columns}, nil
+	case domain.ExportFormatJSON:
+		_, err := io.WriteString(w, "[")
+		return &jsonArrayEncoder{ndjsonEncoder{w: w, columns: columns}, true}, err
+	default:
+		cw := csv.NewWriter(w)
+		return &csvEncoder{w: cw, columns: columns}, cw.Write(columns)
+	}
+}
+
+// csvEncoder writes vehicles as CSV rows under a header row
+type csvEncoder struct {
+	w       *csv.Writer
+	columns []string
+}
+
+// Encode writes the vehicle as a CSV row
+func (e *csvEncoder) Encode(v *domain.Vehicle) error {
+	record := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		record[i] = fmt.Sprint(v.FieldValue(c))
+	}
+	return e.w.Write(record)
+}
+
+// Flush writes the buffered rows
+func (e *csvEncoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// Close writes the remaining buffered rows
+func (e *csvEncoder) Close() error {
+	return e.Flush()
+}
+
+// ndjsonEncoder writes vehicles as JSON objects, one per line
+type ndjsonEncoder struct {
+	w       io.Writer
+	columns []string
+}
+
+// object encodes the selected columns of the vehicle as a JSON object keeping the column order
+func (e *ndjsonEncoder) object(v *domain.Vehicle) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, c := range e.columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(c)
+		value, err := json.Marshal(v.FieldValue(c))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Encode writes the vehicle as a JSON line
+func (e *ndjsonEncoder) Encode(v *domain.Vehicle) error {
+	b, err := e.object(v)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(append(b, '\n'))
+	return err
+}
+
+// Flush does nothing, lines are written as they come
+func (e *ndjsonEncoder) Flush() error {
+	return nil
+}
+
+// Close does nothing, lines are written as they come
+func (e *ndjsonEncoder) Close() error {
+	return nil
+}
+
+// jsonArrayEncoder writes vehicles as elements of a single JSON array
+type jsonArrayEncoder struct {
+	ndjsonEncoder
+	first bool
+}
+
+// Encode writes the vehicle as the next array element
+func (e *jsonArrayEncoder) Encode(v *domain.Vehicle) error {
+	b, err := e.object(v)
+	if err != nil {
+		return err
+	}
+	if !e.first {
+		b = append([]byte{','}, b...)
+	}
+	e.first = false
+	_, err = e.w.Write(b)
+	return err
+}
+
+// Close terminates the array
+func (e *jsonArrayEncoder) Close() error {
+	_, err := io.WriteString(e.w, "]\n")
+	return err
+}
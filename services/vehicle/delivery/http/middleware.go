@@ -41,10 +41,41 @@ func WriteError(w http.ResponseWriter, err error) {
 	case errors.Is(err, domain.ErrNotFound):
 		status = http.StatusNotFound
 		msg = err.Error()
+	case errors.Is(err, domain.ErrAlreadyExists), errors.Is(err, domain.ErrNotDeleted), errors.Is(err, domain.ErrJobFinished):
+		status = http.StatusConflict
+		msg = err.Error()
+	case errors.Is(err, domain.ErrJobNotFound), errors.Is(err, domain.ErrOfferNotFound):
+		status = http.StatusNotFound
+		msg = err.Error()
+	case errors.Is(err, domain.ErrNotForSale), errors.Is(err, domain.ErrSalePending), errors.Is(err, domain.ErrNoPendingSale), errors.Is(err, domain.ErrOfferClosed),
+		errors.Is(err, domain.ErrInvalidTransition), errors.Is(err, domain.ErrSaleClaimed):
+		status = http.StatusConflict
+		msg = err.Error()
+	case errors.Is(err, domain.ErrNotBuyer):
+		status = http.StatusForbidden
+		msg = err.Error()
+	case errors.Is(err, domain.ErrUnavailable):
+		status = http.StatusServiceUnavailable
+		msg = domain.ErrUnavailable.Error()
+	case errors.Is(err, domain.ErrVersionConflict):
+		status = http.StatusPreconditionFailed
+		msg = domain.ErrVersionConflict.Error()
+	case errors.Is(err, domain.ErrPreconditionRequired):
+		status = http.StatusPreconditionRequired
+		msg = err.Error()
+	}
+	body := map[string]any{"error": msg}
+	var conflict *domain.VersionConflictError
+	if errors.As(err, &conflict) {
+		body["conflicts"] = conflict.VINs
+	}
+	var transition *domain.InvalidTransitionError
+	if errors.As(err, &transition) {
+		body["allowed"] = domain.LifecycleTransitions(transition.From)
 	}
 
 	// Write response
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
-	_ = json.NewEncoder(w).Encode(map[string]string{"error": msg})
+	_ = json.NewEncoder(w).Encode(body)
 }
@@ -1,6 +1,9 @@
 package http
 
-import "net/http"
+import (
+	"net/http"
+	"strings"
+)
 
 // healthHandler provides a simple health check endpoint
 func healthHandler(w http.ResponseWriter, r *http.Request) {
@@ -23,13 +26,52 @@ func regVehiclesRoutes(mux *http.ServeMux, handler *VehicleHandler) {
 		}
 	})
 
-	// /vehicles/{vin} (GET, PUT, DELETE)
+	// /vehicles/export (GET)
+	mux.HandleFunc("/vehicles/export", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			handler.ExportVehicles(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// /vehicles/pending-enrichment (GET)
+	mux.HandleFunc("/vehicles/pending-enrichment", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			handler.ListPendingEnrichment(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// /vehicles/{vin} (GET, PUT, PATCH, DELETE), /vehicles/{vin}/history (GET), /vehicles/{vin}/restore (POST),
+	// /vehicles/{vin}/lifecycle (POST) and the sale routes below /vehicles/{vin}/
 	mux.HandleFunc("/vehicles/", func(w http.ResponseWriter, r *http.Request) {
+		if serveSaleRoutes(w, r, handler) {
+			return
+		}
 		switch r.Method {
+		case http.MethodPost:
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/restore"):
+				handler.RestoreVehicle(w, r)
+			case strings.HasSuffix(r.URL.Path, "/lifecycle"):
+				handler.TransitionVehicle(w, r)
+			default:
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			}
 		case http.MethodGet:
+			if strings.HasSuffix(r.URL.Path, "/history") {
+				handler.GetVehicleHistory(w, r)
+				return
+			}
 			handler.GetVehicle(w, r)
 		case http.MethodPut:
 			handler.UpdateVehicle(w, r)
+		case http.MethodPatch:
+			handler.PatchVehicle(w, r)
 		case http.MethodDelete:
 			handler.DeleteVehicle(w, r)
 		default:
@@ -38,6 +80,56 @@ func regVehiclesRoutes(mux *http.ServeMux, handler *VehicleHandler) {
 	})
 }
 
+// serveSaleRoutes serves the buy-now and offer routes of a vehicle, it reports whether the path is one of them
+func serveSaleRoutes(w http.ResponseWriter, r *http.Request, handler *VehicleHandler) bool {
+	_, rest, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/vehicles/"), "/")
+	parts := strings.Split(rest, "/")
+	switch {
+
+	// /vehicles/{vin}/offers (GET, POST)
+	case rest == "offers":
+		switch r.Method {
+		case http.MethodGet:
+			handler.ListOffers(w, r)
+		case http.MethodPost:
+			handler.MakeOffer(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+
+	// /vehicles/{vin}/sale, /vehicles/{vin}/sale/complete, /vehicles/{vin}/sale/cancel, /vehicles/{vin}/purchase,
+	// /vehicles/{vin}/offers/{id}/accept|reject|counter|accept-counter (POST)
+	case parts[0] == "sale" || parts[0] == "purchase" || parts[0] == "offers":
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return true
+		}
+		switch {
+		case rest == "sale":
+			handler.ListForSale(w, r)
+		case rest == "sale/complete":
+			handler.CompleteSale(w, r)
+		case rest == "sale/cancel":
+			handler.CancelSale(w, r)
+		case rest == "purchase":
+			handler.PurchaseVehicle(w, r)
+		case len(parts) == 3 && parts[2] == "accept":
+			handler.AcceptOffer(w, r)
+		case len(parts) == 3 && parts[2] == "reject":
+			handler.RejectOffer(w, r)
+		case len(parts) == 3 && parts[2] == "counter":
+			handler.CounterOffer(w, r)
+		case len(parts) == 3 && parts[2] == "accept-counter":
+			handler.AcceptCounterOffer(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
 // regBulkRoutes registers bulk vehicle routes
 func regBulkRoutes(mux *http.ServeMux, handler *VehiclesBulkHandler) {
 
@@ -54,8 +146,48 @@ func regBulkRoutes(mux *http.ServeMux, handler *VehiclesBulkHandler) {
 	})
 }
 
+// regBulkJobRoutes registers asynchronous bulk job routes
+func regBulkJobRoutes(mux *http.ServeMux, handler *BulkJobHandler) {
+
+	// vehicles/bulk/jobs (POST)
+	mux.HandleFunc("/vehicles/bulk/jobs", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			handler.SubmitBulkJob(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// vehicles/bulk/jobs/{id} (GET, DELETE)
+	mux.HandleFunc("/vehicles/bulk/jobs/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			handler.GetBulkJob(w, r)
+		case http.MethodDelete:
+			handler.CancelBulkJob(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// regImportRoutes registers CSV import routes
+func regImportRoutes(mux *http.ServeMux, handler *VehicleImportHandler) {
+
+	// vehicles/import (POST)
+	mux.HandleFunc("/vehicles/import", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			handler.ImportVehicles(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
 // NewRouter sets up the HTTP routes for vehicle operations
-func NewRouter(handler *VehicleHandler, bulkHandler *VehiclesBulkHandler) http.Handler {
+func NewRouter(handler *VehicleHandler, bulkHandler *VehiclesBulkHandler, jobHandler *BulkJobHandler, importHandler *VehicleImportHandler) http.Handler {
 
 	// Create a new ServeMux
 	mux := http.NewServeMux()
@@ -69,6 +201,12 @@ func NewRouter(handler *VehicleHandler, bulkHandler *VehiclesBulkHandler) http.H
 	// Register bulk vehicle routes
 	regBulkRoutes(mux, bulkHandler)
 
+	// Register asynchronous bulk job routes
+	regBulkJobRoutes(mux, jobHandler)
+
+	// Register CSV import routes
+	regImportRoutes(mux, importHandler)
+
 	// Wrap with logging middleware and exit
 	return LoggingMiddleware(mux)
 }
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -28,18 +29,21 @@ func NewTestRouter() http.Handler {
 	repo := infrastructure.NewMemoryVehicleRepo()
 	inspectionProvider := &infrastructure.MockInspectionProvider{
 		Data: &domain.Vehicle{
-			VIN:          "1HGCM82633A004352",
+			VIN:          "1HGCM8263LA004352",
 			Brand:        "Kia",
 			Engine:       "1.8L",
 			Transmission: "Automatic",
 		},
 	}
 	pricingProvider := &infrastructure.MockPricingProvider{}
-	uc := usecase.NewVehicleUC(repo, inspectionProvider, pricingProvider)
+	uc := usecase.NewVehicleUC(repo, inspectionProvider, pricingProvider, 10)
 	handler := &vehiclehttp.VehicleHandler{UC: uc}
 	bulkUc := usecase.NewVehiclesBulkUC(repo, uc)
 	bulkHandler := &vehiclehttp.VehiclesBulkHandler{UC: bulkUc}
-	return vehiclehttp.NewRouter(handler, bulkHandler)
+	jobUc := usecase.NewBulkJobUC(infrastructure.NewMemoryBulkJobRepo(), bulkUc, time.Minute)
+	jobHandler := &vehiclehttp.BulkJobHandler{UC: jobUc}
+	importHandler := &vehiclehttp.VehicleImportHandler{UC: usecase.NewVehicleImportUC(repo, bulkUc)}
+	return vehiclehttp.NewRouter(handler, bulkHandler, jobHandler, importHandler)
 }
 
 // TestVehicleHandler_CreateVehicle tests the CreateVehicle HTTP handler
@@ -49,7 +53,7 @@ func TestVehicleHandler_CreateVehicle(t *testing.T) {
 	// Valid case
 	t.Run("valid request", func(t *testing.T) {
 		v := domain.Vehicle{
-			VIN:      "1HGCM82633A123456",
+			VIN:      "1HGCM8267LA123456",
 			Year:     2020,
 			Odometer: 15000,
 			MSRP:     25000,
@@ -83,7 +87,7 @@ func TestVehicleHandler_GetVehicle(t *testing.T) {
 	// Prepare router with a vehicle
 	router := NewTestRouter()
 	v := domain.Vehicle{
-		VIN:      "1HGCM82633A123456",
+		VIN:      "1HGCM8267LA123456",
 		Year:     2020,
 		Odometer: 15000,
 		MSRP:     25000,
@@ -103,6 +107,7 @@ func TestVehicleHandler_GetVehicle(t *testing.T) {
 		router.ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"1"`, rec.Header().Get("ETag"))
 
 		var got domain.Vehicle
 		err := json.Unmarshal(rec.Body.Bytes(), &got)
@@ -127,7 +132,7 @@ func TestVehicleHandler_UpdateVehicle(t *testing.T) {
 	// Prepare router with a vehicle
 	router := NewTestRouter()
 	v := domain.Vehicle{
-		VIN:      "1HGCM82633A123456",
+		VIN:      "1HGCM8267LA123456",
 		Year:     2020,
 		Odometer: 15000,
 		MSRP:     25000,
@@ -138,8 +143,10 @@ func TestVehicleHandler_UpdateVehicle(t *testing.T) {
 	rec := httptest.NewRecorder()
 	router.ServeHTTP(rec, req)
 	assert.Equal(t, http.StatusCreated, rec.Code)
+	tag := rec.Header().Get("ETag")
+	assert.Equal(t, `"1"`, tag)
 	updated := domain.Vehicle{
-		Year:     2021,
+		Year:     2020,
 		Odometer: 20000,
 		MSRP:     27000,
 	}
@@ -149,23 +156,47 @@ func TestVehicleHandler_UpdateVehicle(t *testing.T) {
 	t.Run("update existing vehicle", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPut, "/vehicles/"+v.VIN, bytes.NewReader(body))
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", tag)
 		rec := httptest.NewRecorder()
 
 		router.ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
 
 		var got domain.Vehicle
 		err := json.Unmarshal(rec.Body.Bytes(), &got)
 		assert.NoError(t, err)
-		assert.Equal(t, int32(2021), got.Year)
+		assert.Equal(t, int32(2020), got.Year)
 		assert.Equal(t, int32(20000), got.Odometer)
 	})
 
-	// Invalid case
+	// Invalid cases
+	t.Run("update with stale etag", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/vehicles/"+v.VIN, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", tag)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	})
+
+	t.Run("update without if-match", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/vehicles/"+v.VIN, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
 	t.Run("update non-existing vehicle", func(t *testing.T) {
-		req := httptest.NewRequest(http.MethodPut, "/vehicles/NONEXISTENTVIN123", bytes.NewReader(body))
+		req := httptest.NewRequest(http.MethodPut, "/vehicles/1HGCM8268LA999999", bytes.NewReader(body))
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", "*")
 		rec := httptest.NewRecorder()
 
 		router.ServeHTTP(rec, req)
@@ -180,7 +211,7 @@ func TestVehicleHandler_DeleteVehicle(t *testing.T) {
 	// Prepare router with a vehicle
 	router := NewTestRouter()
 	v := domain.Vehicle{
-		VIN:      "1HGCM82633A123456",
+		VIN:      "1HGCM8267LA123456",
 		Year:     2020,
 		Odometer: 15000,
 		MSRP:     25000,
@@ -200,6 +231,29 @@ func TestVehicleHandler_DeleteVehicle(t *testing.T) {
 		router.ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		// Deleted vehicle is hidden
+		req = httptest.NewRequest(http.MethodGet, "/vehicles/"+v.VIN, nil)
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	// Restore case
+	t.Run("restore deleted vehicle", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/vehicles/"+v.VIN+"/restore", nil)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+
+		// Restored vehicle is not deleted
+		req = httptest.NewRequest(http.MethodPost, "/vehicles/"+v.VIN+"/restore", nil)
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusConflict, rec.Code)
 	})
 
 	// Invalid case
@@ -219,13 +273,14 @@ func TestVehicleHandler_ListVehicles(t *testing.T) {
 	// Prepare router with vehicles
 	router := NewTestRouter()
 	v1 := domain.Vehicle{
-		VIN:      "VIN12345678901234",
+		VIN:      "1FTFW1E59LFA12345",
 		Year:     2020,
 		Odometer: 10000,
 		MSRP:     20000,
 	}
+	v1.BodyClass = "Pickup"
 	v2 := domain.Vehicle{
-		VIN:      "VIN23456789012345",
+		VIN:      "2FMDK3GC6MBA23456",
 		Year:     2021,
 		Odometer: 5000,
 		MSRP:     22000,
@@ -248,16 +303,74 @@ func TestVehicleHandler_ListVehicles(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var got []domain.Vehicle
+		var got domain.VehiclesPage
 		err := json.Unmarshal(rec.Body.Bytes(), &got)
 		assert.NoError(t, err)
-		assert.Len(t, got, 2)
+		assert.Len(t, got.Vehicles, 2)
 
-		vins := []string{got[0].VIN, got[1].VIN}
+		vins := []string{got.Vehicles[0].VIN, got.Vehicles[1].VIN}
 		assert.Contains(t, vins, v1.VIN)
 		assert.Contains(t, vins, v2.VIN)
 	})
 
+	// Build data filter case
+	t.Run("list by body class", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/vehicles?body_class=pickup", nil)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var got domain.VehiclesPage
+		err := json.Unmarshal(rec.Body.Bytes(), &got)
+		assert.NoError(t, err)
+		if assert.Len(t, got.Vehicles, 1) {
+			assert.Equal(t, v1.VIN, got.Vehicles[0].VIN)
+		}
+	})
+
+	// Filtered and paginated list case
+	t.Run("list with filters and pagination", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/vehicles?year_min=2020&sort=year&order=desc&limit=1", nil)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var got domain.VehiclesPage
+		err := json.Unmarshal(rec.Body.Bytes(), &got)
+		assert.NoError(t, err)
+		assert.Len(t, got.Vehicles, 1)
+		assert.Equal(t, v2.VIN, got.Vehicles[0].VIN)
+		assert.NotEmpty(t, got.NextPageToken)
+
+		// Fetch the next page
+		req = httptest.NewRequest(http.MethodGet, "/vehicles?year_min=2020&sort=year&order=desc&limit=1&page_token="+got.NextPageToken, nil)
+		rec = httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		got = domain.VehiclesPage{}
+		err = json.Unmarshal(rec.Body.Bytes(), &got)
+		assert.NoError(t, err)
+		assert.Len(t, got.Vehicles, 1)
+		assert.Equal(t, v1.VIN, got.Vehicles[0].VIN)
+		assert.Empty(t, got.NextPageToken)
+	})
+
+	// Invalid query case
+	t.Run("invalid query parameters", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/vehicles?year_min=abc", nil)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
 	// Empty list case
 	t.Run("list empty vehicles", func(t *testing.T) {
 		router := NewTestRouter()
@@ -268,9 +381,197 @@ func TestVehicleHandler_ListVehicles(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var got []domain.Vehicle
+		var got domain.VehiclesPage
 		err := json.Unmarshal(rec.Body.Bytes(), &got)
 		assert.NoError(t, err)
-		assert.Len(t, got, 0)
+		assert.Len(t, got.Vehicles, 0)
+	})
+}
+
+// TestVehicleHandler_ExportVehicles tests the ExportVehicles HTTP handler
+func TestVehicleHandler_ExportVehicles(t *testing.T) {
+
+	// Prepare router with vehicles
+	router := NewTestRouter()
+	for i, vin := range []string{"1HGCM8269LA000001", "1HGCM8269MA000002"} {
+		v := domain.Vehicle{VIN: vin, Year: int32(2020 + i), Odometer: 10000} // nolint:gosec
+		body, _ := json.Marshal(v)
+		req := httptest.NewRequest(http.MethodPost, "/vehicles", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	}
+
+	// Export in each format
+	tests := []struct {
+		name        string
+		query       string
+		contentType string
+		expected    string
+	}{
+		{
+			name:        "csv",
+			query:       "format=csv&columns=vin,year",
+			contentType: "text/csv",
+			expected:    "vin,year\n1HGCM8269LA000001,2020\n1HGCM8269MA000002,2021\n",
+		},
+		{
+			name:        "ndjson",
+			query:       "format=ndjson&columns=year,vin&year_min=2021",
+			contentType: "application/x-ndjson",
+			expected:    `{"year":2021,"vin":"1HGCM8269MA000002"}` + "\n",
+		},
+		{
+			name:        "json",
+			query:       "format=json&columns=vin&order=desc",
+			contentType: "application/json",
+			expected:    `[{"vin":"1HGCM8269MA000002"},{"vin":"1HGCM8269LA000001"}]` + "\n",
+		},
+		{
+			name:        "empty json",
+			query:       "format=json&columns=vin&brand=Tesla",
+			contentType: "application/json",
+			expected:    "[]\n",
+		},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/vehicles/export?"+test.query, nil)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, test.contentType, rec.Header().Get("Content-Type"))
+			assert.Equal(t, test.expected, rec.Body.String())
+		})
+	}
+
+	// Invalid case
+	t.Run("unknown column", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/vehicles/export?columns=owner", nil)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// TestVehicleHandler_GetVehicleHistory tests the GetVehicleHistory HTTP handler
+func TestVehicleHandler_GetVehicleHistory(t *testing.T) {
+
+	// Prepare router with a created and deleted vehicle
+	router := NewTestRouter()
+	body, _ := json.Marshal(domain.Vehicle{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 15000})
+	req := httptest.NewRequest(http.MethodPost, "/vehicles", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "dealer-42")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, "/vehicles/1HGCM8267LA123456", nil)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	// Timeline of the vehicle
+	t.Run("existing history", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/vehicles/1HGCM8267LA123456/history", nil)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got []*domain.HistoryEntry
+		err := json.NewDecoder(rec.Body).Decode(&got)
+		assert.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.Equal(t, "dealer-42", got[0].Actor)
+		assert.Equal(t, domain.HistoryDeleted, got[1].Action)
+		assert.Equal(t, domain.SystemActor, got[1].Actor)
+	})
+
+	// Unknown vehicle
+	t.Run("unknown vehicle", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/vehicles/1HGCM8268LA99999945/history", nil)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+// TestVehicleHandler_PatchVehicle tests the PatchVehicle HTTP handler
+func TestVehicleHandler_PatchVehicle(t *testing.T) {
+
+	// Prepare router with a vehicle
+	router := NewTestRouter()
+	v := domain.Vehicle{
+		VIN:           "1HGCM8267LA123456",
+		Year:          2020,
+		Odometer:      15000,
+		ExteriorColor: "Red",
+	}
+	body, _ := json.Marshal(v)
+	req := httptest.NewRequest(http.MethodPost, "/vehicles", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	// Valid case
+	t.Run("patch existing vehicle", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, "/vehicles/"+v.VIN, bytes.NewReader([]byte(`{"interiorColor":"Black"}`)))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var got domain.PatchResult
+		err := json.Unmarshal(rec.Body.Bytes(), &got)
+		assert.NoError(t, err)
+		assert.Contains(t, got.Changed, "interiorColor")
+		assert.Equal(t, "Black", got.Vehicle.InteriorColor)
+		assert.Equal(t, "Red", got.Vehicle.ExteriorColor)
+		assert.Equal(t, int32(15000), got.Vehicle.Odometer)
+	})
+
+	// Invalid cases
+	t.Run("unsupported media type", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, "/vehicles/"+v.VIN, bytes.NewReader([]byte(`{"odometer":1}`)))
+		req.Header.Set("Content-Type", "text/plain")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+	})
+
+	t.Run("read-only field", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, "/vehicles/"+v.VIN, bytes.NewReader([]byte(`{"price":1}`)))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("patch non-existing vehicle", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, "/vehicles/1HGCM8268LA999999", bytes.NewReader([]byte(`{"odometer":1}`)))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
@@ -0,0 +1,29 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// GET /vehicles/pending-enrichment?limit=50
+func (h *VehicleHandler) ListPendingEnrichment(w http.ResponseWriter, r *http.Request) {
+	limit := domain.DefaultPageSize
+	if s := r.URL.Query().Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			WriteError(w, domain.ErrValidation)
+			return
+		}
+		limit = n
+	}
+	vehicles, err := h.UC.PendingEnrichment(r.Context(), limit)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(vehicles)
+}
@@ -0,0 +1,66 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	vehiclehttp "github.com/alechekz/online-car-auction/services/vehicle/delivery/http"
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/infrastructure"
+	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+)
+
+// TestVehicleHandler_PendingEnrichment tests creating vehicles while the pricing service is down and listing them
+func TestVehicleHandler_PendingEnrichment(t *testing.T) {
+
+	// Prepare router with the pricing service down
+	pricing := &infrastructure.MockPricingProvider{Err: fmt.Errorf("%w: pricing is down", domain.ErrUnavailable)}
+	inspection := &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{Brand: "Kia"}}
+	uc := usecase.NewVehicleUC(infrastructure.NewMemoryVehicleRepo(), inspection, pricing, 10)
+	handler := &vehiclehttp.VehicleHandler{UC: uc}
+	bulkUc := usecase.NewVehiclesBulkUC(infrastructure.NewMemoryVehicleRepo(), uc)
+	router := vehiclehttp.NewRouter(handler, &vehiclehttp.VehiclesBulkHandler{UC: bulkUc}, nil, nil)
+
+	t.Run("created pending enrichment", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, "/vehicles", `{"vin":"1HGCM8267LA123456","year":2020,"odometer":15000}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		rec = serveSale(router, http.MethodGet, "/vehicles/1HGCM8267LA123456", "")
+		var v domain.Vehicle
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&v))
+		assert.Equal(t, "Kia", v.Brand, "the build data was fetched before pricing failed")
+		assert.Equal(t, 1, v.Enrichment.Attempts)
+	})
+
+	t.Run("list pending", func(t *testing.T) {
+		rec := serveSale(router, http.MethodGet, "/vehicles/pending-enrichment", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var vehicles []*domain.Vehicle
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&vehicles))
+		assert.Len(t, vehicles, 1)
+
+		rec = serveSale(router, http.MethodGet, "/vehicles/pending-enrichment?limit=abc", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = serveSale(router, http.MethodPost, "/vehicles/pending-enrichment", "")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("enrichment is read-only", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPatch, "/vehicles/1HGCM8267LA123456", `{"enrichment":null}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("updates need the dependencies", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/vehicles/1HGCM8267LA123456", strings.NewReader(`{"vin":"1HGCM8267LA123456","year":2020,"odometer":15000}`))
+		req.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+}
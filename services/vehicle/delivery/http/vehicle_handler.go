@@ -2,9 +2,14 @@ package http
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
 	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -13,6 +18,33 @@ type VehicleHandler struct {
 	UC usecase.VehicleUsecase
 }
 
+// etag formats the vehicle version as an entity tag
+func etag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseIfMatch parses the If-Match header into the expected vehicle version, zero stands for "*"
+func parseIfMatch(header string) (int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "*" {
+		return 0, nil
+	}
+	tag, err := strconv.Unquote(header)
+	if err != nil {
+		return 0, domain.ErrValidation
+	}
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version < 1 {
+		return 0, domain.ErrValidation
+	}
+	return version, nil
+}
+
+// actorOf returns the actor of the request recorded in the vehicle history
+func actorOf(r *http.Request) string {
+	return strings.TrimSpace(r.Header.Get("X-Actor"))
+}
+
 // POST /vehicles
 func (h *VehicleHandler) CreateVehicle(w http.ResponseWriter, r *http.Request) {
 	var v domain.Vehicle
@@ -20,10 +52,12 @@ func (h *VehicleHandler) CreateVehicle(w http.ResponseWriter, r *http.Request) {
 		WriteError(w, domain.ErrValidation)
 		return
 	}
-	if err := h.UC.Create(&v); err != nil {
+	v.Actor = actorOf(r)
+	if err := h.UC.Create(r.Context(), &v); err != nil {
 		WriteError(w, err)
 		return
 	}
+	w.Header().Set("ETag", etag(v.Version))
 	w.WriteHeader(http.StatusCreated)
 	_ = json.NewEncoder(w).Encode(v)
 }
@@ -31,35 +65,104 @@ func (h *VehicleHandler) CreateVehicle(w http.ResponseWriter, r *http.Request) {
 // GET /vehicles/{vin}
 func (h *VehicleHandler) GetVehicle(w http.ResponseWriter, r *http.Request) {
 	vin := strings.TrimPrefix(r.URL.Path, "/vehicles/")
-	v, err := h.UC.Get(vin)
+	v, err := h.UC.Get(r.Context(), vin)
 	if err != nil {
 		WriteError(w, err)
 		return
 	}
+	w.Header().Set("ETag", etag(v.Version))
 	_ = json.NewEncoder(w).Encode(v)
 }
 
 // PUT /vehicles/{vin}
 func (h *VehicleHandler) UpdateVehicle(w http.ResponseWriter, r *http.Request) {
 	vin := strings.TrimPrefix(r.URL.Path, "/vehicles/")
+	if r.Header.Get("If-Match") == "" {
+		WriteError(w, domain.ErrPreconditionRequired)
+		return
+	}
+	version, err := parseIfMatch(r.Header.Get("If-Match"))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
 	var v domain.Vehicle
 	if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
 		WriteError(w, domain.ErrValidation)
 		return
 	}
 	v.VIN = vin
-	if err := h.UC.Update(&v); err != nil {
+	v.Version = version
+	v.Actor = actorOf(r)
+	if err := h.UC.Update(r.Context(), &v); err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("ETag", etag(v.Version))
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// GET /vehicles/{vin}/history
+func (h *VehicleHandler) GetVehicleHistory(w http.ResponseWriter, r *http.Request) {
+	vin := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/vehicles/"), "/history")
+	entries, err := h.UC.History(r.Context(), vin)
+	if err != nil {
 		WriteError(w, err)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(entries)
+}
+
+// POST /vehicles/{vin}/restore
+func (h *VehicleHandler) RestoreVehicle(w http.ResponseWriter, r *http.Request) {
+	vin := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/vehicles/"), "/restore")
+	v, err := h.UC.Restore(r.Context(), vin, actorOf(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("ETag", etag(v.Version))
+	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(v)
 }
 
+// PATCH /vehicles/{vin}
+func (h *VehicleHandler) PatchVehicle(w http.ResponseWriter, r *http.Request) {
+	vin := strings.TrimPrefix(r.URL.Path, "/vehicles/")
+	mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mt != "application/merge-patch+json" && mt != "application/json" {
+		http.Error(w, "unsupported media type", http.StatusUnsupportedMediaType)
+		return
+	}
+	var version int64
+	if header := r.Header.Get("If-Match"); header != "" {
+		var err error
+		if version, err = parseIfMatch(header); err != nil {
+			WriteError(w, err)
+			return
+		}
+	}
+	patch, err := io.ReadAll(r.Body)
+	if err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	res, err := h.UC.Patch(r.Context(), vin, version, actorOf(r), patch)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("ETag", etag(res.Vehicle.Version))
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
 // DELETE /vehicles/{vin}
 func (h *VehicleHandler) DeleteVehicle(w http.ResponseWriter, r *http.Request) {
 	vin := strings.TrimPrefix(r.URL.Path, "/vehicles/")
-	if err := h.UC.Delete(vin); err != nil {
+	if err := h.UC.Delete(r.Context(), vin, actorOf(r)); err != nil {
 		WriteError(w, err)
 		return
 	}
@@ -67,12 +170,162 @@ func (h *VehicleHandler) DeleteVehicle(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(map[string]string{"status": "deleted", "vin": vin})
 }
 
+// parseVehicleQuery parses the listing query parameters of the request
+func parseVehicleQuery(values url.Values) (*domain.VehicleQuery, error) {
+	q := domain.NewVehicleQuery()
+	q.Brand = values.Get("brand")
+	q.BodyClass = values.Get("body_class")
+	q.FuelType = values.Get("fuel_type")
+	q.PageToken = values.Get("page_token")
+	q.Status = values.Get("status")
+	q.Lifecycle = values.Get("lifecycle")
+	if s := values.Get("sort"); s != "" {
+		q.SortBy = s
+	}
+	if o := values.Get("order"); o != "" {
+		q.Order = o
+	}
+	for _, c := range values["color"] {
+		for _, color := range strings.Split(c, ",") {
+			if color = strings.TrimSpace(color); color != "" {
+				q.Colors = append(q.Colors, color)
+			}
+		}
+	}
+
+	// Numeric parameters
+	int32Params := map[string]*int32{
+		"year_min":     &q.YearFrom,
+		"year_max":     &q.YearTo,
+		"odometer_min": &q.OdometerFrom,
+		"odometer_max": &q.OdometerTo,
+	}
+	for name, dst := range int32Params {
+		if s := values.Get(name); s != "" {
+			n, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				return nil, domain.ErrValidation
+			}
+			*dst = int32(n)
+		}
+	}
+	intParams := map[string]*int{
+		"grade_min": &q.GradeFrom,
+		"grade_max": &q.GradeTo,
+		"limit":     &q.Limit,
+	}
+	for name, dst := range intParams {
+		if s := values.Get(name); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, domain.ErrValidation
+			}
+			*dst = n
+		}
+	}
+	uint64Params := map[string]*uint64{
+		"price_min": &q.PriceFrom,
+		"price_max": &q.PriceTo,
+	}
+	for name, dst := range uint64Params {
+		if s := values.Get(name); s != "" {
+			n, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return nil, domain.ErrValidation
+			}
+			*dst = n
+		}
+	}
+	return q, nil
+}
+
 // GET /vehicles
 func (h *VehicleHandler) ListVehicles(w http.ResponseWriter, r *http.Request) {
-	vehicles, err := h.UC.List()
+	q, err := parseVehicleQuery(r.URL.Query())
 	if err != nil {
 		WriteError(w, err)
 		return
 	}
-	_ = json.NewEncoder(w).Encode(vehicles)
+	page, err := h.UC.List(r.Context(), q)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(page)
+}
+
+// parseVehicleExport parses the export format and columns on top of the listing filters
+func parseVehicleExport(values url.Values) (*domain.VehicleExport, error) {
+	q, err := parseVehicleQuery(values)
+	if err != nil {
+		return nil, err
+	}
+	e := domain.NewVehicleExport(q)
+	if f := values.Get("format"); f != "" {
+		e.Format = f
+	}
+	var columns []string
+	for _, c := range values["columns"] {
+		for _, col := range strings.Split(c, ",") {
+			if col = strings.TrimSpace(col); col != "" {
+				columns = append(columns, col)
+			}
+		}
+	}
+	if len(columns) > 0 {
+		e.Columns = columns
+	}
+	return e, nil
+}
+
+// GET /vehicles/export?format=csv|ndjson|json&columns=vin,year&<listing filters>
+func (h *VehicleHandler) ExportVehicles(w http.ResponseWriter, r *http.Request) {
+	e, err := parseVehicleExport(r.URL.Query())
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	// Start the response lazily, so errors before the first row are still reported with a status
+	var enc exportEncoder
+	start := func() error {
+		w.Header().Set("Content-Type", exportContentTypes[e.Format])
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="vehicles.%s"`, e.Format))
+		enc, err = newExportEncoder(w, e.Format, e.Columns)
+		return err
+	}
+	flusher, _ := w.(http.Flusher)
+	rows := 0
+	err = h.UC.Export(r.Context(), e, func(v *domain.Vehicle) error {
+		if enc == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		if rows++; rows%500 == 0 && flusher != nil {
+			if err := enc.Flush(); err != nil {
+				return err
+			}
+			flusher.Flush()
+		}
+		return nil
+	})
+
+	// Report errors before the first row, later ones can only cut the stream
+	if err != nil {
+		if enc == nil {
+			WriteError(w, err)
+		}
+		return
+	}
+	if enc == nil {
+		if err := start(); err != nil {
+			return
+		}
+	}
+	_ = enc.Close()
 }
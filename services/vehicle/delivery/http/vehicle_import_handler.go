@@ -0,0 +1,78 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+)
+
+// VehicleImportHandler handles HTTP requests for CSV vehicle imports
+type VehicleImportHandler struct {
+	UC usecase.VehicleImportUsecase
+}
+
+// parseColumnMapping reads map.<field>=<column> query parameters into a column mapping
+func parseColumnMapping(query url.Values) domain.ColumnMapping {
+	mapping := domain.ColumnMapping{}
+	for key, values := range query {
+		if field, ok := strings.CutPrefix(key, "map."); ok && len(values) > 0 {
+			mapping[field] = values[0]
+		}
+	}
+	return mapping
+}
+
+// csvBody returns the CSV stream of the request, either the raw body or the "file" part of a multipart form
+func csvBody(r *http.Request) (io.Reader, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		return r.Body, nil
+	}
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, domain.ErrValidation
+	}
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			return nil, domain.ErrValidation
+		}
+		if part.FormName() == "file" {
+			return part, nil
+		}
+	}
+}
+
+// POST /vehicles/import?operation=create|update&report=json|csv&map.<field>=<column>
+func (h *VehicleImportHandler) ImportVehicles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	body, err := csvBody(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	res, err := h.UC.Import(r.Context(), body, query.Get("operation"), actorOf(r), parseColumnMapping(query))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("X-Imported-Count", strconv.Itoa(res.Imported))
+	w.Header().Set("X-Rejected-Count", strconv.Itoa(res.Rejected))
+
+	// Rejected rows as a downloadable CSV report
+	if query.Get("report") == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="import-rejected.csv"`)
+		_ = res.WriteReport(w)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,69 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// TestVehicleImportHandler_ImportVehicles tests the ImportVehicles HTTP handler
+func TestVehicleImportHandler_ImportVehicles(t *testing.T) {
+	router := NewTestRouter()
+	data := "Stock VIN,Model Year\n1HGCM8267LA123456,2020\n123,2020\n"
+
+	// Raw CSV body with a JSON summary
+	t.Run("raw csv", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/vehicles/import?map.vin=Stock+VIN&map.year=Model+Year", strings.NewReader(data))
+		req.Header.Set("Content-Type", "text/csv")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var got domain.ImportResult
+		err := json.NewDecoder(rec.Body).Decode(&got)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.Imported)
+		assert.Equal(t, 1, got.Rejected)
+		assert.Equal(t, 3, got.RejectedRows[0].Line)
+	})
+
+	// Multipart upload with a CSV report
+	t.Run("multipart csv report", func(t *testing.T) {
+		var body bytes.Buffer
+		mw := multipart.NewWriter(&body)
+		fw, _ := mw.CreateFormFile("file", "inventory.csv")
+		_, _ = fw.Write([]byte("vin,year\n2HGCM8268KA654321,2019\n123,2020\n"))
+		_ = mw.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/vehicles/import?report=csv", &body)
+		req.Header.Set("Content-Type", mw.FormDataContentType())
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "1", rec.Header().Get("X-Imported-Count"))
+		assert.True(t, strings.HasPrefix(rec.Body.String(), "vin,year,line,error\n123,2020,3,"))
+	})
+
+	// Invalid case
+	t.Run("missing vin column", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/vehicles/import", strings.NewReader(data))
+		req.Header.Set("Content-Type", "text/csv")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
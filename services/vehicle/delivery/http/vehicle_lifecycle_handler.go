@@ -0,0 +1,30 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// transitionRequest is the body of a lifecycle transition
+type transitionRequest struct {
+	Lifecycle string `json:"lifecycle"`
+}
+
+// POST /vehicles/{vin}/lifecycle
+func (h *VehicleHandler) TransitionVehicle(w http.ResponseWriter, r *http.Request) {
+	vin := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/vehicles/"), "/lifecycle")
+	var req transitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, domain.ErrValidation)
+		return
+	}
+	v, err := h.UC.Transition(r.Context(), vin, req.Lifecycle, actorOf(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	writeVehicle(w, v)
+}
@@ -0,0 +1,61 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// TestVehicleHandler_Transition tests the lifecycle transition handler
+func TestVehicleHandler_Transition(t *testing.T) {
+
+	// Prepare router with a created vehicle
+	router := NewTestRouter()
+	const path = "/vehicles/1HGCM8267LA123456/lifecycle"
+	rec := serveSale(router, http.MethodPost, "/vehicles", `{"vin":"1HGCM8267LA123456","year":2020,"odometer":15000}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	t.Run("valid transition", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, path, `{"lifecycle":"inspected"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var v domain.Vehicle
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&v))
+		assert.Equal(t, domain.LifecycleInspected, v.Lifecycle)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+	})
+
+	t.Run("invalid transition", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, path, `{"lifecycle":"sold"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		var body struct {
+			Allowed []string `json:"allowed"`
+		}
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, []string{domain.LifecyclePriced, domain.LifecycleWithdrawn}, body.Allowed)
+
+		rec = serveSale(router, http.MethodPost, path, `{"lifecycle":"auctioned"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("filter listings", func(t *testing.T) {
+		rec := serveSale(router, http.MethodGet, "/vehicles?lifecycle=inspected", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var page domain.VehiclesPage
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&page))
+		assert.Len(t, page.Vehicles, 1)
+
+		rec = serveSale(router, http.MethodGet, "/vehicles?lifecycle=unknown", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("routing", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, "/vehicles/NONEXISTENTVIN12345/lifecycle", `{"lifecycle":"inspected"}`)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = serveSale(router, http.MethodPut, path, `{"lifecycle":"priced"}`)
+		assert.NotEqual(t, http.StatusOK, rec.Code)
+	})
+}
@@ -0,0 +1,206 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// saleRequest is the body of the sale requests, each request uses only some of the fields
+type saleRequest struct {
+	BuyNowPrice uint64 `json:"buy_now_price"`
+	Buyer       string `json:"buyer"`
+	Amount      uint64 `json:"amount"`
+}
+
+// salePath returns the VIN and the offer ID of a sale request path, the ID is zero outside of a single offer
+func salePath(r *http.Request) (string, int64, error) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/vehicles/"), "/")
+	if len(parts) < 4 {
+		return parts[0], 0, nil
+	}
+	id, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil || id < 1 {
+		return "", 0, domain.ErrOfferNotFound
+	}
+	return parts[0], id, nil
+}
+
+// decodeSale parses the sale request path and the optional body
+func decodeSale(r *http.Request) (string, int64, *saleRequest, error) {
+	vin, id, err := salePath(r)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	var req saleRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return "", 0, nil, domain.ErrValidation
+		}
+	}
+	return vin, id, &req, nil
+}
+
+// writeVehicle writes the vehicle with its entity tag
+func writeVehicle(w http.ResponseWriter, v *domain.Vehicle) {
+	w.Header().Set("ETag", etag(v.Version))
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeDeal writes the vehicle reserved by an accepted offer along with the offer
+func writeDeal(w http.ResponseWriter, v *domain.Vehicle, o *domain.Offer) {
+	w.Header().Set("ETag", etag(v.Version))
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"vehicle": v, "offer": o})
+}
+
+// writeOffer writes the offer with the given status code
+func writeOffer(w http.ResponseWriter, status int, o *domain.Offer) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(o)
+}
+
+// POST /vehicles/{vin}/sale, the buy-now price defaults to the recommended price plus the markup
+func (h *VehicleHandler) ListForSale(w http.ResponseWriter, r *http.Request) {
+	vin, _, req, err := decodeSale(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	v, err := h.UC.ListForSale(r.Context(), vin, req.BuyNowPrice, actorOf(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	writeVehicle(w, v)
+}
+
+// POST /vehicles/{vin}/purchase
+func (h *VehicleHandler) PurchaseVehicle(w http.ResponseWriter, r *http.Request) {
+	vin, _, req, err := decodeSale(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	v, err := h.UC.Purchase(r.Context(), vin, req.Buyer)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	writeVehicle(w, v)
+}
+
+// POST /vehicles/{vin}/sale/complete
+func (h *VehicleHandler) CompleteSale(w http.ResponseWriter, r *http.Request) {
+	vin, _, _ := salePath(r)
+	v, err := h.UC.CompleteSale(r.Context(), vin, actorOf(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	writeVehicle(w, v)
+}
+
+// POST /vehicles/{vin}/sale/cancel
+func (h *VehicleHandler) CancelSale(w http.ResponseWriter, r *http.Request) {
+	vin, _, _ := salePath(r)
+	v, err := h.UC.CancelSale(r.Context(), vin, actorOf(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	writeVehicle(w, v)
+}
+
+// POST /vehicles/{vin}/offers
+func (h *VehicleHandler) MakeOffer(w http.ResponseWriter, r *http.Request) {
+	vin, _, req, err := decodeSale(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	o, err := h.UC.MakeOffer(r.Context(), vin, req.Buyer, req.Amount)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Location", "/vehicles/"+vin+"/offers/"+strconv.FormatInt(o.ID, 10))
+	writeOffer(w, http.StatusCreated, o)
+}
+
+// GET /vehicles/{vin}/offers
+func (h *VehicleHandler) ListOffers(w http.ResponseWriter, r *http.Request) {
+	vin, _, _ := salePath(r)
+	offers, err := h.UC.Offers(r.Context(), vin)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(offers)
+}
+
+// POST /vehicles/{vin}/offers/{id}/accept
+func (h *VehicleHandler) AcceptOffer(w http.ResponseWriter, r *http.Request) {
+	vin, id, err := salePath(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	v, o, err := h.UC.AcceptOffer(r.Context(), vin, id, actorOf(r))
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	writeDeal(w, v, o)
+}
+
+// POST /vehicles/{vin}/offers/{id}/reject
+func (h *VehicleHandler) RejectOffer(w http.ResponseWriter, r *http.Request) {
+	vin, id, err := salePath(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	o, err := h.UC.RejectOffer(r.Context(), vin, id)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	writeOffer(w, http.StatusOK, o)
+}
+
+// POST /vehicles/{vin}/offers/{id}/counter
+func (h *VehicleHandler) CounterOffer(w http.ResponseWriter, r *http.Request) {
+	vin, id, req, err := decodeSale(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	o, err := h.UC.CounterOffer(r.Context(), vin, id, req.Amount)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	writeOffer(w, http.StatusOK, o)
+}
+
+// POST /vehicles/{vin}/offers/{id}/accept-counter, only the buyer of the offer may accept the counter
+func (h *VehicleHandler) AcceptCounterOffer(w http.ResponseWriter, r *http.Request) {
+	vin, id, req, err := decodeSale(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	v, o, err := h.UC.AcceptCounterOffer(r.Context(), vin, id, req.Buyer)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	writeDeal(w, v, o)
+}
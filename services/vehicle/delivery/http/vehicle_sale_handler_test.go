@@ -0,0 +1,144 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// serveSale sends a sale request with an optional JSON body to the router
+func serveSale(router http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "seller")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+// TestVehicleHandler_Sale tests the buy-now and make-offer HTTP handlers
+func TestVehicleHandler_Sale(t *testing.T) {
+
+	// Prepare router with a created vehicle
+	router := NewTestRouter()
+	const path = "/vehicles/1HGCM8267LA123456"
+	rec := serveSale(router, http.MethodPost, "/vehicles", `{"vin":"1HGCM8267LA123456","year":2020,"odometer":15000}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	t.Run("not for sale", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, path+"/purchase", `{"buyer":"alice"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		rec = serveSale(router, http.MethodPost, path+"/sale", "")
+		assert.Equal(t, http.StatusConflict, rec.Code, "only listed vehicles are sold")
+		for _, to := range []string{"inspected", "priced", "listed"} {
+			rec = serveSale(router, http.MethodPost, path+"/lifecycle", `{"lifecycle":"`+to+`"}`)
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("list for sale", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, path+"/sale", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var v domain.Vehicle
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&v))
+		assert.Equal(t, domain.SaleAvailable, v.Status)
+		assert.Equal(t, uint64(108_900), v.BuyNowPrice)
+		assert.Equal(t, `"5"`, rec.Header().Get("ETag"))
+
+		rec = serveSale(router, http.MethodPost, path+"/sale", `{"buy_now_price":100000}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		rec = serveSale(router, http.MethodGet, "/vehicles?status=available", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var page domain.VehiclesPage
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&page))
+		assert.Len(t, page.Vehicles, 1)
+		assert.Equal(t, uint64(100_000), page.Vehicles[0].BuyNowPrice)
+	})
+
+	t.Run("sale fields are read-only", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, path, bytes.NewReader([]byte(`{"status":"sold"}`)))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	var offer domain.Offer
+	t.Run("make offer", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, path+"/offers", `{"buyer":"alice","amount":100000}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serveSale(router, http.MethodPost, path+"/offers", `{"buyer":"alice","amount":90000}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&offer))
+		assert.Equal(t, domain.OfferOpen, offer.Status)
+		assert.Equal(t, path+"/offers/1", rec.Header().Get("Location"))
+	})
+
+	t.Run("counter and accept", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, path+"/offers/1/counter", `{"amount":95000}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		rec = serveSale(router, http.MethodPost, path+"/offers/1/accept-counter", `{"buyer":"bob"}`)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = serveSale(router, http.MethodPost, path+"/offers/1/accept-counter", `{"buyer":"alice"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var res struct {
+			Vehicle domain.Vehicle `json:"vehicle"`
+			Offer   domain.Offer   `json:"offer"`
+		}
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+		assert.Equal(t, domain.SalePending, res.Vehicle.Status)
+		assert.Equal(t, uint64(95_000), res.Vehicle.SalePrice)
+		assert.Equal(t, domain.OfferAccepted, res.Offer.Status)
+	})
+
+	t.Run("pending sale", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, path+"/purchase", `{"buyer":"carol"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		rec = serveSale(router, http.MethodDelete, path, "")
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		rec = serveSale(router, http.MethodPost, path+"/offers/1/reject", "")
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		rec = serveSale(router, http.MethodPost, path+"/offers/7/accept", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = serveSale(router, http.MethodPost, path+"/lifecycle", `{"lifecycle":"withdrawn"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("cancel and purchase", func(t *testing.T) {
+		rec := serveSale(router, http.MethodPost, path+"/sale/cancel", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		rec = serveSale(router, http.MethodPost, path+"/purchase", `{"buyer":"carol"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		rec = serveSale(router, http.MethodPost, path+"/sale/complete", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var v domain.Vehicle
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&v))
+		assert.Equal(t, domain.Sale{Status: domain.SaleSold, BuyNowPrice: 100_000, Buyer: "carol", SalePrice: 100_000}, v.Sale)
+		assert.Equal(t, domain.LifecycleSold, v.Lifecycle)
+	})
+
+	t.Run("list offers", func(t *testing.T) {
+		rec := serveSale(router, http.MethodGet, path+"/offers", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var offers []*domain.Offer
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&offers))
+		assert.Len(t, offers, 1)
+	})
+
+	t.Run("routing", func(t *testing.T) {
+		rec := serveSale(router, http.MethodGet, path+"/purchase", "")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		rec = serveSale(router, http.MethodPost, path+"/offers/1/withdraw", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = serveSale(router, http.MethodPost, path+"/offers/abc/accept", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
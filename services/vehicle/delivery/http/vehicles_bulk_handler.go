@@ -12,6 +12,26 @@ type VehiclesBulkHandler struct {
 	UC usecase.VehiclesBulkUsecase
 }
 
+// writeBulkResult writes per-vehicle results of a best-effort bulk operation as a multi-status response
+func writeBulkResult(w http.ResponseWriter, res *domain.BulkResult, err error) {
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusMultiStatus)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// setActor sets the actor of every vehicle of the bulk
+func setActor(vb *domain.VehiclesBulk, actor string) {
+	for _, v := range vb.Vehicles {
+		if v != nil {
+			v.Actor = actor
+		}
+	}
+}
+
 // POST /vehicles/bulk
 func (h *VehiclesBulkHandler) CreateVehiclesBulk(w http.ResponseWriter, r *http.Request) {
 	var vb domain.VehiclesBulk
@@ -19,7 +39,13 @@ func (h *VehiclesBulkHandler) CreateVehiclesBulk(w http.ResponseWriter, r *http.
 		WriteError(w, domain.ErrValidation)
 		return
 	}
-	if err := h.UC.Create(&vb); err != nil {
+	setActor(&vb, actorOf(r))
+	if vb.IsBestEffort() {
+		res, err := h.UC.CreateBestEffort(r.Context(), &vb)
+		writeBulkResult(w, res, err)
+		return
+	}
+	if err := h.UC.Create(r.Context(), &vb); err != nil {
 		WriteError(w, err)
 		return
 	}
@@ -34,7 +60,13 @@ func (h *VehiclesBulkHandler) UpdateVehiclesBulk(w http.ResponseWriter, r *http.
 		WriteError(w, domain.ErrValidation)
 		return
 	}
-	if err := h.UC.Update(&vb); err != nil {
+	setActor(&vb, actorOf(r))
+	if vb.IsBestEffort() {
+		res, err := h.UC.UpdateBestEffort(r.Context(), &vb)
+		writeBulkResult(w, res, err)
+		return
+	}
+	if err := h.UC.Update(r.Context(), &vb); err != nil {
 		WriteError(w, err)
 		return
 	}
@@ -20,8 +20,8 @@ func TestVehicleHandler_CreateVehiclesBulk(t *testing.T) {
 	t.Run("valid request", func(t *testing.T) {
 		vb := domain.VehiclesBulk{
 			Vehicles: []*domain.Vehicle{
-				{VIN: "1HGCM82633A123456", Year: 2020, Odometer: 15000},
-				{VIN: "2HGCM82633A654321", Year: 2019, Odometer: 30000},
+				{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 15000},
+				{VIN: "2HGCM8268KA654321", Year: 2019, Odometer: 30000},
 			},
 		}
 		body, _ := json.Marshal(vb)
@@ -59,8 +59,8 @@ func TestVehicleHandler_UpdateVehiclesBulk(t *testing.T) {
 	// Create vehicles first
 	vb := domain.VehiclesBulk{
 		Vehicles: []*domain.Vehicle{
-			{VIN: "1HGCM82633A123456", Year: 2020, Odometer: 15000},
-			{VIN: "2HGCM82633A654321", Year: 2019, Odometer: 30000},
+			{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 15000},
+			{VIN: "2HGCM8268KA654321", Year: 2019, Odometer: 30000},
 		},
 	}
 	body, _ := json.Marshal(vb)
@@ -75,8 +75,8 @@ func TestVehicleHandler_UpdateVehiclesBulk(t *testing.T) {
 
 		vb := domain.VehiclesBulk{
 			Vehicles: []*domain.Vehicle{
-				{VIN: "1HGCM82633A123456", Year: 2025, Odometer: 300_000},
-				{VIN: "2HGCM82633A654321", Year: 2021, Odometer: 90_000},
+				{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 300_000},
+				{VIN: "2HGCM8268KA654321", Year: 2019, Odometer: 90_000},
 			},
 		}
 		body, _ := json.Marshal(vb)
@@ -108,3 +108,74 @@ func TestVehicleHandler_UpdateVehiclesBulk(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 }
+
+// TestVehicleHandler_UpdateVehiclesBulkConflict tests version conflicts of the UpdateVehiclesBulk HTTP handler
+func TestVehicleHandler_UpdateVehiclesBulkConflict(t *testing.T) {
+	router := NewTestRouter()
+
+	// Create vehicles first
+	vb := domain.VehiclesBulk{
+		Vehicles: []*domain.Vehicle{
+			{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 15000},
+			{VIN: "2HGCM8268KA654321", Year: 2019, Odometer: 30000},
+		},
+	}
+	body, _ := json.Marshal(vb)
+	req := httptest.NewRequest(http.MethodPost, "/vehicles/bulk", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	// Stale version of the second vehicle
+	vb = domain.VehiclesBulk{
+		Vehicles: []*domain.Vehicle{
+			{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 16000, Version: 1},
+			{VIN: "2HGCM8268KA654321", Year: 2019, Odometer: 31000, Version: 7},
+		},
+	}
+	body, _ = json.Marshal(vb)
+	req = httptest.NewRequest(http.MethodPut, "/vehicles/bulk", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	var got struct {
+		Conflicts []string `json:"conflicts"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&got)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2HGCM8268KA654321"}, got.Conflicts)
+}
+
+// TestVehicleHandler_CreateVehiclesBulkBestEffort tests the best-effort mode of the CreateVehiclesBulk HTTP handler
+func TestVehicleHandler_CreateVehiclesBulkBestEffort(t *testing.T) {
+	router := NewTestRouter()
+
+	vb := domain.VehiclesBulk{
+		Mode: domain.BulkModeBestEffort,
+		Vehicles: []*domain.Vehicle{
+			{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 15000},
+			{VIN: "2HGCM8268KA654321", Year: 1800, Odometer: 30000},
+		},
+	}
+	body, _ := json.Marshal(vb)
+	req := httptest.NewRequest(http.MethodPost, "/vehicles/bulk", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMultiStatus, rec.Code)
+
+	var got domain.BulkResult
+	err := json.NewDecoder(rec.Body).Decode(&got)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.Succeeded)
+	assert.Equal(t, 1, got.Failed)
+	assert.Equal(t, domain.BulkStatusCreated, got.Results[0].Status)
+	assert.Equal(t, domain.BulkStatusFailed, got.Results[1].Status)
+	assert.Equal(t, "2HGCM8268KA654321", got.Results[1].VIN)
+}
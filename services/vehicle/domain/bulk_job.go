@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Bulk job operations
+const (
+	BulkJobCreate = "create"
+	BulkJobUpdate = "update"
+)
+
+// Bulk job statuses
+const (
+	BulkJobPending   = "pending"
+	BulkJobRunning   = "running"
+	BulkJobCompleted = "completed"
+	BulkJobCancelled = "cancelled"
+)
+
+// BulkJob represents an asynchronous bulk operation on vehicles
+type BulkJob struct {
+	ID        string            `json:"id"`
+	Operation string            `json:"operation"`
+	Status    string            `json:"status"`
+	Total     int               `json:"total"`
+	Processed int               `json:"processed"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Results   []*BulkItemResult `json:"results"`
+	Vehicles  []*Vehicle        `json:"-"`
+	Owner     string            `json:"-"` // the instance processing the job
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// NewBulkJob creates a new pending bulk job for the vehicles
+func NewBulkJob(id, operation string, vehicles []*Vehicle) *BulkJob {
+	now := time.Now().UTC()
+	return &BulkJob{
+		ID:        id,
+		Operation: operation,
+		Status:    BulkJobPending,
+		Total:     len(vehicles),
+		Results:   []*BulkItemResult{},
+		Vehicles:  vehicles,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate checks if the bulk job data is valid
+func (j *BulkJob) Validate() error {
+	return validation.ValidateStruct(
+		j,
+		validation.Field(
+			&j.ID,
+			validation.Required,
+		),
+		validation.Field(
+			&j.Operation,
+			validation.Required,
+			validation.In(BulkJobCreate, BulkJobUpdate),
+		),
+		validation.Field(
+			&j.Vehicles,
+			validation.Required,
+			validation.Skip, // invalid vehicles are reported per item while processing
+		),
+	)
+}
+
+// IsFinished reports whether the job is no longer processed
+func (j *BulkJob) IsFinished() bool {
+	return j.Status == BulkJobCompleted || j.Status == BulkJobCancelled
+}
+
+// Remaining returns the vehicles that have not been processed yet
+func (j *BulkJob) Remaining() []*Vehicle {
+	return j.Vehicles[min(len(j.Results), len(j.Vehicles)):]
+}
+
+// Record appends the results of a processed chunk of vehicles and updates the progress
+func (j *BulkJob) Record(results []*BulkItemResult) {
+	for _, r := range results {
+		if r.Status == BulkStatusFailed {
+			j.Failed++
+		} else {
+			j.Succeeded++
+		}
+	}
+	j.Results = append(j.Results, results...)
+	j.Processed = len(j.Results)
+	j.UpdatedAt = time.Now().UTC()
+}
+
+// SetStatus changes the status of the job
+func (j *BulkJob) SetStatus(status string) {
+	j.Status = status
+	j.UpdatedAt = time.Now().UTC()
+}
+
+// Clone returns a copy of the job that does not share progress with the original
+func (j *BulkJob) Clone() *BulkJob {
+	c := *j
+	c.Results = slices.Clone(j.Results)
+	return &c
+}
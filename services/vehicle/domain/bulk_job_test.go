@@ -0,0 +1,81 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// jTest is a struct for bulk job tests
+type jTest struct {
+	name    string
+	data    func() *domain.BulkJob
+	isValid bool
+}
+
+// newTestBulkJob is a test valid bulk job instance
+func newTestBulkJob() *domain.BulkJob {
+	return domain.NewBulkJob("job-1", domain.BulkJobCreate, []*domain.Vehicle{newTestVehicle(), newTestVehicle(), newTestVehicle()})
+}
+
+// TestBulkJob_Validate tests the Validate method of the BulkJob struct
+func TestBulkJob_Validate(t *testing.T) {
+	tests := []jTest{
+		{
+			name: "valid job",
+			data: func() *domain.BulkJob {
+				return newTestBulkJob()
+			},
+			isValid: true,
+		},
+		{
+			name: "unknown operation",
+			data: func() *domain.BulkJob {
+				j := newTestBulkJob()
+				j.Operation = "delete"
+				return j
+			},
+			isValid: false,
+		},
+		{
+			name: "no vehicles",
+			data: func() *domain.BulkJob {
+				return domain.NewBulkJob("job-1", domain.BulkJobCreate, nil)
+			},
+			isValid: false,
+		},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.isValid {
+				assert.NoError(t, test.data().Validate())
+			} else {
+				assert.Error(t, test.data().Validate())
+			}
+		})
+	}
+}
+
+// TestBulkJob_Record tests the progress tracking of the BulkJob struct
+func TestBulkJob_Record(t *testing.T) {
+	j := newTestBulkJob()
+	assert.Equal(t, domain.BulkJobPending, j.Status)
+	assert.Len(t, j.Remaining(), 3)
+
+	j.Record([]*domain.BulkItemResult{
+		{Status: domain.BulkStatusCreated},
+		{Status: domain.BulkStatusFailed},
+	})
+	assert.Equal(t, 2, j.Processed)
+	assert.Equal(t, 1, j.Succeeded)
+	assert.Equal(t, 1, j.Failed)
+	assert.Len(t, j.Remaining(), 1)
+	assert.False(t, j.IsFinished())
+
+	j.SetStatus(domain.BulkJobCancelled)
+	assert.True(t, j.IsFinished())
+}
@@ -1,8 +1,59 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
-	ErrNotFound   = errors.New("vehicle not found")
-	ErrValidation = errors.New("validation failed")
+	ErrNotFound             = errors.New("vehicle not found")
+	ErrAlreadyExists        = errors.New("vehicle already exists")
+	ErrNotDeleted           = errors.New("vehicle is not deleted")
+	ErrValidation           = errors.New("validation failed")
+	ErrVersionConflict      = errors.New("vehicle version conflict")
+	ErrPreconditionRequired = errors.New("if-match header required")
+	ErrJobNotFound          = errors.New("bulk job not found")
+	ErrJobFinished          = errors.New("bulk job already finished")
+	ErrJobClaimed           = errors.New("bulk job is processed by another instance")
+	ErrNotForSale           = errors.New("vehicle is not available for sale")
+	ErrSalePending          = errors.New("vehicle sale is pending")
+	ErrNoPendingSale        = errors.New("vehicle has no pending sale")
+	ErrSaleClaimed          = errors.New("vehicle is claimed by another sale")
+	ErrOfferNotFound        = errors.New("offer not found")
+	ErrOfferClosed          = errors.New("offer is no longer open")
+	ErrNotBuyer             = errors.New("not the buyer of the offer")
+	ErrInvalidTransition    = errors.New("invalid lifecycle transition")
+	ErrUnavailable          = errors.New("dependency unavailable")
+	ErrDecodedOffline       = errors.New("build data decoded offline")
 )
+
+// VersionConflictError lists the VINs whose stored version differs from the expected one
+type VersionConflictError struct {
+	VINs []string
+}
+
+// Error returns the error message
+func (e *VersionConflictError) Error() string {
+	return ErrVersionConflict.Error() + ": " + strings.Join(e.VINs, ", ")
+}
+
+// Unwrap makes the error match ErrVersionConflict
+func (e *VersionConflictError) Unwrap() error {
+	return ErrVersionConflict
+}
+
+// InvalidTransitionError reports the lifecycle transition the pipeline does not allow
+type InvalidTransitionError struct {
+	From string
+	To   string
+}
+
+// Error returns the error message
+func (e *InvalidTransitionError) Error() string {
+	return ErrInvalidTransition.Error() + " from " + e.From + " to " + e.To
+}
+
+// Unwrap makes the error match ErrInvalidTransition
+func (e *InvalidTransitionError) Unwrap() error {
+	return ErrInvalidTransition
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+)
+
+// readOnlyFields are vehicle fields that are calculated by the system or changed by the sale flow
+// or the lifecycle transitions and cannot be patched
+var readOnlyFields = []string{"vin", "msrp", "price", "grade", "version", "lifecycle", "status", "buy_now_price", "buyer", "sale_price", "enrichment", "decode_quality"}
+
+// fetchFields are vehicle fields that affect the grade or the price of the vehicle
+var fetchFields = []string{
+	"year",
+	"odometer",
+	"exteriorColor",
+	"interiorColor",
+	"small_scratches",
+	"strong_scratches",
+	"electric_fail",
+	"suspension_fail",
+}
+
+// PatchResult represents the outcome of a partial vehicle update
+type PatchResult struct {
+	Vehicle *Vehicle `json:"vehicle"`
+	Changed []string `json:"changed"`
+}
+
+// MergePatch applies an RFC 7396 JSON merge patch to the JSON document
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	var d, p any
+	if len(bytes.TrimSpace(doc)) > 0 {
+		if err := json.Unmarshal(doc, &d); err != nil {
+			return nil, err
+		}
+	}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergeValue(d, p))
+}
+
+// mergeValue merges the patch value into the target value
+func mergeValue(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = map[string]any{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergeValue(t[k], v)
+	}
+	return t
+}
+
+// ApplyMergePatch applies a JSON merge patch to the vehicle and returns the changed fields
+func (v *Vehicle) ApplyMergePatch(patch []byte) ([]string, error) {
+
+	// Reject patches of read-only fields
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return nil, ErrValidation
+	}
+	for _, f := range readOnlyFields {
+		if _, ok := fields[f]; ok {
+			return nil, ErrValidation
+		}
+	}
+
+	// Merge the patch onto the current state
+	doc, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := MergePatch(doc, patch)
+	if err != nil {
+		return nil, ErrValidation
+	}
+	var patched Vehicle
+	if err := json.Unmarshal(merged, &patched); err != nil {
+		return nil, ErrValidation
+	}
+
+	// Save the patched state
+	patched.Actor = v.Actor
+	changed := v.Diff(&patched)
+	*v = patched
+	return changed, nil
+}
+
+// Diff returns the JSON names of the fields that differ between two vehicles
+func (v *Vehicle) Diff(other *Vehicle) []string {
+	var a, b map[string]json.RawMessage
+	da, _ := json.Marshal(v)
+	db, _ := json.Marshal(other)
+	_ = json.Unmarshal(da, &a)
+	_ = json.Unmarshal(db, &b)
+
+	changed := []string{}
+	for k, va := range a {
+		if !bytes.Equal(va, b[k]) {
+			changed = append(changed, k)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// NeedsFetch reports whether any of the changed fields affects the grade or the price
+func NeedsFetch(changed []string) bool {
+	return slices.ContainsFunc(changed, func(f string) bool {
+		return slices.Contains(fetchFields, f)
+	})
+}
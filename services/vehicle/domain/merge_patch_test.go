@@ -0,0 +1,80 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMergePatch tests the MergePatch function against RFC 7396 examples
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		patch    string
+		expected string
+	}{
+		{name: "replace value", doc: `{"a":"b"}`, patch: `{"a":"c"}`, expected: `{"a":"c"}`},
+		{name: "add value", doc: `{"a":"b"}`, patch: `{"b":"c"}`, expected: `{"a":"b","b":"c"}`},
+		{name: "remove value", doc: `{"a":"b"}`, patch: `{"a":null}`, expected: `{}`},
+		{name: "replace array", doc: `{"a":["b"]}`, patch: `{"a":"c"}`, expected: `{"a":"c"}`},
+		{name: "nested merge", doc: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, expected: `{"a":{"b":"d"}}`},
+		{name: "non object patch", doc: `{"a":"b"}`, patch: `["c"]`, expected: `["c"]`},
+		{name: "empty document", doc: ``, patch: `{"a":{"bb":{"ccc":null}}}`, expected: `{"a":{"bb":{}}}`},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := domain.MergePatch([]byte(test.doc), []byte(test.patch))
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(got))
+		})
+	}
+}
+
+// TestVehicle_ApplyMergePatch tests the ApplyMergePatch method of the Vehicle struct
+func TestVehicle_ApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		patch    string
+		isValid  bool
+		expected []string
+	}{
+		{name: "change odometer", patch: `{"odometer":20000}`, isValid: true, expected: []string{"odometer"}},
+		{name: "change colors", patch: `{"exteriorColor":"Red","interiorColor":"Black"}`, isValid: true, expected: []string{"exteriorColor", "interiorColor"}},
+		{name: "same value", patch: `{"year":2022}`, isValid: true, expected: []string{}},
+		{name: "remove value", patch: `{"odometer":null}`, isValid: true, expected: []string{"odometer"}},
+		{name: "read-only price", patch: `{"price":1}`, isValid: false},
+		{name: "read-only vin", patch: `{"vin":"1HGBH41J3LN109187"}`, isValid: false},
+		{name: "read-only sale status", patch: `{"status":"sold"}`, isValid: false},
+		{name: "read-only decode quality", patch: `{"decode_quality":{"status":"clean"}}`, isValid: false},
+		{name: "wrong type", patch: `{"year":"2022"}`, isValid: false},
+		{name: "not an object", patch: `[1,2]`, isValid: false},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := newTestVehicle()
+			changed, err := v.ApplyMergePatch([]byte(test.patch))
+			if test.isValid {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, changed)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, newTestVehicle(), v)
+			}
+		})
+	}
+}
+
+// TestNeedsFetch tests the NeedsFetch function
+func TestNeedsFetch(t *testing.T) {
+	assert.True(t, domain.NeedsFetch([]string{"brand", "odometer"}))
+	assert.True(t, domain.NeedsFetch([]string{"small_scratches"}))
+	assert.False(t, domain.NeedsFetch([]string{"brand", "engine"}))
+	assert.False(t, domain.NeedsFetch([]string{}))
+}
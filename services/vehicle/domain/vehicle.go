@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/alechekz/online-car-auction/pkg/vin"
 )
 
 // Vehicle represents a vehicle entity in the system
@@ -23,22 +25,37 @@ type Vehicle struct {
 	Brand           string `json:"brand"`
 	Engine          string `json:"engine"`
 	Transmission    string `json:"transmission"`
+	BuildData
+	Version   int64  `json:"version"`
+	Lifecycle string `json:"lifecycle"`
+	Sale
+	Enrichment *Enrichment `json:"enrichment,omitempty"`
+	Actor      string      `json:"-"`
+	DeletedAt  *time.Time  `json:"-"`
+}
+
+// NormalizeVIN returns the VIN in the upper case form vehicles are stored and looked up under
+func NormalizeVIN(s string) string {
+	return vin.Normalize(s)
 }
 
-// Validate checks if the vehicle data is valid
+// Validate checks if the vehicle data is valid, the VIN is normalized first
 func (v *Vehicle) Validate() error {
+	v.VIN = NormalizeVIN(v.VIN)
 	return validation.ValidateStruct(
 		v,
 		validation.Field(
 			&v.VIN,
 			validation.Required,
 			validation.Length(17, 17),
+			vin.Rule,
 		),
 		validation.Field(
 			&v.Year,
 			validation.Required,
 			validation.Min(1900),
 			validation.Max(time.Now().Year()),
+			vin.YearRule(v.VIN),
 		),
 		validation.Field(
 			&v.Odometer,
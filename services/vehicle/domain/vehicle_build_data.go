@@ -0,0 +1,59 @@
+package domain
+
+// Decode quality statuses of the build data
+const (
+	DecodeClean   = "clean"
+	DecodePartial = "partial"
+	DecodeFailed  = "failed"
+	DecodeOffline = "offline"
+)
+
+// BuildData holds the factory build data of a vehicle decoded from its VIN by the inspection service,
+// next to its brand, engine and transmission
+type BuildData struct {
+	Model                string         `json:"model,omitempty"`
+	Trim                 string         `json:"trim,omitempty"`
+	BodyClass            string         `json:"body_class,omitempty"`
+	DriveType            string         `json:"drive_type,omitempty"`
+	FuelType             string         `json:"fuel_type,omitempty"`
+	Displacement         float64        `json:"displacement,omitempty"`
+	Cylinders            int32          `json:"cylinders,omitempty"`
+	Doors                int32          `json:"doors,omitempty"`
+	ElectrificationLevel string         `json:"electrification_level,omitempty"`
+	DecodeQuality        *DecodeQuality `json:"decode_quality,omitempty"`
+}
+
+// DecodeQuality tells how reliable the build data is, with the NHTSA error codes and message
+type DecodeQuality struct {
+	Status  string  `json:"status"`
+	Codes   []int32 `json:"codes,omitempty"`
+	Message string  `json:"message,omitempty"`
+}
+
+// Fill fills the attributes missing from the build data with the decoded ones,
+// the decode quality always follows the decoded build data
+func (b *BuildData) Fill(decoded BuildData) {
+	fill(&b.Model, decoded.Model)
+	fill(&b.Trim, decoded.Trim)
+	fill(&b.BodyClass, decoded.BodyClass)
+	fill(&b.DriveType, decoded.DriveType)
+	fill(&b.FuelType, decoded.FuelType)
+	fill(&b.Displacement, decoded.Displacement)
+	fill(&b.Cylinders, decoded.Cylinders)
+	fill(&b.Doors, decoded.Doors)
+	fill(&b.ElectrificationLevel, decoded.ElectrificationLevel)
+	b.DecodeQuality = decoded.DecodeQuality
+}
+
+// DecodedOffline reports whether the build data was decoded from the VIN alone while NHTSA was unavailable
+func (b *BuildData) DecodedOffline() bool {
+	return b.DecodeQuality != nil && b.DecodeQuality.Status == DecodeOffline
+}
+
+// fill sets the zero attribute to the value
+func fill[T comparable](attr *T, value T) {
+	var zero T
+	if *attr == zero {
+		*attr = value
+	}
+}
@@ -0,0 +1,35 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// TestBuildData_Fill tests the Fill method of the BuildData struct
+func TestBuildData_Fill(t *testing.T) {
+	decoded := domain.BuildData{
+		Model:         "Accord",
+		Trim:          "EX",
+		BodyClass:     "Sedan",
+		Displacement:  2.4,
+		Cylinders:     4,
+		Doors:         4,
+		DecodeQuality: &domain.DecodeQuality{Status: domain.DecodePartial, Codes: []int32{1}, Message: "check digit"},
+	}
+	b := domain.BuildData{Trim: "LX", Doors: 2}
+
+	b.Fill(decoded)
+	assert.Equal(t, "Accord", b.Model)
+	assert.Equal(t, "LX", b.Trim, "the user's attributes are kept")
+	assert.Equal(t, int32(2), b.Doors, "the user's attributes are kept")
+	assert.Equal(t, 2.4, b.Displacement)
+	assert.Equal(t, decoded.DecodeQuality, b.DecodeQuality)
+
+	// The decode quality always follows the decoded build data
+	b.Fill(domain.BuildData{})
+	assert.Nil(t, b.DecodeQuality)
+	assert.Equal(t, "Accord", b.Model)
+}
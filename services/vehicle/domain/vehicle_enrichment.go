@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// Backoff of the enrichment retries, doubling after every failed attempt up to the maximum
+const (
+	EnrichmentBackoff    = time.Minute
+	MaxEnrichmentBackoff = time.Hour
+)
+
+// Enrichment marks a vehicle saved without its build data, grade or price while a dependency was unavailable,
+// the enrichment is retried until it succeeds
+type Enrichment struct {
+	Attempts int       `json:"attempts"`
+	RetryAt  time.Time `json:"retry_at"`
+	Error    string    `json:"error"`
+}
+
+// EnrichmentDelay returns the pause after the given number of failed enrichment attempts
+func EnrichmentDelay(attempts int) time.Duration {
+	d := EnrichmentBackoff
+	for i := 1; i < attempts && d < MaxEnrichmentBackoff; i++ {
+		d *= 2
+	}
+	return min(d, MaxEnrichmentBackoff)
+}
+
+// DeferEnrichment marks the vehicle as pending enrichment after a failed attempt, the next attempt is due after the backoff
+func (v *Vehicle) DeferEnrichment(err error, now time.Time) {
+	attempts := 1
+	if v.Enrichment != nil {
+		attempts = v.Enrichment.Attempts + 1
+	}
+	v.Enrichment = &Enrichment{
+		Attempts: attempts,
+		RetryAt:  now.Add(EnrichmentDelay(attempts)).UTC(),
+		Error:    err.Error(),
+	}
+}
+
+// IsEnrichmentDue reports whether the pending enrichment of the vehicle should be retried at the given time
+func (v *Vehicle) IsEnrichmentDue(now time.Time) bool {
+	return v.Enrichment != nil && !now.Before(v.Enrichment.RetryAt)
+}
+
+// Enriched returns a copy of the vehicle with the build data, grade, price and pending enrichment of the other one
+func (v *Vehicle) Enriched(other *Vehicle) *Vehicle {
+	c := *v
+	c.Brand = other.Brand
+	c.Engine = other.Engine
+	c.Transmission = other.Transmission
+	c.BuildData = other.BuildData
+	c.MSRP = other.MSRP
+	c.Grade = other.Grade
+	c.Price = other.Price
+	c.Enrichment = other.Enrichment
+	c.Actor = other.Actor
+	return &c
+}
@@ -0,0 +1,72 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// TestEnrichmentDelay tests the backoff of the enrichment retries
+func TestEnrichmentDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: time.Minute},
+		{attempts: 2, expected: 2 * time.Minute},
+		{attempts: 4, expected: 8 * time.Minute},
+		{attempts: 7, expected: time.Hour},
+		{attempts: 100, expected: time.Hour},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		assert.Equal(t, test.expected, domain.EnrichmentDelay(test.attempts), "attempts: %d", test.attempts)
+	}
+}
+
+// TestVehicle_DeferEnrichment tests marking a vehicle as pending enrichment
+func TestVehicle_DeferEnrichment(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	v := newTestVehicle()
+	assert.False(t, v.IsEnrichmentDue(now), "nothing is pending")
+
+	v.DeferEnrichment(errors.New("inspection is down"), now)
+	assert.Equal(t, &domain.Enrichment{Attempts: 1, RetryAt: now.Add(time.Minute), Error: "inspection is down"}, v.Enrichment)
+	assert.False(t, v.IsEnrichmentDue(now))
+	assert.True(t, v.IsEnrichmentDue(now.Add(time.Minute)))
+
+	v.DeferEnrichment(errors.New("pricing is down"), now.Add(time.Minute))
+	assert.Equal(t, &domain.Enrichment{Attempts: 2, RetryAt: now.Add(3 * time.Minute), Error: "pricing is down"}, v.Enrichment)
+}
+
+// TestVehicle_Enriched tests copying the enriched data of a vehicle
+func TestVehicle_Enriched(t *testing.T) {
+	stored := newTestVehicle()
+	stored.Odometer = 1_000
+	stored.Enrichment = &domain.Enrichment{Attempts: 1}
+	fetched := newTestVehicle()
+	fetched.Brand = "Kia"
+	fetched.Price = 99_000
+	fetched.Odometer = 2_000
+	fetched.Model = "Accord"
+	fetched.DecodeQuality = &domain.DecodeQuality{Status: domain.DecodeClean}
+
+	e := stored.Enriched(fetched)
+	assert.Equal(t, "Kia", e.Brand)
+	assert.Equal(t, uint64(99_000), e.Price)
+	assert.Equal(t, "Accord", e.Model)
+	assert.Equal(t, domain.DecodeClean, e.DecodeQuality.Status)
+	assert.Equal(t, int32(1_000), e.Odometer, "only the enriched data is copied")
+	assert.Nil(t, e.Enrichment)
+	assert.NotNil(t, stored.Enrichment)
+
+	// The enrichment bookkeeping is not part of the history
+	entry := domain.NewHistoryEntry(domain.HistoryUpdated, "", stored, e)
+	assert.NotContains(t, entry.Changes, "enrichment")
+	assert.Contains(t, entry.Changes, "brand")
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Export formats of a vehicles export
+const (
+	ExportFormatCSV    = "csv"
+	ExportFormatNDJSON = "ndjson"
+	ExportFormatJSON   = "json"
+)
+
+// exportColumns are vehicle fields that can be exported, in their default order
+var exportColumns = []string{
+	"vin",
+	"year",
+	"odometer",
+	"exteriorColor",
+	"interiorColor",
+	"msrp",
+	"price",
+	"grade",
+	"small_scratches",
+	"strong_scratches",
+	"electric_fail",
+	"suspension_fail",
+	"brand",
+	"engine",
+	"transmission",
+	"version",
+}
+
+// VehicleExport describes a streaming export of all vehicles matching the query,
+// the limit and the page token of the query are ignored
+type VehicleExport struct {
+	Query   *VehicleQuery
+	Format  string
+	Columns []string
+}
+
+// NewVehicleExport creates a new CSV export of all columns
+func NewVehicleExport(q *VehicleQuery) *VehicleExport {
+	return &VehicleExport{
+		Query:   q,
+		Format:  ExportFormatCSV,
+		Columns: exportColumns,
+	}
+}
+
+// Validate checks if the export parameters are valid
+func (e *VehicleExport) Validate() error {
+	cols := make([]any, len(exportColumns))
+	for i, c := range exportColumns {
+		cols[i] = c
+	}
+	return validation.ValidateStruct(
+		e,
+		validation.Field(
+			&e.Query,
+			validation.Required,
+		),
+		validation.Field(
+			&e.Format,
+			validation.Required,
+			validation.In(ExportFormatCSV, ExportFormatNDJSON, ExportFormatJSON),
+		),
+		validation.Field(
+			&e.Columns,
+			validation.Required,
+			validation.Each(validation.In(cols...)),
+		),
+	)
+}
+
+// FieldValue returns the value of the vehicle field with the given JSON name
+func (v *Vehicle) FieldValue(name string) any {
+	switch name {
+	case "vin":
+		return v.VIN
+	case "year":
+		return v.Year
+	case "odometer":
+		return v.Odometer
+	case "exteriorColor":
+		return v.ExteriorColor
+	case "interiorColor":
+		return v.InteriorColor
+	case "msrp":
+		return v.MSRP
+	case "price":
+		return v.Price
+	case "grade":
+		return v.Grade
+	case "small_scratches":
+		return v.SmallScratches
+	case "strong_scratches":
+		return v.StrongScratches
+	case "electric_fail":
+		return v.ElectricFail
+	case "suspension_fail":
+		return v.SuspensionFail
+	case "brand":
+		return v.Brand
+	case "engine":
+		return v.Engine
+	case "transmission":
+		return v.Transmission
+	case "version":
+		return v.Version
+	}
+	return nil
+}
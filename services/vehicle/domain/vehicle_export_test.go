@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// eTest is a struct for vehicle export tests
+type eTest struct {
+	name    string
+	data    func() *domain.VehicleExport
+	isValid bool
+}
+
+// TestVehicleExport_Validate tests the Validate method of the VehicleExport struct
+func TestVehicleExport_Validate(t *testing.T) {
+	tests := []eTest{
+		{
+			name: "default export",
+			data: func() *domain.VehicleExport {
+				return domain.NewVehicleExport(domain.NewVehicleQuery())
+			},
+			isValid: true,
+		},
+		{
+			name: "selected columns",
+			data: func() *domain.VehicleExport {
+				e := domain.NewVehicleExport(domain.NewVehicleQuery())
+				e.Format = domain.ExportFormatNDJSON
+				e.Columns = []string{"vin", "price"}
+				return e
+			},
+			isValid: true,
+		},
+		{
+			name: "unknown format",
+			data: func() *domain.VehicleExport {
+				e := domain.NewVehicleExport(domain.NewVehicleQuery())
+				e.Format = "xml"
+				return e
+			},
+			isValid: false,
+		},
+		{
+			name: "unknown column",
+			data: func() *domain.VehicleExport {
+				e := domain.NewVehicleExport(domain.NewVehicleQuery())
+				e.Columns = []string{"vin", "owner"}
+				return e
+			},
+			isValid: false,
+		},
+		{
+			name: "invalid query",
+			data: func() *domain.VehicleExport {
+				q := domain.NewVehicleQuery()
+				q.SortBy = "color"
+				return domain.NewVehicleExport(q)
+			},
+			isValid: false,
+		},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.isValid {
+				assert.NoError(t, test.data().Validate())
+			} else {
+				assert.Error(t, test.data().Validate())
+			}
+		})
+	}
+}
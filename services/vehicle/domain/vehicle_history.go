@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// History actions of a vehicle
+const (
+	HistoryCreated  = "created"
+	HistoryUpdated  = "updated"
+	HistoryDeleted  = "deleted"
+	HistoryRestored = "restored"
+	HistoryPurged   = "purged"
+)
+
+// SystemActor is recorded as the actor of changes made without a known actor
+const SystemActor = "system"
+
+// FieldChange represents the value of a vehicle field before and after a change
+type FieldChange struct {
+	From json.RawMessage `json:"from"`
+	To   json.RawMessage `json:"to"`
+}
+
+// HistoryEntry represents an immutable record of a single vehicle change
+type HistoryEntry struct {
+	ID        int64                   `json:"id"`
+	VIN       string                  `json:"vin"`
+	Action    string                  `json:"action"`
+	Actor     string                  `json:"actor"`
+	Version   int64                   `json:"version"`
+	Changes   map[string]*FieldChange `json:"changes"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+// NewHistoryEntry records the change of a vehicle from the before state to the after state,
+// before is nil for created vehicles and after is nil for deleted ones
+func NewHistoryEntry(action, actor string, before, after *Vehicle) *HistoryEntry {
+	if actor == "" {
+		actor = SystemActor
+	}
+	e := &HistoryEntry{
+		Action:    action,
+		Actor:     actor,
+		Changes:   diffFields(before, after),
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, v := range []*Vehicle{after, before} {
+		if v != nil {
+			e.VIN = v.VIN
+			e.Version = v.Version
+			break
+		}
+	}
+	return e
+}
+
+// fieldValues returns the JSON values of the vehicle fields without the version and the enrichment bookkeeping,
+// nil vehicles have no fields
+func fieldValues(v *Vehicle) map[string]json.RawMessage {
+	fields := map[string]json.RawMessage{}
+	if v == nil {
+		return fields
+	}
+	b, _ := json.Marshal(v)
+	_ = json.Unmarshal(b, &fields)
+	delete(fields, "version")
+	delete(fields, "enrichment")
+	return fields
+}
+
+// diffFields returns the changed fields between two vehicle states
+func diffFields(before, after *Vehicle) map[string]*FieldChange {
+	a, b := fieldValues(before), fieldValues(after)
+	changes := map[string]*FieldChange{}
+	for k, va := range a {
+		if vb, ok := b[k]; !ok || !bytes.Equal(va, vb) {
+			changes[k] = &FieldChange{From: va, To: b[k]}
+		}
+	}
+	for k, vb := range b {
+		if _, ok := a[k]; !ok {
+			changes[k] = &FieldChange{To: vb}
+		}
+	}
+	return changes
+}
@@ -0,0 +1,49 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewHistoryEntry tests the NewHistoryEntry function
+func TestNewHistoryEntry(t *testing.T) {
+	before := newTestVehicle()
+	before.Version = 1
+	after := newTestVehicle()
+	after.Price = 21_000
+	after.Grade = 40
+	after.Version = 2
+
+	// Updated vehicle records only the changed fields
+	t.Run("updated", func(t *testing.T) {
+		e := domain.NewHistoryEntry(domain.HistoryUpdated, "alice", before, after)
+		assert.Equal(t, before.VIN, e.VIN)
+		assert.Equal(t, "alice", e.Actor)
+		assert.Equal(t, int64(2), e.Version)
+		assert.Len(t, e.Changes, 2)
+		assert.Equal(t, json.RawMessage("0"), e.Changes["price"].From)
+		assert.Equal(t, json.RawMessage("21000"), e.Changes["price"].To)
+		assert.Equal(t, json.RawMessage("40"), e.Changes["grade"].To)
+	})
+
+	// Created vehicle records every field with no previous value
+	t.Run("created", func(t *testing.T) {
+		e := domain.NewHistoryEntry(domain.HistoryCreated, "", nil, before)
+		assert.Equal(t, domain.SystemActor, e.Actor)
+		assert.Nil(t, e.Changes["vin"].From)
+		assert.JSONEq(t, `"`+before.VIN+`"`, string(e.Changes["vin"].To))
+		assert.NotContains(t, e.Changes, "version")
+	})
+
+	// Deleted vehicle records every field with no new value
+	t.Run("deleted", func(t *testing.T) {
+		e := domain.NewHistoryEntry(domain.HistoryDeleted, "bob", after, nil)
+		assert.Equal(t, after.VIN, e.VIN)
+		assert.Equal(t, int64(2), e.Version)
+		assert.Nil(t, e.Changes["price"].To)
+	})
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// importFields are vehicle fields that can be filled from CSV columns
+var importFields = []string{
+	"vin",
+	"year",
+	"odometer",
+	"exteriorColor",
+	"interiorColor",
+	"small_scratches",
+	"strong_scratches",
+	"electric_fail",
+	"suspension_fail",
+	"brand",
+	"engine",
+	"transmission",
+}
+
+// ColumnMapping maps vehicle fields onto CSV column headers, unmapped fields use their JSON name as the header
+type ColumnMapping map[string]string
+
+// ImportRejectedRow represents a CSV row that was not imported
+type ImportRejectedRow struct {
+	Line   int      `json:"line"`
+	VIN    string   `json:"vin,omitempty"`
+	Error  string   `json:"error"`
+	Record []string `json:"record"`
+}
+
+// ImportResult represents the outcome of a CSV import
+type ImportResult struct {
+	Total        int                  `json:"total"`
+	Imported     int                  `json:"imported"`
+	Rejected     int                  `json:"rejected"`
+	RejectedRows []*ImportRejectedRow `json:"rejected_rows"`
+	Header       []string             `json:"-"`
+}
+
+// Columns resolves the CSV column index of every mapped vehicle field
+func (m ColumnMapping) Columns(header []string) (map[string]int, error) {
+
+	// Reject mappings of unknown fields
+	for field := range m {
+		if !slices.Contains(importFields, field) {
+			return nil, fmt.Errorf("%w: field %q cannot be imported", ErrValidation, field)
+		}
+	}
+
+	// Find the column of each field
+	columns := make(map[string]int)
+	for _, field := range importFields {
+		name, mapped := m[field]
+		if !mapped {
+			name = field
+		}
+		i := slices.IndexFunc(header, func(h string) bool {
+			return strings.EqualFold(strings.TrimSpace(h), strings.TrimSpace(name))
+		})
+		switch {
+		case i >= 0:
+			columns[field] = i
+		case mapped:
+			return nil, fmt.Errorf("%w: column %q not found", ErrValidation, name)
+		}
+	}
+	if _, ok := columns["vin"]; !ok {
+		return nil, fmt.Errorf("%w: vin column not found", ErrValidation)
+	}
+	return columns, nil
+}
+
+// ParseVehicleRow builds a vehicle from a CSV record using the resolved columns
+func ParseVehicleRow(record []string, columns map[string]int) (*Vehicle, error) {
+	var v Vehicle
+	if err := v.ApplyRow(record, columns); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ApplyRow sets the vehicle fields of the CSV record's non-empty mapped columns and leaves the others as they are
+func (v *Vehicle) ApplyRow(record []string, columns map[string]int) error {
+	for field, i := range columns {
+		if i >= len(record) {
+			continue
+		}
+		value := strings.TrimSpace(record[i])
+		if value == "" {
+			continue
+		}
+		if err := v.setField(field, value); err != nil {
+			return fmt.Errorf("%w: invalid %s %q", ErrValidation, field, value)
+		}
+	}
+	return nil
+}
+
+// setField parses the value into the vehicle field with the given JSON name
+func (v *Vehicle) setField(field, value string) error {
+	var err error
+	switch field {
+	case "vin":
+		v.VIN = value
+	case "year":
+		v.Year, err = parseInt32(value)
+	case "odometer":
+		v.Odometer, err = parseInt32(value)
+	case "exteriorColor":
+		v.ExteriorColor = value
+	case "interiorColor":
+		v.InteriorColor = value
+	case "small_scratches":
+		v.SmallScratches, err = strconv.ParseBool(value)
+	case "strong_scratches":
+		v.StrongScratches, err = strconv.ParseBool(value)
+	case "electric_fail":
+		v.ElectricFail, err = strconv.ParseBool(value)
+	case "suspension_fail":
+		v.SuspensionFail, err = strconv.ParseBool(value)
+	case "brand":
+		v.Brand = value
+	case "engine":
+		v.Engine = value
+	case "transmission":
+		v.Transmission = value
+	}
+	return err
+}
+
+// parseInt32 parses a decimal 32-bit integer
+func parseInt32(value string) (int32, error) {
+	n, err := strconv.ParseInt(value, 10, 32)
+	return int32(n), err
+}
+
+// Reject records a row that was not imported
+func (res *ImportResult) Reject(line int, vin, reason string, record []string) {
+	res.Rejected++
+	res.RejectedRows = append(res.RejectedRows, &ImportRejectedRow{
+		Line:   line,
+		VIN:    vin,
+		Error:  reason,
+		Record: record,
+	})
+}
+
+// WriteReport writes the rejected rows as CSV, the original columns are followed by the line and the error
+func (res *ImportResult) WriteReport(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append(slices.Clone(res.Header), "line", "error")); err != nil {
+		return err
+	}
+	for _, row := range res.RejectedRows {
+		if err := cw.Write(append(slices.Clone(row.Record), strconv.Itoa(row.Line), row.Error)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
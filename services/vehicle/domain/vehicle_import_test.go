@@ -0,0 +1,97 @@
+package domain_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestColumnMapping_Columns tests the Columns method of the ColumnMapping type
+func TestColumnMapping_Columns(t *testing.T) {
+	header := []string{"Stock VIN", "Model Year", "odometer", "Notes"}
+	tests := []struct {
+		name     string
+		mapping  domain.ColumnMapping
+		isValid  bool
+		expected map[string]int
+	}{
+		{
+			name:     "mapped and default columns",
+			mapping:  domain.ColumnMapping{"vin": "stock vin", "year": "Model Year"},
+			isValid:  true,
+			expected: map[string]int{"vin": 0, "year": 1, "odometer": 2},
+		},
+		{
+			name:    "missing vin column",
+			mapping: domain.ColumnMapping{"year": "Model Year"},
+			isValid: false,
+		},
+		{
+			name:    "missing mapped column",
+			mapping: domain.ColumnMapping{"vin": "Stock VIN", "brand": "Make"},
+			isValid: false,
+		},
+		{
+			name:    "read-only field",
+			mapping: domain.ColumnMapping{"vin": "Stock VIN", "price": "Notes"},
+			isValid: false,
+		},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			columns, err := test.mapping.Columns(header)
+			if test.isValid {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, columns)
+			} else {
+				assert.ErrorIs(t, err, domain.ErrValidation)
+			}
+		})
+	}
+}
+
+// TestParseVehicleRow tests the ParseVehicleRow function
+func TestParseVehicleRow(t *testing.T) {
+	columns := map[string]int{"vin": 0, "year": 1, "odometer": 2, "small_scratches": 3}
+
+	// Valid row
+	t.Run("valid row", func(t *testing.T) {
+		v, err := domain.ParseVehicleRow([]string{" 1HGCM8267LA123456 ", "2020", "", "true"}, columns)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.Vehicle{VIN: "1HGCM8267LA123456", Year: 2020, SmallScratches: true}, v)
+	})
+
+	// Short row leaves missing fields empty
+	t.Run("short row", func(t *testing.T) {
+		v, err := domain.ParseVehicleRow([]string{"1HGCM8267LA123456"}, columns)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.Vehicle{VIN: "1HGCM8267LA123456"}, v)
+	})
+
+	// Invalid values
+	t.Run("invalid number", func(t *testing.T) {
+		_, err := domain.ParseVehicleRow([]string{"1HGCM8267LA123456", "twenty"}, columns)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("invalid flag", func(t *testing.T) {
+		_, err := domain.ParseVehicleRow([]string{"1HGCM8267LA123456", "2020", "1000", "maybe"}, columns)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+}
+
+// TestImportResult_WriteReport tests the WriteReport method of the ImportResult struct
+func TestImportResult_WriteReport(t *testing.T) {
+	res := &domain.ImportResult{Header: []string{"vin", "year"}}
+	res.Reject(3, "123", "validation failed", []string{"123", "2020"})
+
+	var buf bytes.Buffer
+	assert.NoError(t, res.WriteReport(&buf))
+	assert.Equal(t, "vin,year,line,error\n123,2020,3,validation failed\n", buf.String())
+	assert.Equal(t, 1, res.Rejected)
+}
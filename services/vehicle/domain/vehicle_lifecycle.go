@@ -0,0 +1,62 @@
+package domain
+
+import "slices"
+
+// Lifecycle statuses of a vehicle in the remarketing pipeline
+const (
+	LifecycleDraft     = "draft"
+	LifecycleInspected = "inspected"
+	LifecyclePriced    = "priced"
+	LifecycleListed    = "listed"
+	LifecycleSold      = "sold"
+	LifecycleUnsold    = "unsold"
+	LifecycleDelivered = "delivered"
+	LifecycleWithdrawn = "withdrawn"
+)
+
+// lifecycleTransitions are the allowed moves from each lifecycle status, an unsold vehicle may be listed again,
+// a vehicle may be withdrawn until it is sold and a withdrawn vehicle starts over as a draft
+var lifecycleTransitions = map[string][]string{
+	LifecycleDraft:     {LifecycleInspected, LifecycleWithdrawn},
+	LifecycleInspected: {LifecyclePriced, LifecycleWithdrawn},
+	LifecyclePriced:    {LifecycleListed, LifecycleWithdrawn},
+	LifecycleListed:    {LifecycleSold, LifecycleUnsold, LifecycleWithdrawn},
+	LifecycleSold:      {LifecycleDelivered},
+	LifecycleUnsold:    {LifecycleListed, LifecycleWithdrawn},
+	LifecycleDelivered: {},
+	LifecycleWithdrawn: {LifecycleDraft},
+}
+
+// LifecycleStatuses lists all lifecycle statuses in pipeline order
+var LifecycleStatuses = []string{
+	LifecycleDraft, LifecycleInspected, LifecyclePriced, LifecycleListed,
+	LifecycleSold, LifecycleUnsold, LifecycleDelivered, LifecycleWithdrawn,
+}
+
+// LifecycleTransitions returns the statuses the vehicle can move to from the given one
+func LifecycleTransitions(from string) []string {
+	return slices.Clone(lifecycleTransitions[from])
+}
+
+// Transition moves the vehicle to the lifecycle status if the pipeline allows it, the lifecycle of a vehicle
+// with a pending deal or claimed by a sale is left to the sale flow which settles it;
+// leaving the listed status ends the buy-now listing of the vehicle
+func (v *Vehicle) Transition(to string) error {
+	if !slices.Contains(LifecycleStatuses, to) {
+		return ErrValidation
+	}
+	switch v.Status {
+	case SalePending:
+		return ErrSalePending
+	case SaleClaimed:
+		return ErrSaleClaimed
+	}
+	if !slices.Contains(lifecycleTransitions[v.Lifecycle], to) {
+		return &InvalidTransitionError{From: v.Lifecycle, To: to}
+	}
+	if v.Status == SaleAvailable {
+		v.Sale = Sale{}
+	}
+	v.Lifecycle = to
+	return nil
+}
@@ -0,0 +1,120 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// TestVehicle_Transition tests the lifecycle transitions of a vehicle
+func TestVehicle_Transition(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		err  error
+	}{
+		{name: "inspect draft", from: domain.LifecycleDraft, to: domain.LifecycleInspected},
+		{name: "price inspected", from: domain.LifecycleInspected, to: domain.LifecyclePriced},
+		{name: "list priced", from: domain.LifecyclePriced, to: domain.LifecycleListed},
+		{name: "sell listed", from: domain.LifecycleListed, to: domain.LifecycleSold},
+		{name: "listed unsold", from: domain.LifecycleListed, to: domain.LifecycleUnsold},
+		{name: "relist unsold", from: domain.LifecycleUnsold, to: domain.LifecycleListed},
+		{name: "deliver sold", from: domain.LifecycleSold, to: domain.LifecycleDelivered},
+		{name: "withdraw listed", from: domain.LifecycleListed, to: domain.LifecycleWithdrawn},
+		{name: "withdraw draft", from: domain.LifecycleDraft, to: domain.LifecycleWithdrawn},
+		{name: "reinstate withdrawn", from: domain.LifecycleWithdrawn, to: domain.LifecycleDraft},
+		{name: "skip inspection", from: domain.LifecycleDraft, to: domain.LifecyclePriced, err: domain.ErrInvalidTransition},
+		{name: "sell unlisted", from: domain.LifecyclePriced, to: domain.LifecycleSold, err: domain.ErrInvalidTransition},
+		{name: "withdraw sold", from: domain.LifecycleSold, to: domain.LifecycleWithdrawn, err: domain.ErrInvalidTransition},
+		{name: "leave delivered", from: domain.LifecycleDelivered, to: domain.LifecycleListed, err: domain.ErrInvalidTransition},
+		{name: "stay in place", from: domain.LifecycleListed, to: domain.LifecycleListed, err: domain.ErrInvalidTransition},
+		{name: "unknown status", from: domain.LifecycleDraft, to: "auctioned", err: domain.ErrValidation},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := newTestVehicle()
+			v.Lifecycle = test.from
+			err := v.Transition(test.to)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+				assert.Equal(t, test.from, v.Lifecycle)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.to, v.Lifecycle)
+		})
+	}
+}
+
+// TestInvalidTransitionError tests the message of an invalid lifecycle transition
+func TestInvalidTransitionError(t *testing.T) {
+	v := newTestVehicle()
+	v.Lifecycle = domain.LifecycleDraft
+	err := v.Transition(domain.LifecycleListed)
+	assert.EqualError(t, err, "invalid lifecycle transition from draft to listed")
+	assert.Equal(t, []string{domain.LifecycleInspected, domain.LifecycleWithdrawn}, domain.LifecycleTransitions(domain.LifecycleDraft))
+}
+
+// TestVehicle_TransitionOnSale tests leaving the listed status while the vehicle is on sale
+func TestVehicle_TransitionOnSale(t *testing.T) {
+	tests := []struct {
+		name string
+		sale domain.Sale
+		to   string
+		err  error
+	}{
+		{name: "sell available", sale: domain.Sale{Status: domain.SaleAvailable, BuyNowPrice: 30_000}, to: domain.LifecycleSold},
+		{name: "unsold available", sale: domain.Sale{Status: domain.SaleAvailable, BuyNowPrice: 30_000}, to: domain.LifecycleUnsold},
+		{name: "withdraw available", sale: domain.Sale{Status: domain.SaleAvailable, BuyNowPrice: 30_000}, to: domain.LifecycleWithdrawn},
+		{name: "sell pending", sale: domain.Sale{Status: domain.SalePending, BuyNowPrice: 30_000, Buyer: "alice", SalePrice: 30_000}, to: domain.LifecycleSold, err: domain.ErrSalePending},
+		{name: "unsold pending", sale: domain.Sale{Status: domain.SalePending, BuyNowPrice: 30_000, Buyer: "alice", SalePrice: 30_000}, to: domain.LifecycleUnsold, err: domain.ErrSalePending},
+		{name: "withdraw pending", sale: domain.Sale{Status: domain.SalePending, BuyNowPrice: 30_000, Buyer: "alice", SalePrice: 30_000}, to: domain.LifecycleWithdrawn, err: domain.ErrSalePending},
+		{name: "sell claimed", sale: domain.Sale{Status: domain.SaleClaimed, Channel: "auction", SaleID: "a1"}, to: domain.LifecycleSold, err: domain.ErrSaleClaimed},
+		{name: "unsold claimed", sale: domain.Sale{Status: domain.SaleClaimed, Channel: "auction", SaleID: "a1"}, to: domain.LifecycleUnsold, err: domain.ErrSaleClaimed},
+		{name: "withdraw claimed", sale: domain.Sale{Status: domain.SaleClaimed, Channel: "auction", SaleID: "a1"}, to: domain.LifecycleWithdrawn, err: domain.ErrSaleClaimed},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := newTestVehicle()
+			v.Lifecycle = domain.LifecycleListed
+			v.Sale = test.sale
+			err := v.Transition(test.to)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+				assert.Equal(t, domain.LifecycleListed, v.Lifecycle)
+				assert.Equal(t, test.sale, v.Sale)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.to, v.Lifecycle)
+			assert.Equal(t, domain.Sale{}, v.Sale, "the buy-now listing ends")
+			assert.ErrorIs(t, v.Purchase("alice"), domain.ErrNotForSale)
+		})
+	}
+}
+
+// TestCloseOffers tests rejecting the offers of an ended buy-now listing
+func TestCloseOffers(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	offers := []*domain.Offer{
+		{ID: 1, Status: domain.OfferOpen},
+		{ID: 2, Status: domain.OfferCountered},
+		{ID: 3, Status: domain.OfferRejected},
+		{ID: 4, Status: domain.OfferAccepted},
+	}
+	closed := domain.CloseOffers(offers, now)
+	assert.Equal(t, []*domain.Offer{offers[0], offers[1]}, closed)
+	for _, o := range closed {
+		assert.Equal(t, domain.OfferRejected, o.Status)
+		assert.Equal(t, now, o.UpdatedAt)
+	}
+	assert.Equal(t, domain.OfferAccepted, offers[3].Status)
+}
@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Sortable fields of a vehicles listing
+const (
+	SortByVIN      = "vin"
+	SortByYear     = "year"
+	SortByOdometer = "odometer"
+	SortByGrade    = "grade"
+	SortByPrice    = "price"
+	SortByMSRP     = "msrp"
+	SortByBrand    = "brand"
+)
+
+// Sort orders of a vehicles listing
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Page size limits of a vehicles listing
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// VehicleQuery describes filtering, sorting and pagination of a vehicles listing,
+// zero values of the range bounds mean the bound is not set
+type VehicleQuery struct {
+	Brand        string
+	BodyClass    string
+	FuelType     string
+	YearFrom     int32
+	YearTo       int32
+	OdometerFrom int32
+	OdometerTo   int32
+	GradeFrom    int
+	GradeTo      int
+	PriceFrom    uint64
+	PriceTo      uint64
+	Colors       []string
+	Status       string
+	Lifecycle    string
+	SortBy       string
+	Order        string
+	Limit        int
+	PageToken    string
+}
+
+// VehiclesPage represents a single page of a vehicles listing
+type VehiclesPage struct {
+	Vehicles      []*Vehicle `json:"vehicles"`
+	NextPageToken string     `json:"next_page_token,omitempty"`
+}
+
+// pageCursor is the decoded form of a page token
+type pageCursor struct {
+	SortBy string          `json:"s"`
+	Order  string          `json:"o"`
+	VIN    string          `json:"v"`
+	Key    json.RawMessage `json:"k"`
+}
+
+// NewVehicleQuery creates a new VehicleQuery with default sorting and page size
+func NewVehicleQuery() *VehicleQuery {
+	return &VehicleQuery{
+		SortBy: SortByVIN,
+		Order:  OrderAsc,
+		Limit:  DefaultPageSize,
+	}
+}
+
+// Validate checks if the query parameters are valid
+func (q *VehicleQuery) Validate() error {
+	return validation.ValidateStruct(
+		q,
+		validation.Field(
+			&q.SortBy,
+			validation.In(SortByVIN, SortByYear, SortByOdometer, SortByGrade, SortByPrice, SortByMSRP, SortByBrand),
+		),
+		validation.Field(
+			&q.Order,
+			validation.In(OrderAsc, OrderDesc),
+		),
+		validation.Field(
+			&q.Status,
+			validation.In(SaleAvailable, SalePending, SaleSold, SaleClaimed),
+		),
+		validation.Field(
+			&q.Lifecycle,
+			validation.In(
+				LifecycleDraft, LifecycleInspected, LifecyclePriced, LifecycleListed,
+				LifecycleSold, LifecycleUnsold, LifecycleDelivered, LifecycleWithdrawn,
+			),
+		),
+		validation.Field(
+			&q.Limit,
+			validation.Min(1),
+			validation.Max(MaxPageSize),
+		),
+		validation.Field(
+			&q.YearTo,
+			validation.When(q.YearTo != 0, validation.Min(q.YearFrom)),
+		),
+		validation.Field(
+			&q.OdometerTo,
+			validation.When(q.OdometerTo != 0, validation.Min(q.OdometerFrom)),
+		),
+		validation.Field(
+			&q.GradeTo,
+			validation.When(q.GradeTo != 0, validation.Min(q.GradeFrom)),
+		),
+		validation.Field(
+			&q.PriceTo,
+			validation.When(q.PriceTo != 0, validation.Min(q.PriceFrom)),
+		),
+	)
+}
+
+// Match reports whether the vehicle satisfies the query filters
+func (q *VehicleQuery) Match(v *Vehicle) bool {
+	switch {
+	case q.Brand != "" && !strings.EqualFold(q.Brand, v.Brand):
+		return false
+	case q.BodyClass != "" && !strings.EqualFold(q.BodyClass, v.BodyClass):
+		return false
+	case q.FuelType != "" && !strings.EqualFold(q.FuelType, v.FuelType):
+		return false
+	case q.YearFrom != 0 && v.Year < q.YearFrom, q.YearTo != 0 && v.Year > q.YearTo:
+		return false
+	case q.OdometerFrom != 0 && v.Odometer < q.OdometerFrom, q.OdometerTo != 0 && v.Odometer > q.OdometerTo:
+		return false
+	case q.GradeFrom != 0 && v.Grade < q.GradeFrom, q.GradeTo != 0 && v.Grade > q.GradeTo:
+		return false
+	case q.PriceFrom != 0 && v.Price < q.PriceFrom, q.PriceTo != 0 && v.Price > q.PriceTo:
+		return false
+	case q.Status != "" && v.Status != q.Status:
+		return false
+	case q.Lifecycle != "" && v.Lifecycle != q.Lifecycle:
+		return false
+	}
+	if len(q.Colors) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(q.Colors, func(c string) bool {
+		return strings.EqualFold(c, v.ExteriorColor)
+	})
+}
+
+// SortValue returns the value of the sort field of the vehicle
+func (q *VehicleQuery) SortValue(v *Vehicle) any {
+	switch q.SortBy {
+	case SortByYear:
+		return v.Year
+	case SortByOdometer:
+		return v.Odometer
+	case SortByGrade:
+		return v.Grade
+	case SortByPrice:
+		return v.Price
+	case SortByMSRP:
+		return v.MSRP
+	case SortByBrand:
+		return v.Brand
+	default:
+		return v.VIN
+	}
+}
+
+// Compare compares two vehicles by the sort field and then by VIN, respecting the sort order
+func (q *VehicleQuery) Compare(a, b *Vehicle) int {
+	var c int
+	switch q.SortBy {
+	case SortByYear:
+		c = cmp.Compare(a.Year, b.Year)
+	case SortByOdometer:
+		c = cmp.Compare(a.Odometer, b.Odometer)
+	case SortByGrade:
+		c = cmp.Compare(a.Grade, b.Grade)
+	case SortByPrice:
+		c = cmp.Compare(a.Price, b.Price)
+	case SortByMSRP:
+		c = cmp.Compare(a.MSRP, b.MSRP)
+	case SortByBrand:
+		c = cmp.Compare(a.Brand, b.Brand)
+	}
+	if c == 0 {
+		c = cmp.Compare(a.VIN, b.VIN)
+	}
+	if q.Order == OrderDesc {
+		return -c
+	}
+	return c
+}
+
+// NextPageToken encodes the position right after the given vehicle into a page token
+func (q *VehicleQuery) NextPageToken(last *Vehicle) string {
+	key, _ := json.Marshal(q.SortValue(last))
+	b, _ := json.Marshal(pageCursor{SortBy: q.SortBy, Order: q.Order, VIN: last.VIN, Key: key})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Cursor decodes the page token into the last vehicle of the previous page,
+// only the VIN and the sort field are set; nil is returned for the first page
+func (q *VehicleQuery) Cursor() (*Vehicle, error) {
+	if q.PageToken == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(q.PageToken)
+	if err != nil {
+		return nil, ErrValidation
+	}
+	var c pageCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrValidation
+	}
+	if c.SortBy != q.SortBy || c.Order != q.Order || c.VIN == "" {
+		return nil, ErrValidation
+	}
+
+	// Restore the sort field value
+	v := &Vehicle{VIN: c.VIN}
+	var target any
+	switch q.SortBy {
+	case SortByYear:
+		target = &v.Year
+	case SortByOdometer:
+		target = &v.Odometer
+	case SortByGrade:
+		target = &v.Grade
+	case SortByPrice:
+		target = &v.Price
+	case SortByMSRP:
+		target = &v.MSRP
+	case SortByBrand:
+		target = &v.Brand
+	default:
+		return v, nil
+	}
+	if err := json.Unmarshal(c.Key, target); err != nil {
+		return nil, ErrValidation
+	}
+	return v, nil
+}
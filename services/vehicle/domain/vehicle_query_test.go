@@ -0,0 +1,220 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// qTest is a struct for vehicle query tests
+type qTest struct {
+	name    string
+	data    func() *domain.VehicleQuery
+	isValid bool
+}
+
+// TestVehicleQuery_Validate tests the Validate method of the VehicleQuery struct
+func TestVehicleQuery_Validate(t *testing.T) {
+	tests := []qTest{
+		{
+			name: "default query",
+			data: func() *domain.VehicleQuery {
+				return domain.NewVehicleQuery()
+			},
+			isValid: true,
+		},
+		{
+			name: "unknown sort field",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.SortBy = "color"
+				return q
+			},
+			isValid: false,
+		},
+		{
+			name: "unknown order",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.Order = "up"
+				return q
+			},
+			isValid: false,
+		},
+		{
+			name: "too large page",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.Limit = domain.MaxPageSize + 1
+				return q
+			},
+			isValid: false,
+		},
+		{
+			name: "inverted year range",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.YearFrom = 2020
+				q.YearTo = 2010
+				return q
+			},
+			isValid: false,
+		},
+		{
+			name: "open price range",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.PriceFrom = 10_000
+				return q
+			},
+			isValid: true,
+		},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.isValid {
+				assert.NoError(t, test.data().Validate())
+			} else {
+				assert.Error(t, test.data().Validate())
+			}
+		})
+	}
+}
+
+// TestVehicleQuery_Match tests the Match method of the VehicleQuery struct
+func TestVehicleQuery_Match(t *testing.T) {
+	v := newTestVehicle()
+	v.Brand = "Honda"
+	v.ExteriorColor = "Red"
+	v.Price = 20_000
+	v.BodyClass = "Sedan/Saloon"
+	v.FuelType = "Gasoline"
+
+	tests := []qTest{
+		{
+			name: "no filters",
+			data: func() *domain.VehicleQuery {
+				return domain.NewVehicleQuery()
+			},
+			isValid: true,
+		},
+		{
+			name: "brand is case insensitive",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.Brand = "HONDA"
+				return q
+			},
+			isValid: true,
+		},
+		{
+			name: "body class and fuel type are case insensitive",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.BodyClass = "sedan/saloon"
+				q.FuelType = "GASOLINE"
+				return q
+			},
+			isValid: true,
+		},
+		{
+			name: "other fuel type",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.FuelType = "Electric"
+				return q
+			},
+			isValid: false,
+		},
+		{
+			name: "year out of range",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.YearFrom = 2023
+				return q
+			},
+			isValid: false,
+		},
+		{
+			name: "price within range",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.PriceFrom = 15_000
+				q.PriceTo = 25_000
+				return q
+			},
+			isValid: true,
+		},
+		{
+			name: "one of colors",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.Colors = []string{"black", "red"}
+				return q
+			},
+			isValid: true,
+		},
+		{
+			name: "other colors",
+			data: func() *domain.VehicleQuery {
+				q := domain.NewVehicleQuery()
+				q.Colors = []string{"black", "white"}
+				return q
+			},
+			isValid: false,
+		},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.isValid, test.data().Match(v))
+		})
+	}
+}
+
+// TestVehicleQuery_Cursor tests the page token round trip of the VehicleQuery struct
+func TestVehicleQuery_Cursor(t *testing.T) {
+	v := newTestVehicle()
+	v.Price = 20_000
+
+	// First page has no cursor
+	t.Run("no page token", func(t *testing.T) {
+		cursor, err := domain.NewVehicleQuery().Cursor()
+		assert.NoError(t, err)
+		assert.Nil(t, cursor)
+	})
+
+	// Token restores the VIN and the sort field
+	t.Run("round trip", func(t *testing.T) {
+		q := domain.NewVehicleQuery()
+		q.SortBy = domain.SortByPrice
+		q.Order = domain.OrderDesc
+		q.PageToken = q.NextPageToken(v)
+		cursor, err := q.Cursor()
+		assert.NoError(t, err)
+		assert.Equal(t, v.VIN, cursor.VIN)
+		assert.Equal(t, v.Price, cursor.Price)
+	})
+
+	// Token issued for another sorting is rejected
+	t.Run("sort mismatch", func(t *testing.T) {
+		q := domain.NewVehicleQuery()
+		q.PageToken = q.NextPageToken(v)
+		q.SortBy = domain.SortByYear
+		_, err := q.Cursor()
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	// Garbage token is rejected
+	t.Run("malformed token", func(t *testing.T) {
+		q := domain.NewVehicleQuery()
+		q.PageToken = "not a token"
+		_, err := q.Cursor()
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+}
@@ -0,0 +1,269 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Sale statuses of a vehicle, vehicles that were never listed have no sale status,
+// a claimed vehicle is on sale through another channel such as an auction
+const (
+	SaleAvailable = "available"
+	SalePending   = "pending"
+	SaleSold      = "sold"
+	SaleClaimed   = "claimed"
+)
+
+// Statuses of an offer
+const (
+	OfferOpen      = "open"
+	OfferCountered = "countered"
+	OfferAccepted  = "accepted"
+	OfferRejected  = "rejected"
+)
+
+// Sale is the sale state of a vehicle, the buyer and the sale price are set once a deal is made,
+// the channel and the sale ID identify the sale of another channel that claimed the vehicle
+type Sale struct {
+	Status      string `json:"status,omitempty"`
+	BuyNowPrice uint64 `json:"buy_now_price,omitempty"`
+	Buyer       string `json:"buyer,omitempty"`
+	SalePrice   uint64 `json:"sale_price,omitempty"`
+	Channel     string `json:"sale_channel,omitempty"`
+	SaleID      string `json:"sale_id,omitempty"`
+}
+
+// Offer represents a buyer's offer below the buy-now price, the seller may accept, reject or counter it
+type Offer struct {
+	ID        int64     `json:"id"`
+	VIN       string    `json:"vin"`
+	Buyer     string    `json:"buyer"`
+	Amount    uint64    `json:"amount"`
+	Counter   uint64    `json:"counter,omitempty"`
+	Status    string    `json:"status"`
+	Version   int64     `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BuyNowPrice returns the recommended price raised by the markup percent, rounded up
+func BuyNowPrice(price, markup uint64) uint64 {
+	return price + (price*markup+99)/100
+}
+
+// ListForSale makes the listed vehicle available at the buy-now price, an available vehicle may be repriced
+func (v *Vehicle) ListForSale(price uint64) error {
+	if v.Lifecycle != LifecycleListed {
+		return ErrNotForSale
+	}
+	switch v.Status {
+	case SalePending:
+		return ErrSalePending
+	case SaleClaimed:
+		return ErrSaleClaimed
+	case SaleSold:
+		return ErrNotForSale
+	}
+	if price == 0 {
+		return ErrValidation
+	}
+	v.Sale = Sale{Status: SaleAvailable, BuyNowPrice: price}
+	return nil
+}
+
+// Purchase reserves the vehicle for the buyer at the buy-now price
+func (v *Vehicle) Purchase(buyer string) error {
+	if buyer == "" {
+		return ErrValidation
+	}
+	return v.reserve(buyer, v.BuyNowPrice)
+}
+
+// reserve moves an available listed vehicle to the pending status of a deal with the buyer
+func (v *Vehicle) reserve(buyer string, price uint64) error {
+	if v.Status != SaleAvailable || v.Lifecycle != LifecycleListed {
+		return v.notAvailable()
+	}
+	v.Status = SalePending
+	v.Buyer = buyer
+	v.SalePrice = price
+	return nil
+}
+
+// notAvailable returns the error of a vehicle that cannot be sold now
+func (v *Vehicle) notAvailable() error {
+	switch v.Status {
+	case SalePending:
+		return ErrSalePending
+	case SaleClaimed:
+		return ErrSaleClaimed
+	}
+	return ErrNotForSale
+}
+
+// CompleteSale marks the pending deal as sold and moves the vehicle to the sold lifecycle status,
+// the vehicle is still listed as its lifecycle cannot change while the deal is pending
+func (v *Vehicle) CompleteSale() error {
+	if v.Status != SalePending {
+		return ErrNoPendingSale
+	}
+	v.Status = SaleSold
+	v.Lifecycle = LifecycleSold
+	return nil
+}
+
+// CancelSale drops the pending deal and makes the vehicle available again
+func (v *Vehicle) CancelSale() error {
+	if v.Status != SalePending {
+		return ErrNoPendingSale
+	}
+	v.Sale = Sale{Status: SaleAvailable, BuyNowPrice: v.BuyNowPrice}
+	return nil
+}
+
+// ClaimSale reserves the listed vehicle for the sale of another channel, a vehicle on a buy-now sale
+// or claimed by another sale cannot be claimed, claiming it again for the same sale changes nothing
+func (v *Vehicle) ClaimSale(channel, saleID string) (bool, error) {
+	if channel == "" || saleID == "" {
+		return false, ErrValidation
+	}
+	if v.Status == SaleClaimed && v.SaleID == saleID {
+		return false, nil
+	}
+	if v.Lifecycle != LifecycleListed {
+		return false, ErrNotForSale
+	}
+	if v.Status != "" {
+		return false, v.notAvailable()
+	}
+	v.Sale = Sale{Status: SaleClaimed, Channel: channel, SaleID: saleID}
+	return true, nil
+}
+
+// SettleSale records the outcome of the sale that claimed the vehicle, sold to the buyer at the price
+// or unsold without a buyer, settling the same sale again changes nothing; a listed vehicle of a sale
+// opened before the claims were introduced is settled as well
+func (v *Vehicle) SettleSale(channel, saleID, buyer string, price uint64) (bool, error) {
+	if saleID == "" {
+		return false, ErrValidation
+	}
+	if v.SaleID == saleID && v.Status != SaleClaimed {
+		return false, nil
+	}
+	switch {
+	case v.Status == SaleClaimed && v.SaleID != saleID:
+		return false, ErrSaleClaimed
+	case v.Status != SaleClaimed && (v.Status != "" || v.Lifecycle != LifecycleListed):
+		return false, ErrNotForSale
+	}
+	if buyer == "" {
+		v.Sale = Sale{Channel: channel, SaleID: saleID}
+		v.Lifecycle = LifecycleUnsold
+		return true, nil
+	}
+	v.Sale = Sale{Status: SaleSold, Buyer: buyer, SalePrice: price, Channel: channel, SaleID: saleID}
+	v.Lifecycle = LifecycleSold
+	return true, nil
+}
+
+// ReleaseSale drops the claim of a sale that was never opened, the vehicle stays listed,
+// releasing a vehicle not claimed by the sale changes nothing
+func (v *Vehicle) ReleaseSale(saleID string) bool {
+	if v.Status != SaleClaimed || v.SaleID != saleID {
+		return false
+	}
+	v.Sale = Sale{}
+	return true
+}
+
+// MakeOffer creates an offer of the buyer, offers at or above the buy-now price should purchase instead
+func (v *Vehicle) MakeOffer(buyer string, amount uint64, now time.Time) (*Offer, error) {
+	if v.Status != SaleAvailable || v.Lifecycle != LifecycleListed {
+		return nil, v.notAvailable()
+	}
+	if buyer == "" || amount == 0 {
+		return nil, ErrValidation
+	}
+	if amount >= v.BuyNowPrice {
+		return nil, fmt.Errorf("%w: offer must be below the buy-now price", ErrValidation)
+	}
+	return &Offer{
+		VIN:       v.VIN,
+		Buyer:     buyer,
+		Amount:    amount,
+		Status:    OfferOpen,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// AcceptOffer accepts an open offer and reserves the vehicle for its buyer at the offered amount
+func (v *Vehicle) AcceptOffer(o *Offer, now time.Time) error {
+	if o.Status != OfferOpen {
+		return ErrOfferClosed
+	}
+	if err := v.reserve(o.Buyer, o.Amount); err != nil {
+		return err
+	}
+	o.close(OfferAccepted, now)
+	return nil
+}
+
+// CounterOffer answers an open offer with a higher amount, at most the buy-now price
+func (v *Vehicle) CounterOffer(o *Offer, amount uint64, now time.Time) error {
+	if v.Status != SaleAvailable {
+		return v.notAvailable()
+	}
+	if o.Status != OfferOpen {
+		return ErrOfferClosed
+	}
+	if amount <= o.Amount || amount > v.BuyNowPrice {
+		return fmt.Errorf("%w: counter offer must be above the offer and at most the buy-now price", ErrValidation)
+	}
+	o.Status = OfferCountered
+	o.Counter = amount
+	o.UpdatedAt = now
+	return nil
+}
+
+// AcceptCounterOffer lets the buyer of a countered offer accept the counter amount
+func (v *Vehicle) AcceptCounterOffer(o *Offer, buyer string, now time.Time) error {
+	if o.Status != OfferCountered {
+		return ErrOfferClosed
+	}
+	if buyer != o.Buyer {
+		return ErrNotBuyer
+	}
+	if err := v.reserve(o.Buyer, o.Counter); err != nil {
+		return err
+	}
+	o.close(OfferAccepted, now)
+	return nil
+}
+
+// Reject rejects an open or countered offer
+func (o *Offer) Reject(now time.Time) error {
+	if o.Status != OfferOpen && o.Status != OfferCountered {
+		return ErrOfferClosed
+	}
+	o.close(OfferRejected, now)
+	return nil
+}
+
+// CloseOffers rejects the open and countered offers of a vehicle whose buy-now listing has ended
+// and returns the rejected ones
+func CloseOffers(offers []*Offer, now time.Time) []*Offer {
+	var closed []*Offer
+	for _, o := range offers {
+		if o.Reject(now) == nil {
+			closed = append(closed, o)
+		}
+	}
+	return closed
+}
+
+// close moves the offer to a final status
+func (o *Offer) close(status string, now time.Time) {
+	o.Status = status
+	o.UpdatedAt = now
+}
@@ -0,0 +1,238 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// newTestSaleVehicle is a test listed vehicle available at a buy-now price of 30,000
+func newTestSaleVehicle() *domain.Vehicle {
+	v := newTestVehicle()
+	v.Lifecycle = domain.LifecycleListed
+	v.Sale = domain.Sale{Status: domain.SaleAvailable, BuyNowPrice: 30_000}
+	return v
+}
+
+// TestBuyNowPrice tests the BuyNowPrice function
+func TestBuyNowPrice(t *testing.T) {
+	assert.Equal(t, uint64(27_500), domain.BuyNowPrice(25_000, 10))
+	assert.Equal(t, uint64(25_000), domain.BuyNowPrice(25_000, 0))
+	assert.Equal(t, uint64(1_111), domain.BuyNowPrice(1_010, 10), "rounded up")
+}
+
+// TestVehicle_SaleLifecycle tests the status transitions of a vehicle sale
+func TestVehicle_SaleLifecycle(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    string
+		lifecycle string
+		action    func(v *domain.Vehicle) error
+		err       error
+		expected  string
+	}{
+		{name: "list unlisted", status: "", action: func(v *domain.Vehicle) error { return v.ListForSale(30_000) }, expected: domain.SaleAvailable},
+		{name: "reprice available", status: domain.SaleAvailable, action: func(v *domain.Vehicle) error { return v.ListForSale(29_000) }, expected: domain.SaleAvailable},
+		{name: "list without price", status: "", action: func(v *domain.Vehicle) error { return v.ListForSale(0) }, err: domain.ErrValidation},
+		{name: "list pending", status: domain.SalePending, action: func(v *domain.Vehicle) error { return v.ListForSale(30_000) }, err: domain.ErrSalePending},
+		{name: "list sold", status: domain.SaleSold, action: func(v *domain.Vehicle) error { return v.ListForSale(30_000) }, err: domain.ErrNotForSale},
+		{name: "list claimed", status: domain.SaleClaimed, action: func(v *domain.Vehicle) error { return v.ListForSale(30_000) }, err: domain.ErrSaleClaimed},
+		{name: "purchase available", status: domain.SaleAvailable, action: func(v *domain.Vehicle) error { return v.Purchase("alice") }, expected: domain.SalePending},
+		{name: "purchase without buyer", status: domain.SaleAvailable, action: func(v *domain.Vehicle) error { return v.Purchase("") }, err: domain.ErrValidation},
+		{name: "purchase unlisted", status: "", action: func(v *domain.Vehicle) error { return v.Purchase("alice") }, err: domain.ErrNotForSale},
+		{name: "purchase pending", status: domain.SalePending, action: func(v *domain.Vehicle) error { return v.Purchase("bob") }, err: domain.ErrSalePending},
+		{name: "purchase claimed", status: domain.SaleClaimed, action: func(v *domain.Vehicle) error { return v.Purchase("bob") }, err: domain.ErrSaleClaimed},
+		{name: "complete pending", status: domain.SalePending, action: (*domain.Vehicle).CompleteSale, expected: domain.SaleSold},
+		{name: "complete available", status: domain.SaleAvailable, action: (*domain.Vehicle).CompleteSale, err: domain.ErrNoPendingSale},
+		{name: "cancel pending", status: domain.SalePending, action: (*domain.Vehicle).CancelSale, expected: domain.SaleAvailable},
+		{name: "cancel sold", status: domain.SaleSold, action: (*domain.Vehicle).CancelSale, err: domain.ErrNoPendingSale},
+		{name: "list not listed", status: "", lifecycle: domain.LifecyclePriced, action: func(v *domain.Vehicle) error { return v.ListForSale(30_000) }, err: domain.ErrNotForSale},
+		{name: "purchase withdrawn", status: domain.SaleAvailable, lifecycle: domain.LifecycleWithdrawn, action: func(v *domain.Vehicle) error { return v.Purchase("alice") }, err: domain.ErrNotForSale},
+	}
+
+	// Run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := newTestSaleVehicle()
+			v.Status = test.status
+			if test.lifecycle != "" {
+				v.Lifecycle = test.lifecycle
+			}
+			err := test.action(v)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+				assert.Equal(t, test.status, v.Status)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, v.Status)
+		})
+	}
+}
+
+// TestVehicle_ClaimSale tests claiming a vehicle for the sale of another channel and settling the sale
+func TestVehicle_ClaimSale(t *testing.T) {
+	claimed := func() *domain.Vehicle {
+		v := newTestSaleVehicle()
+		v.Sale = domain.Sale{}
+		changed, err := v.ClaimSale("auction", "a1")
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		return v
+	}
+
+	t.Run("claim", func(t *testing.T) {
+		v := claimed()
+		assert.Equal(t, domain.Sale{Status: domain.SaleClaimed, Channel: "auction", SaleID: "a1"}, v.Sale)
+
+		changed, err := v.ClaimSale("auction", "a1")
+		assert.NoError(t, err)
+		assert.False(t, changed, "claiming again for the same sale changes nothing")
+		_, err = v.ClaimSale("tender", "t1")
+		assert.ErrorIs(t, err, domain.ErrSaleClaimed)
+		_, err = v.MakeOffer("alice", 20_000, time.Now())
+		assert.ErrorIs(t, err, domain.ErrSaleClaimed)
+		assert.ErrorIs(t, v.Transition(domain.LifecycleWithdrawn), domain.ErrSaleClaimed)
+	})
+
+	t.Run("claim unavailable", func(t *testing.T) {
+		_, err := newTestSaleVehicle().ClaimSale("auction", "a1")
+		assert.ErrorIs(t, err, domain.ErrNotForSale, "on a buy-now sale")
+		v := claimed()
+		v.Lifecycle = domain.LifecyclePriced
+		v.Sale = domain.Sale{}
+		_, err = v.ClaimSale("auction", "a1")
+		assert.ErrorIs(t, err, domain.ErrNotForSale, "not listed")
+		_, err = claimed().ClaimSale("auction", "")
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("settle sold", func(t *testing.T) {
+		v := claimed()
+		changed, err := v.SettleSale("auction", "a1", "alice", 21_000)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, domain.Sale{Status: domain.SaleSold, Buyer: "alice", SalePrice: 21_000, Channel: "auction", SaleID: "a1"}, v.Sale)
+		assert.Equal(t, domain.LifecycleSold, v.Lifecycle)
+
+		changed, err = v.SettleSale("auction", "a1", "alice", 21_000)
+		assert.NoError(t, err)
+		assert.False(t, changed, "settling again changes nothing")
+	})
+
+	t.Run("settle unsold", func(t *testing.T) {
+		v := claimed()
+		changed, err := v.SettleSale("auction", "a1", "", 0)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, domain.Sale{Channel: "auction", SaleID: "a1"}, v.Sale)
+		assert.Equal(t, domain.LifecycleUnsold, v.Lifecycle)
+	})
+
+	t.Run("settle another sale", func(t *testing.T) {
+		_, err := claimed().SettleSale("tender", "t1", "alice", 21_000)
+		assert.ErrorIs(t, err, domain.ErrSaleClaimed)
+		_, err = newTestSaleVehicle().SettleSale("tender", "t1", "alice", 21_000)
+		assert.ErrorIs(t, err, domain.ErrNotForSale)
+	})
+
+	t.Run("settle unclaimed listed vehicle", func(t *testing.T) {
+		v := newTestSaleVehicle()
+		v.Sale = domain.Sale{}
+		changed, err := v.SettleSale("auction", "a1", "alice", 21_000)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, domain.LifecycleSold, v.Lifecycle)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		v := claimed()
+		assert.False(t, v.ReleaseSale("t1"), "claimed by another sale")
+		assert.True(t, v.ReleaseSale("a1"))
+		assert.Equal(t, domain.Sale{}, v.Sale)
+		assert.Equal(t, domain.LifecycleListed, v.Lifecycle)
+		assert.False(t, v.ReleaseSale("a1"))
+	})
+}
+
+// TestVehicle_Purchase tests the deal made by a buy-now purchase and its cancellation
+func TestVehicle_Purchase(t *testing.T) {
+	v := newTestSaleVehicle()
+	assert.NoError(t, v.Purchase("alice"))
+	assert.Equal(t, domain.Sale{Status: domain.SalePending, BuyNowPrice: 30_000, Buyer: "alice", SalePrice: 30_000}, v.Sale)
+
+	assert.NoError(t, v.CancelSale())
+	assert.Equal(t, domain.Sale{Status: domain.SaleAvailable, BuyNowPrice: 30_000}, v.Sale)
+
+	// The completed sale moves the vehicle to the sold lifecycle status
+	assert.NoError(t, v.Purchase("bob"))
+	assert.ErrorIs(t, v.Transition(domain.LifecycleWithdrawn), domain.ErrSalePending)
+	assert.Equal(t, domain.LifecycleListed, v.Lifecycle)
+	assert.NoError(t, v.CompleteSale())
+	assert.Equal(t, domain.LifecycleSold, v.Lifecycle)
+}
+
+// TestVehicle_Offers tests making, countering, accepting and rejecting offers
+func TestVehicle_Offers(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("make offer", func(t *testing.T) {
+		v := newTestSaleVehicle()
+		o, err := v.MakeOffer("alice", 25_000, now)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OfferOpen, o.Status)
+		assert.Equal(t, v.VIN, o.VIN)
+
+		_, err = v.MakeOffer("alice", 30_000, now)
+		assert.ErrorIs(t, err, domain.ErrValidation, "at the buy-now price")
+		_, err = v.MakeOffer("", 25_000, now)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+
+		v.Status = domain.SaleSold
+		_, err = v.MakeOffer("alice", 25_000, now)
+		assert.ErrorIs(t, err, domain.ErrNotForSale)
+	})
+
+	t.Run("accept offer", func(t *testing.T) {
+		v := newTestSaleVehicle()
+		o, _ := v.MakeOffer("alice", 25_000, now)
+		other, _ := v.MakeOffer("bob", 24_000, now)
+		assert.NoError(t, v.AcceptOffer(o, now.Add(time.Minute)))
+		assert.Equal(t, domain.OfferAccepted, o.Status)
+		assert.Equal(t, now.Add(time.Minute), o.UpdatedAt)
+		assert.Equal(t, domain.Sale{Status: domain.SalePending, BuyNowPrice: 30_000, Buyer: "alice", SalePrice: 25_000}, v.Sale)
+
+		assert.ErrorIs(t, v.AcceptOffer(o, now), domain.ErrOfferClosed)
+		assert.ErrorIs(t, v.AcceptOffer(other, now), domain.ErrSalePending)
+		assert.Equal(t, domain.OfferOpen, other.Status)
+	})
+
+	t.Run("counter offer", func(t *testing.T) {
+		v := newTestSaleVehicle()
+		o, _ := v.MakeOffer("alice", 25_000, now)
+		assert.ErrorIs(t, v.CounterOffer(o, 25_000, now), domain.ErrValidation)
+		assert.ErrorIs(t, v.CounterOffer(o, 31_000, now), domain.ErrValidation)
+		assert.NoError(t, v.CounterOffer(o, 28_000, now))
+		assert.Equal(t, domain.OfferCountered, o.Status)
+		assert.ErrorIs(t, v.AcceptOffer(o, now), domain.ErrOfferClosed, "the seller has countered")
+
+		assert.ErrorIs(t, v.AcceptCounterOffer(o, "bob", now), domain.ErrNotBuyer)
+		assert.NoError(t, v.AcceptCounterOffer(o, "alice", now))
+		assert.Equal(t, domain.OfferAccepted, o.Status)
+		assert.Equal(t, uint64(28_000), v.SalePrice)
+	})
+
+	t.Run("reject offer", func(t *testing.T) {
+		v := newTestSaleVehicle()
+		o, _ := v.MakeOffer("alice", 25_000, now)
+		assert.NoError(t, v.CounterOffer(o, 28_000, now))
+		assert.NoError(t, o.Reject(now))
+		assert.Equal(t, domain.OfferRejected, o.Status)
+		assert.ErrorIs(t, o.Reject(now), domain.ErrOfferClosed)
+		assert.ErrorIs(t, v.AcceptCounterOffer(o, "alice", now), domain.ErrOfferClosed)
+		assert.Equal(t, domain.SaleAvailable, v.Status)
+	})
+}
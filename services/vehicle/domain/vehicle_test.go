@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
 
@@ -18,7 +19,7 @@ type test struct {
 // newTestVehicle is a test valid vehicle instance
 func newTestVehicle() *domain.Vehicle {
 	return &domain.Vehicle{
-		VIN:      "1HGBH41JXMN109186",
+		VIN:      "1HGBH41J8NN109186",
 		Year:     2022,
 		Odometer: 12000,
 	}
@@ -43,6 +44,33 @@ func TestVehicle_Validate(t *testing.T) {
 			},
 			isValid: false,
 		},
+		{
+			name: "VIN with forbidden letter",
+			data: func() *domain.Vehicle {
+				v := newTestVehicle()
+				v.VIN = "1HGBH41J8NO109186"
+				return v
+			},
+			isValid: false,
+		},
+		{
+			name: "VIN with wrong check digit",
+			data: func() *domain.Vehicle {
+				v := newTestVehicle()
+				v.VIN = "1HGBH41J1NN109186"
+				return v
+			},
+			isValid: false,
+		},
+		{
+			name: "year not matching VIN",
+			data: func() *domain.Vehicle {
+				v := newTestVehicle()
+				v.Year = 2021
+				return v
+			},
+			isValid: false,
+		},
 		{
 			name: "too old year",
 			data: func() *domain.Vehicle {
@@ -56,7 +84,7 @@ func TestVehicle_Validate(t *testing.T) {
 			name: "too new year",
 			data: func() *domain.Vehicle {
 				v := newTestVehicle()
-				v.Year = 2026
+				v.Year = int32(time.Now().Year() + 1) // nolint:gosec
 				return v
 			},
 			isValid: false,
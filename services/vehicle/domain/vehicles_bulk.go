@@ -2,15 +2,48 @@ package domain
 
 import validation "github.com/go-ozzo/ozzo-validation/v4"
 
+// Bulk processing modes
+const (
+	BulkModeAtomic     = "atomic"
+	BulkModeBestEffort = "best_effort"
+)
+
+// Per-vehicle statuses of a bulk operation
+const (
+	BulkStatusCreated = "created"
+	BulkStatusUpdated = "updated"
+	BulkStatusFailed  = "failed"
+)
+
 // VehiclesBulk represents a bulk operation on vehicles
 type VehiclesBulk struct {
+	Mode     string     `json:"mode,omitempty"`
 	Vehicles []*Vehicle `json:"vehicles"`
 }
 
+// BulkItemResult represents the outcome of a bulk operation for a single vehicle
+type BulkItemResult struct {
+	VIN     string   `json:"vin"`
+	Status  string   `json:"status"`
+	Error   string   `json:"error,omitempty"`
+	Vehicle *Vehicle `json:"vehicle,omitempty"`
+}
+
+// BulkResult represents the per-vehicle outcome of a bulk operation
+type BulkResult struct {
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Results   []*BulkItemResult `json:"results"`
+}
+
 // Validate checks if the VehiclesBulk data is valid
 func (vb *VehiclesBulk) Validate() error {
 	return validation.ValidateStruct(
 		vb,
+		validation.Field(
+			&vb.Mode,
+			validation.In(BulkModeAtomic, BulkModeBestEffort),
+		),
 		validation.Field(
 			&vb.Vehicles,
 			validation.Required,
@@ -20,3 +53,8 @@ func (vb *VehiclesBulk) Validate() error {
 		),
 	)
 }
+
+// IsBestEffort reports whether each vehicle of the bulk is processed independently
+func (vb *VehiclesBulk) IsBestEffort() bool {
+	return vb.Mode == BulkModeBestEffort
+}
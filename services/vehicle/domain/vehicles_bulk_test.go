@@ -41,6 +41,24 @@ func TestVehiclesBulk_Validate(t *testing.T) {
 			},
 			isValid: false,
 		},
+		{
+			name: "best effort mode",
+			data: func() *domain.VehiclesBulk {
+				vb := newTestVehiclesBulk()
+				vb.Mode = domain.BulkModeBestEffort
+				return vb
+			},
+			isValid: true,
+		},
+		{
+			name: "unknown mode",
+			data: func() *domain.VehiclesBulk {
+				vb := newTestVehiclesBulk()
+				vb.Mode = "partial"
+				return vb
+			},
+			isValid: false,
+		},
 		{
 			name: "vehicles slice with invalid vehicle",
 			data: func() *domain.VehiclesBulk {
@@ -0,0 +1,22 @@
+package infrastructure
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// unavailableCodes are the status codes of a dependency that cannot answer right now, an open circuit breaker included
+var unavailableCodes = []codes.Code{codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted}
+
+// mapClientError marks the errors of a dependency that cannot answer right now as domain.ErrUnavailable
+func mapClientError(err error) error {
+	if slices.Contains(unavailableCodes, status.Code(err)) {
+		return fmt.Errorf("%w: %v", domain.ErrUnavailable, err)
+	}
+	return err
+}
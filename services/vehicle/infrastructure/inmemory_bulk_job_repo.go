@@ -0,0 +1,139 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// MemoryBulkJobRepo is an in-memory implementation of BulkJobRepository interface
+type MemoryBulkJobRepo struct {
+	mu         sync.RWMutex
+	data       map[string]*domain.BulkJob
+	heartbeats map[string]time.Time
+}
+
+// NewMemoryBulkJobRepo creates a new instance of MemoryBulkJobRepo
+func NewMemoryBulkJobRepo() *MemoryBulkJobRepo {
+	return &MemoryBulkJobRepo{
+		data:       make(map[string]*domain.BulkJob),
+		heartbeats: make(map[string]time.Time),
+	}
+}
+
+// Save saves a bulk job to the in-memory store, a job with an owner is claimed by it
+func (r *MemoryBulkJobRepo) Save(ctx context.Context, job *domain.BulkJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.data[job.ID] = job.Clone()
+	r.heartbeats[job.ID] = time.Now()
+	return nil
+}
+
+// FindByID retrieves a bulk job by its ID
+func (r *MemoryBulkJobRepo) FindByID(ctx context.Context, id string) (*domain.BulkJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if job, ok := r.data[id]; ok {
+		return job.Clone(), nil
+	}
+	return nil, domain.ErrJobNotFound
+}
+
+// Update saves the progress of an unfinished bulk job and renews the lease of its owner, a job cancelled
+// in the meantime stays cancelled and the job is given the stored status
+func (r *MemoryBulkJobRepo) Update(ctx context.Context, job *domain.BulkJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.data[job.ID]
+	if !ok {
+		return domain.ErrJobNotFound
+	}
+	if stored.Status == domain.BulkJobCompleted {
+		return domain.ErrJobFinished
+	}
+	if stored.Owner != job.Owner {
+		return domain.ErrJobClaimed
+	}
+	if stored.Status == domain.BulkJobCancelled {
+		job.Status = stored.Status
+	}
+	r.data[job.ID] = job.Clone()
+	r.heartbeats[job.ID] = time.Now()
+	return nil
+}
+
+// Cancel marks a pending or running bulk job as cancelled
+func (r *MemoryBulkJobRepo) Cancel(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.data[id]
+	if !ok {
+		return domain.ErrJobNotFound
+	}
+	if stored.IsFinished() {
+		return domain.ErrJobFinished
+	}
+	job := stored.Clone()
+	job.SetStatus(domain.BulkJobCancelled)
+	r.data[id] = job
+	return nil
+}
+
+// Claim makes the owner process the unfinished job unless another owner has renewed its lease recently
+func (r *MemoryBulkJobRepo) Claim(ctx context.Context, id, owner string, lease time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, err := r.unfinished(id)
+	if err != nil {
+		return err
+	}
+	if stored.Owner != "" && stored.Owner != owner && time.Since(r.heartbeats[id]) < lease {
+		return domain.ErrJobClaimed
+	}
+	stored.Owner = owner
+	r.heartbeats[id] = time.Now()
+	return nil
+}
+
+// Heartbeat renews the lease of the owner on the unfinished job
+func (r *MemoryBulkJobRepo) Heartbeat(ctx context.Context, id, owner string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, err := r.unfinished(id)
+	if err != nil {
+		return err
+	}
+	if stored.Owner != owner {
+		return domain.ErrJobClaimed
+	}
+	r.heartbeats[id] = time.Now()
+	return nil
+}
+
+// unfinished returns the stored job if it is still pending or running
+func (r *MemoryBulkJobRepo) unfinished(id string) (*domain.BulkJob, error) {
+	stored, ok := r.data[id]
+	if !ok {
+		return nil, domain.ErrJobNotFound
+	}
+	if stored.IsFinished() {
+		return nil, domain.ErrJobFinished
+	}
+	return stored, nil
+}
+
+// ListUnfinished lists pending and running bulk jobs
+func (r *MemoryBulkJobRepo) ListUnfinished(ctx context.Context) ([]*domain.BulkJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var jobs []*domain.BulkJob
+	for _, job := range r.data {
+		if !job.IsFinished() {
+			jobs = append(jobs, job.Clone())
+		}
+	}
+	return jobs, nil
+}
@@ -1,79 +1,435 @@
 package infrastructure
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
 )
 
 // MemoryVehicleRepo is an in-memory implementation of VehicleRepository interface
 type MemoryVehicleRepo struct {
-	data map[string]*domain.Vehicle
+	mu          sync.RWMutex
+	data        map[string]*domain.Vehicle
+	history     map[string][]*domain.HistoryEntry
+	offers      map[string][]*domain.Offer
+	lastID      int64
+	lastOfferID int64
 }
 
 // NewMemoryVehicleRepo creates a new instance of MemoryVehicleRepo
 func NewMemoryVehicleRepo() *MemoryVehicleRepo {
 	return &MemoryVehicleRepo{
-		data: make(map[string]*domain.Vehicle),
+		data:    make(map[string]*domain.Vehicle),
+		history: make(map[string][]*domain.HistoryEntry),
+		offers:  make(map[string][]*domain.Offer),
 	}
 }
 
-// Save saves a vehicle to the in-memory store
-func (r *MemoryVehicleRepo) Save(v *domain.Vehicle) error {
-	r.data[v.VIN] = v
+// store saves a copy of the vehicle and records the change in its history, the caller must hold the lock
+func (r *MemoryVehicleRepo) store(v *domain.Vehicle, action string) {
+	before := r.data[v.VIN]
+	c := *v
+	c.Actor = ""
+	r.data[v.VIN] = &c
+	r.record(domain.NewHistoryEntry(action, v.Actor, before, &c))
+}
+
+// record appends the entry to the vehicle history, the caller must hold the lock
+func (r *MemoryVehicleRepo) record(e *domain.HistoryEntry) {
+	r.lastID++
+	e.ID = r.lastID
+	r.history[e.VIN] = append(r.history[e.VIN], e)
+}
+
+// Save saves a vehicle to the in-memory store, new vehicles are drafts not listed for sale and keep their pending enrichment
+func (r *MemoryVehicleRepo) Save(ctx context.Context, v *domain.Vehicle) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.data[v.VIN]; ok {
+		return domain.ErrAlreadyExists
+	}
+	v.Version = 1
+	v.Lifecycle = domain.LifecycleDraft
+	v.Sale = domain.Sale{}
+	r.store(v, domain.HistoryCreated)
 	return nil
 }
 
+// active returns the stored vehicle unless it is deleted, the caller must hold the lock
+func (r *MemoryVehicleRepo) active(vin string) (*domain.Vehicle, bool) {
+	v, ok := r.data[vin]
+	if !ok || v.DeletedAt != nil {
+		return nil, false
+	}
+	return v, true
+}
+
 // FindByVIN retrieves a vehicle by its VIN
-func (r *MemoryVehicleRepo) FindByVIN(vin string) (*domain.Vehicle, error) {
-	if v, ok := r.data[vin]; ok {
-		return v, nil
+func (r *MemoryVehicleRepo) FindByVIN(ctx context.Context, vin string) (*domain.Vehicle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if v, ok := r.active(vin); ok {
+		c := *v
+		return &c, nil
 	}
 	return nil, errors.New("not found")
 }
 
-// Update updates an existing vehicle in the in-memory store
-func (r *MemoryVehicleRepo) Update(v *domain.Vehicle) error {
-	if _, ok := r.data[v.VIN]; !ok {
-		return errors.New("not found")
+// Update updates an existing vehicle in the in-memory store keeping its lifecycle status and sale state,
+// a non-zero version must match the stored one
+func (r *MemoryVehicleRepo) Update(ctx context.Context, v *domain.Vehicle) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.active(v.VIN)
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if v.Version != 0 && v.Version != stored.Version {
+		return domain.ErrVersionConflict
 	}
-	r.data[v.VIN] = v
+	if stored.Status == domain.SalePending {
+		return domain.ErrSalePending
+	}
+	v.Version = stored.Version + 1
+	v.Lifecycle = stored.Lifecycle
+	v.Sale = stored.Sale
+	r.store(v, domain.HistoryUpdated)
 	return nil
 }
 
-// Delete removes a vehicle from the in-memory store by its VIN
-func (r *MemoryVehicleRepo) Delete(vin string) error {
-	if _, ok := r.data[vin]; !ok {
-		return errors.New("vehicle not found")
+// Delete marks a vehicle in the in-memory store as deleted by its VIN
+func (r *MemoryVehicleRepo) Delete(ctx context.Context, vin, actor string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.active(vin)
+	if !ok {
+		return domain.ErrNotFound
 	}
-	delete(r.data, vin)
+	now := time.Now().UTC()
+	deleted := *stored
+	deleted.DeletedAt = &now
+	deleted.Version++
+	r.data[vin] = &deleted
+	r.record(domain.NewHistoryEntry(domain.HistoryDeleted, actor, &deleted, nil))
 	return nil
 }
 
-// List lists all vehicles in the in-memory store
-func (r *MemoryVehicleRepo) List() ([]*domain.Vehicle, error) {
+// Restore clears the deletion mark of a vehicle in the in-memory store
+func (r *MemoryVehicleRepo) Restore(ctx context.Context, vin, actor string) (*domain.Vehicle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.data[vin]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	if stored.DeletedAt == nil {
+		return nil, domain.ErrNotDeleted
+	}
+	restored := *stored
+	restored.DeletedAt = nil
+	restored.Version++
+	r.data[vin] = &restored
+	r.record(domain.NewHistoryEntry(domain.HistoryRestored, actor, nil, &restored))
+	c := restored
+	return &c, nil
+}
+
+// Purge permanently removes vehicles deleted before the given time from the in-memory store
+func (r *MemoryVehicleRepo) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for vin, v := range r.data {
+		if v.DeletedAt != nil && v.DeletedAt.Before(deletedBefore) {
+			delete(r.data, vin)
+			delete(r.offers, vin)
+			r.record(domain.NewHistoryEntry(domain.HistoryPurged, domain.SystemActor, v, nil))
+			n++
+		}
+	}
+	return n, nil
+}
+
+// List lists vehicles matching the query in the in-memory store
+func (r *MemoryVehicleRepo) List(ctx context.Context, q *domain.VehicleQuery) ([]*domain.Vehicle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	cursor, err := q.Cursor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Filter vehicles and skip the ones up to the cursor
 	result := make([]*domain.Vehicle, 0, len(r.data))
 	for _, v := range r.data {
+		if v.DeletedAt != nil || !q.Match(v) {
+			continue
+		}
+		if cursor != nil && q.Compare(v, cursor) <= 0 {
+			continue
+		}
 		result = append(result, v)
 	}
+
+	// Sort and limit the result
+	slices.SortFunc(result, q.Compare)
+	if len(result) > q.Limit {
+		result = result[:q.Limit]
+	}
 	return result, nil
 }
 
-// SaveBulk saves multiple vehicles to the in-memory store
-func (r *MemoryVehicleRepo) SaveBulk(vb *domain.VehiclesBulk) error {
+// Export streams vehicles matching the query from a snapshot of the in-memory store
+func (r *MemoryVehicleRepo) Export(ctx context.Context, q *domain.VehicleQuery, fn func(*domain.Vehicle) error) error {
+
+	// Take a snapshot of the matching vehicles
+	r.mu.RLock()
+	snapshot := make([]*domain.Vehicle, 0, len(r.data))
+	for _, v := range r.data {
+		if v.DeletedAt == nil && q.Match(v) {
+			c := *v
+			snapshot = append(snapshot, &c)
+		}
+	}
+	r.mu.RUnlock()
+
+	// Stream the sorted snapshot
+	slices.SortFunc(snapshot, q.Compare)
+	for _, v := range snapshot {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveBulk saves multiple vehicles to the in-memory store,
+// nothing is saved if any of the vehicles already exists
+func (r *MemoryVehicleRepo) SaveBulk(ctx context.Context, vb *domain.VehiclesBulk) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	seen := make(map[string]bool, len(vb.Vehicles))
 	for _, v := range vb.Vehicles {
-		r.data[v.VIN] = v
+		if _, ok := r.data[v.VIN]; ok || seen[v.VIN] {
+			return domain.ErrAlreadyExists
+		}
+		seen[v.VIN] = true
+	}
+	for _, v := range vb.Vehicles {
+		v.Version = 1
+		v.Lifecycle = domain.LifecycleDraft
+		v.Sale = domain.Sale{}
+		r.store(v, domain.HistoryCreated)
 	}
 	return nil
 }
 
-// UpdateBulk updates multiple vehicles in the in-memory store
-func (r *MemoryVehicleRepo) UpdateBulk(vb *domain.VehiclesBulk) error {
+// UpdateBulk updates multiple vehicles in the in-memory store keeping their lifecycle statuses and sale state,
+// nothing is updated if any of the non-zero versions does not match the stored one
+func (r *MemoryVehicleRepo) UpdateBulk(ctx context.Context, vb *domain.VehiclesBulk) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Check all vehicles before updating any of them
+	var conflicts []string
 	for _, v := range vb.Vehicles {
-		if _, ok := r.data[v.VIN]; !ok {
+		stored, ok := r.active(v.VIN)
+		if !ok {
 			return errors.New("vehicle not found: " + v.VIN)
 		}
-		r.data[v.VIN] = v
+		if stored.Status == domain.SalePending {
+			return fmt.Errorf("%w: %s", domain.ErrSalePending, v.VIN)
+		}
+		if v.Version != 0 && v.Version != stored.Version {
+			conflicts = append(conflicts, v.VIN)
+		}
+	}
+	if len(conflicts) > 0 {
+		return &domain.VersionConflictError{VINs: conflicts}
+	}
+
+	// Update vehicles
+	for _, v := range vb.Vehicles {
+		v.Version = r.data[v.VIN].Version + 1
+		v.Lifecycle = r.data[v.VIN].Lifecycle
+		v.Sale = r.data[v.VIN].Sale
+		r.store(v, domain.HistoryUpdated)
 	}
 	return nil
 }
+
+// History lists the change history of a vehicle, oldest first
+func (r *MemoryVehicleRepo) History(ctx context.Context, vin string) ([]*domain.HistoryEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.history[vin]), nil
+}
+
+// UpdateSale updates the sale state and the lifecycle status of the vehicle and the given offers in the in-memory store,
+// the vehicle may be nil and all versions must match the stored ones
+func (r *MemoryVehicleRepo) UpdateSale(ctx context.Context, v *domain.Vehicle, offers ...*domain.Offer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Check all versions before updating anything
+	if v != nil {
+		stored, ok := r.active(v.VIN)
+		if !ok {
+			return domain.ErrNotFound
+		}
+		if v.Version != stored.Version {
+			return domain.ErrVersionConflict
+		}
+	}
+	for _, o := range offers {
+		stored, ok := r.offer(o.VIN, o.ID)
+		if !ok {
+			return domain.ErrOfferNotFound
+		}
+		if o.Version != stored.Version {
+			return domain.ErrVersionConflict
+		}
+	}
+
+	// Update the vehicle and the offers
+	if v != nil {
+		c := *r.data[v.VIN]
+		c.Sale = v.Sale
+		c.Lifecycle = v.Lifecycle
+		c.Version++
+		c.Actor = v.Actor
+		r.store(&c, domain.HistoryUpdated)
+		v.Version = c.Version
+	}
+	for _, o := range offers {
+		o.Version++
+		stored, _ := r.offer(o.VIN, o.ID)
+		*stored = *o
+	}
+	return nil
+}
+
+// UpdateLifecycle updates the lifecycle status of the vehicle in the in-memory store, the version must match the stored one
+func (r *MemoryVehicleRepo) UpdateLifecycle(ctx context.Context, v *domain.Vehicle) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.active(v.VIN)
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if v.Version != stored.Version {
+		return domain.ErrVersionConflict
+	}
+	c := *stored
+	c.Lifecycle = v.Lifecycle
+	c.Version++
+	c.Actor = v.Actor
+	r.store(&c, domain.HistoryUpdated)
+	v.Version = c.Version
+	return nil
+}
+
+// UpdateEnrichment updates the enriched data and the pending enrichment of the vehicle in the in-memory store,
+// the version must match the stored one and only changes of the data bump it and are recorded in the history
+func (r *MemoryVehicleRepo) UpdateEnrichment(ctx context.Context, v *domain.Vehicle) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.active(v.VIN)
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if v.Version != stored.Version {
+		return domain.ErrVersionConflict
+	}
+	c := stored.Enriched(v)
+	if e := domain.NewHistoryEntry(domain.HistoryUpdated, v.Actor, stored, c); len(e.Changes) > 0 {
+		c.Version++
+		e.Version = c.Version
+		r.record(e)
+	}
+	c.Actor = ""
+	r.data[v.VIN] = c
+	v.Version = c.Version
+	return nil
+}
+
+// ListPendingEnrichment lists up to limit vehicles pending enrichment in the in-memory store, the earliest retry first
+func (r *MemoryVehicleRepo) ListPendingEnrichment(ctx context.Context, limit int) ([]*domain.Vehicle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]*domain.Vehicle, 0)
+	for _, v := range r.data {
+		if v.DeletedAt == nil && v.Enrichment != nil {
+			c := *v
+			result = append(result, &c)
+		}
+	}
+	slices.SortFunc(result, func(a, b *domain.Vehicle) int {
+		if c := a.Enrichment.RetryAt.Compare(b.Enrichment.RetryAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.VIN, b.VIN)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// offer returns the stored offer of the vehicle, the caller must hold the lock
+func (r *MemoryVehicleRepo) offer(vin string, id int64) (*domain.Offer, bool) {
+	for _, o := range r.offers[vin] {
+		if o.ID == id {
+			return o, true
+		}
+	}
+	return nil, false
+}
+
+// SaveOffer saves an offer of an existing vehicle to the in-memory store
+func (r *MemoryVehicleRepo) SaveOffer(ctx context.Context, o *domain.Offer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.active(o.VIN); !ok {
+		return domain.ErrNotFound
+	}
+	r.lastOfferID++
+	o.ID = r.lastOfferID
+	o.Version = 1
+	c := *o
+	r.offers[o.VIN] = append(r.offers[o.VIN], &c)
+	return nil
+}
+
+// FindOffer retrieves an offer of the vehicle by its ID
+func (r *MemoryVehicleRepo) FindOffer(ctx context.Context, vin string, id int64) (*domain.Offer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	o, ok := r.offer(vin, id)
+	if !ok {
+		return nil, domain.ErrOfferNotFound
+	}
+	c := *o
+	return &c, nil
+}
+
+// ListOffers lists the offers of the vehicle, oldest first
+func (r *MemoryVehicleRepo) ListOffers(ctx context.Context, vin string) ([]*domain.Offer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	offers := make([]*domain.Offer, len(r.offers[vin]))
+	for i, o := range r.offers[vin] {
+		c := *o
+		offers[i] = &c
+	}
+	return offers, nil
+}
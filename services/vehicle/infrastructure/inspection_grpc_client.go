@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/alechekz/online-car-auction/pkg/resilience"
 	pb "github.com/alechekz/online-car-auction/services/inspection/delivery/grpc/proto"
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
 	"google.golang.org/grpc"
@@ -12,18 +13,24 @@ import (
 
 // InspectionGRPCClient is a gRPC client for the Inspection Service
 type InspectionGRPCClient struct {
-	client pb.InspectionServiceClient
-	conn   *grpc.ClientConn
+	client  pb.InspectionServiceClient
+	conn    *grpc.ClientConn
+	timeout time.Duration
 }
 
-// NewInspectionGRPCClient creates a new InspectionGRPCClient instance
-func NewInspectionGRPCClient(address string) (*InspectionGRPCClient, error) {
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewInspectionGRPCClient creates a new InspectionGRPCClient instance, the timeout limits every call with all of its retries
+// and the breaker, if any, guards the Inspection Service
+func NewInspectionGRPCClient(address string, timeout time.Duration, policy resilience.RetryPolicy, breaker *resilience.Breaker) (*InspectionGRPCClient, error) {
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		resilience.DialOption(policy, breaker, pb.InspectionService_GetBuildData_FullMethodName, pb.InspectionService_InspectVehicle_FullMethodName),
+	)
 	if err != nil {
 		return nil, err
 	}
 	client := pb.NewInspectionServiceClient(conn)
-	return &InspectionGRPCClient{client: client, conn: conn}, nil
+	return &InspectionGRPCClient{client: client, conn: conn, timeout: timeout}, nil
 }
 
 // Close closes the gRPC connection
@@ -32,8 +39,8 @@ func (c *InspectionGRPCClient) Close() error {
 }
 
 // InspectVehicle sends an inspection request to the Inspection Service
-func (c *InspectionGRPCClient) InspectVehicle(v *domain.Vehicle) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+func (c *InspectionGRPCClient) InspectVehicle(ctx context.Context, v *domain.Vehicle) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	req := &pb.InspectVehicleRequest{
@@ -48,20 +55,20 @@ func (c *InspectionGRPCClient) InspectVehicle(v *domain.Vehicle) error {
 
 	resp, err := c.client.InspectVehicle(ctx, req)
 	if err != nil {
-		return err
+		return mapClientError(err)
 	}
 	v.Grade = int(resp.Grade)
 	return nil
 }
 
 // GetBuildData retrieves the build data of a vehicle from the Inspection Service
-func (c *InspectionGRPCClient) GetBuildData(vin string) (*domain.Vehicle, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+func (c *InspectionGRPCClient) GetBuildData(ctx context.Context, vin string) (*domain.Vehicle, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 	req := &pb.GetBuildDataRequest{Vin: vin}
 	resp, err := c.client.GetBuildData(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, mapClientError(err)
 	}
 	return &domain.Vehicle{
 		VIN:          resp.Vin,
@@ -69,5 +76,25 @@ func (c *InspectionGRPCClient) GetBuildData(vin string) (*domain.Vehicle, error)
 		Engine:       resp.Engine,
 		Transmission: resp.Transmission,
 		MSRP:         resp.Msrp,
+		BuildData: domain.BuildData{
+			Model:                resp.Model,
+			Trim:                 resp.Trim,
+			BodyClass:            resp.BodyClass,
+			DriveType:            resp.DriveType,
+			FuelType:             resp.FuelType,
+			Displacement:         resp.Displacement,
+			Cylinders:            resp.Cylinders,
+			Doors:                resp.Doors,
+			ElectrificationLevel: resp.ElectrificationLevel,
+			DecodeQuality:        fromProtoDecodeQuality(resp.DecodeQuality),
+		},
 	}, nil
 }
+
+// fromProtoDecodeQuality converts the protobuf decode quality of the build data
+func fromProtoDecodeQuality(q *pb.DecodeQuality) *domain.DecodeQuality {
+	if q == nil {
+		return nil
+	}
+	return &domain.DecodeQuality{Status: q.Status, Codes: q.Codes, Message: q.Message}
+}
@@ -1,6 +1,10 @@
 package infrastructure
 
-import "github.com/alechekz/online-car-auction/services/vehicle/domain"
+import (
+	"context"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
 
 // MockInspectionProvider is a mock implementation of the InspectionProvider interface for testing purposes
 type MockInspectionProvider struct {
@@ -8,8 +12,11 @@ type MockInspectionProvider struct {
 	Err  error
 }
 
-// GetBuildData simulates fetching build data for a vehicle
-func (m *MockInspectionProvider) GetBuildData(vin string) (*domain.Vehicle, error) {
+// GetBuildData simulates fetching build data for a vehicle, failing like a real call once the context is done
+func (m *MockInspectionProvider) GetBuildData(ctx context.Context, vin string) (*domain.Vehicle, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	if m.Err != nil {
 		return nil, m.Err
 	}
@@ -17,6 +24,6 @@ func (m *MockInspectionProvider) GetBuildData(vin string) (*domain.Vehicle, erro
 }
 
 // InspectVehicle simulates vehicle inspection
-func (m *MockInspectionProvider) InspectVehicle(v *domain.Vehicle) error {
+func (m *MockInspectionProvider) InspectVehicle(ctx context.Context, v *domain.Vehicle) error {
 	return m.Err
 }
@@ -1,6 +1,10 @@
 package infrastructure
 
-import "github.com/alechekz/online-car-auction/services/vehicle/domain"
+import (
+	"context"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
 
 // MockPricingProvider is a mock implementation of the PricingProvider interface for testing purposes
 type MockPricingProvider struct {
@@ -9,6 +13,6 @@ type MockPricingProvider struct {
 }
 
 // GetRecommendedPrice simulates fetching recommended price for a vehicle
-func (m *MockPricingProvider) GetRecommendedPrice(v *domain.Vehicle) (uint64, error) {
+func (m *MockPricingProvider) GetRecommendedPrice(ctx context.Context, v *domain.Vehicle) (uint64, error) {
 	return 99_000, m.Err
 }
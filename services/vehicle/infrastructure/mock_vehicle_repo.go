@@ -1,6 +1,9 @@
 package infrastructure
 
 import (
+	"context"
+	"time"
+
 	"github.com/stretchr/testify/mock"
 
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
@@ -12,43 +15,109 @@ type MockVehiclesRepository struct {
 }
 
 // Save saves a vehicle record
-func (m *MockVehiclesRepository) Save(v *domain.Vehicle) error {
-	args := m.Called(v)
+func (m *MockVehiclesRepository) Save(ctx context.Context, v *domain.Vehicle) error {
+	args := m.Called(ctx, v)
 	return args.Error(0)
 }
 
 // Update updates a vehicle record
-func (m *MockVehiclesRepository) Update(v *domain.Vehicle) error {
-	args := m.Called(v)
+func (m *MockVehiclesRepository) Update(ctx context.Context, v *domain.Vehicle) error {
+	args := m.Called(ctx, v)
 	return args.Error(0)
 }
 
 // FindByVIN finds a vehicle by its VIN
-func (m *MockVehiclesRepository) FindByVIN(vin string) (*domain.Vehicle, error) {
-	args := m.Called(vin)
+func (m *MockVehiclesRepository) FindByVIN(ctx context.Context, vin string) (*domain.Vehicle, error) {
+	args := m.Called(ctx, vin)
 	return args.Get(0).(*domain.Vehicle), args.Error(1)
 }
 
 // Delete deletes a vehicle by its VIN
-func (m *MockVehiclesRepository) Delete(vin string) error {
-	args := m.Called(vin)
+func (m *MockVehiclesRepository) Delete(ctx context.Context, vin, actor string) error {
+	args := m.Called(ctx, vin, actor)
 	return args.Error(0)
 }
 
-// List lists all vehicles
-func (m *MockVehiclesRepository) List() ([]*domain.Vehicle, error) {
-	args := m.Called()
+// Restore restores a deleted vehicle by its VIN
+func (m *MockVehiclesRepository) Restore(ctx context.Context, vin, actor string) (*domain.Vehicle, error) {
+	args := m.Called(ctx, vin, actor)
+	return args.Get(0).(*domain.Vehicle), args.Error(1)
+}
+
+// Purge permanently removes vehicles deleted before the given time
+func (m *MockVehiclesRepository) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	args := m.Called(ctx, deletedBefore)
+	return args.Int(0), args.Error(1)
+}
+
+// List lists vehicles matching the query
+func (m *MockVehiclesRepository) List(ctx context.Context, q *domain.VehicleQuery) ([]*domain.Vehicle, error) {
+	args := m.Called(ctx, q)
 	return args.Get(0).([]*domain.Vehicle), args.Error(1)
 }
 
+// Export streams vehicles matching the query
+func (m *MockVehiclesRepository) Export(ctx context.Context, q *domain.VehicleQuery, fn func(*domain.Vehicle) error) error {
+	args := m.Called(ctx, q, fn)
+	return args.Error(0)
+}
+
 // SaveBulk saves multiple vehicle records in bulk
-func (m *MockVehiclesRepository) SaveBulk(vb *domain.VehiclesBulk) error {
-	args := m.Called(vb)
+func (m *MockVehiclesRepository) SaveBulk(ctx context.Context, vb *domain.VehiclesBulk) error {
+	args := m.Called(ctx, vb)
 	return args.Error(0)
 }
 
 // UpdateBulk updates multiple vehicle records in bulk
-func (m *MockVehiclesRepository) UpdateBulk(vb *domain.VehiclesBulk) error {
-	args := m.Called(vb)
+func (m *MockVehiclesRepository) UpdateBulk(ctx context.Context, vb *domain.VehiclesBulk) error {
+	args := m.Called(ctx, vb)
+	return args.Error(0)
+}
+
+// History lists the change history of a vehicle
+func (m *MockVehiclesRepository) History(ctx context.Context, vin string) ([]*domain.HistoryEntry, error) {
+	args := m.Called(ctx, vin)
+	return args.Get(0).([]*domain.HistoryEntry), args.Error(1)
+}
+
+// UpdateSale updates the sale state of a vehicle and its offers
+func (m *MockVehiclesRepository) UpdateSale(ctx context.Context, v *domain.Vehicle, offers ...*domain.Offer) error {
+	args := m.Called(ctx, v, offers)
+	return args.Error(0)
+}
+
+// UpdateLifecycle updates the lifecycle status of a vehicle
+func (m *MockVehiclesRepository) UpdateLifecycle(ctx context.Context, v *domain.Vehicle) error {
+	args := m.Called(ctx, v)
+	return args.Error(0)
+}
+
+// UpdateEnrichment updates the enriched data and the pending enrichment of a vehicle
+func (m *MockVehiclesRepository) UpdateEnrichment(ctx context.Context, v *domain.Vehicle) error {
+	args := m.Called(ctx, v)
 	return args.Error(0)
 }
+
+// ListPendingEnrichment lists the vehicles pending enrichment
+func (m *MockVehiclesRepository) ListPendingEnrichment(ctx context.Context, limit int) ([]*domain.Vehicle, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*domain.Vehicle), args.Error(1)
+}
+
+// SaveOffer saves an offer for a vehicle
+func (m *MockVehiclesRepository) SaveOffer(ctx context.Context, o *domain.Offer) error {
+	args := m.Called(ctx, o)
+	return args.Error(0)
+}
+
+// FindOffer finds an offer of a vehicle by its ID
+func (m *MockVehiclesRepository) FindOffer(ctx context.Context, vin string, id int64) (*domain.Offer, error) {
+	args := m.Called(ctx, vin, id)
+	return args.Get(0).(*domain.Offer), args.Error(1)
+}
+
+// ListOffers lists the offers of a vehicle
+func (m *MockVehiclesRepository) ListOffers(ctx context.Context, vin string) ([]*domain.Offer, error) {
+	args := m.Called(ctx, vin)
+	return args.Get(0).([]*domain.Offer), args.Error(1)
+}
@@ -0,0 +1,198 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// jobColumns are the columns selected by bulk job queries, in the order of scanJob
+const jobColumns = `id, operation, status, total, processed, succeeded, failed, vehicles, results, COALESCE(owner, ''), created_at, updated_at`
+
+// PostgresBulkJobRepo is a PostgreSQL implementation of BulkJobRepository interface
+type PostgresBulkJobRepo struct {
+	db      *pgxpool.Pool
+	timeout time.Duration
+}
+
+// NewPostgresBulkJobRepo creates a new instance of PostgresBulkJobRepo, the timeout limits every operation
+func NewPostgresBulkJobRepo(conn string, timeout time.Duration) (*PostgresBulkJobRepo, error) {
+	pool, err := pgxpool.New(context.Background(), conn)
+	if err != nil {
+		return nil, err
+	}
+	return &PostgresBulkJobRepo{db: pool, timeout: timeout}, nil
+}
+
+// Save saves a bulk job to the PostgreSQL database, a job with an owner is claimed by it
+func (r *PostgresBulkJobRepo) Save(ctx context.Context, job *domain.BulkJob) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	vehicles, err := json.Marshal(job.Vehicles)
+	if err != nil {
+		return err
+	}
+	results, err := json.Marshal(job.Results)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Exec(ctx,
+		`INSERT INTO bulk_jobs
+		(id, operation, status, total, processed, succeeded, failed, vehicles, results, owner, heartbeat_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''), now(), $11, $12)`,
+		job.ID, job.Operation, job.Status, job.Total, job.Processed, job.Succeeded, job.Failed, vehicles, results, job.Owner, job.CreatedAt, job.UpdatedAt,
+	)
+	return err
+}
+
+// scanJob scans a bulk job row
+func scanJob(row pgx.Row) (*domain.BulkJob, error) {
+	var job domain.BulkJob
+	var vehicles, results []byte
+	if err := row.Scan(
+		&job.ID, &job.Operation, &job.Status, &job.Total, &job.Processed, &job.Succeeded, &job.Failed, &vehicles, &results, &job.Owner, &job.CreatedAt, &job.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(vehicles, &job.Vehicles); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(results, &job.Results); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FindByID retrieves a bulk job by its ID
+func (r *PostgresBulkJobRepo) FindByID(ctx context.Context, id string) (*domain.BulkJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	job, err := scanJob(r.db.QueryRow(ctx, `SELECT `+jobColumns+` FROM bulk_jobs WHERE id=$1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrJobNotFound
+	}
+	return job, err
+}
+
+// Update saves the progress of an unfinished bulk job in the PostgreSQL database and renews the lease of its owner,
+// a job cancelled in the meantime stays cancelled and the job is given the stored status
+func (r *PostgresBulkJobRepo) Update(ctx context.Context, job *domain.BulkJob) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	results, err := json.Marshal(job.Results)
+	if err != nil {
+		return err
+	}
+	err = r.db.QueryRow(ctx,
+		`UPDATE bulk_jobs
+		SET status = CASE WHEN status = $8 THEN status ELSE $1 END,
+			processed=$2, succeeded=$3, failed=$4, results=$5, updated_at=$6, heartbeat_at=now()
+		WHERE id=$7 AND status <> $9 AND owner IS NOT DISTINCT FROM NULLIF($10, '')
+		RETURNING status`,
+		job.Status, job.Processed, job.Succeeded, job.Failed, results, job.UpdatedAt, job.ID,
+		domain.BulkJobCancelled, domain.BulkJobCompleted, job.Owner,
+	).Scan(&job.Status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return r.missing(ctx, job.ID)
+	}
+	return err
+}
+
+// Cancel marks a pending or running bulk job as cancelled
+func (r *PostgresBulkJobRepo) Cancel(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tag, err := r.db.Exec(ctx,
+		`UPDATE bulk_jobs SET status=$1, updated_at=now()
+		WHERE id=$2 AND status IN ($3, $4)`,
+		domain.BulkJobCancelled, id, domain.BulkJobPending, domain.BulkJobRunning,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return r.missing(ctx, id)
+	}
+	return nil
+}
+
+// Claim makes the owner process the unfinished job unless another owner has renewed its lease recently
+func (r *PostgresBulkJobRepo) Claim(ctx context.Context, id, owner string, lease time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tag, err := r.db.Exec(ctx,
+		`UPDATE bulk_jobs SET owner=$1, heartbeat_at=now()
+		WHERE id=$2 AND status IN ($3, $4)
+		AND (owner IS NULL OR owner = $1 OR heartbeat_at IS NULL OR heartbeat_at < now() - $5 * interval '1 millisecond')`,
+		owner, id, domain.BulkJobPending, domain.BulkJobRunning, lease.Milliseconds(),
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return r.missing(ctx, id)
+	}
+	return nil
+}
+
+// Heartbeat renews the lease of the owner on the unfinished job
+func (r *PostgresBulkJobRepo) Heartbeat(ctx context.Context, id, owner string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tag, err := r.db.Exec(ctx,
+		`UPDATE bulk_jobs SET heartbeat_at=now() WHERE id=$1 AND status IN ($2, $3) AND owner=$4`,
+		id, domain.BulkJobPending, domain.BulkJobRunning, owner,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return r.missing(ctx, id)
+	}
+	return nil
+}
+
+// missing explains why a conditional update of a bulk job matched no row
+func (r *PostgresBulkJobRepo) missing(ctx context.Context, id string) error {
+	var status string
+	err := r.db.QueryRow(ctx, `SELECT status FROM bulk_jobs WHERE id=$1`, id).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrJobNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if status == domain.BulkJobCompleted || status == domain.BulkJobCancelled {
+		return domain.ErrJobFinished
+	}
+	return domain.ErrJobClaimed
+}
+
+// ListUnfinished lists pending and running bulk jobs
+func (r *PostgresBulkJobRepo) ListUnfinished(ctx context.Context) ([]*domain.BulkJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT `+jobColumns+` FROM bulk_jobs WHERE status IN ($1, $2) ORDER BY created_at`,
+		domain.BulkJobPending, domain.BulkJobRunning,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*domain.BulkJob
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
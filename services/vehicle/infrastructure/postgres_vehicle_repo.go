@@ -2,96 +2,473 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresVehicleRepo is a PostgreSQL implementation of VehicleRepository interface
 type PostgresVehicleRepo struct {
-	db *pgxpool.Pool
+	db      *pgxpool.Pool
+	timeout time.Duration
 }
 
-// NewPostgresVehicleRepo creates a new instance of PostgresVehicleRepo
-func NewPostgresVehicleRepo(conn string) (*PostgresVehicleRepo, error) {
+// NewPostgresVehicleRepo creates a new instance of PostgresVehicleRepo, the timeout limits every operation but exports
+func NewPostgresVehicleRepo(conn string, timeout time.Duration) (*PostgresVehicleRepo, error) {
 	pool, err := pgxpool.New(context.Background(), conn)
 	if err != nil {
 		return nil, err
 	}
-	return &PostgresVehicleRepo{db: pool}, nil
+	return &PostgresVehicleRepo{db: pool, timeout: timeout}, nil
 }
 
-// Save saves a vehicle to the PostgreSQL database
-func (r *PostgresVehicleRepo) Save(v *domain.Vehicle) error {
-	_, err := r.db.Exec(context.Background(),
+// Save saves a vehicle to the PostgreSQL database and records it in the vehicle history,
+// new vehicles are drafts not listed for sale and keep their pending enrichment
+func (r *PostgresVehicleRepo) Save(ctx context.Context, v *domain.Vehicle) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	v.Version = 1
+	v.Lifecycle = domain.LifecycleDraft
+	v.Sale = domain.Sale{}
+	attempts, retryAt, enrichmentErr := enrichmentValues(v.Enrichment)
+	_, err = tx.Exec(ctx,
 		`INSERT INTO vehicles
-		(vin, year, odometer, brand, engine, transmission, msrp, grade, price, exterior_color, interior_color, small_scratches, strong_scratches, electric_fail, suspension_fail)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
-		v.VIN, v.Year, v.Odometer, v.Brand, v.Engine, v.Transmission, v.MSRP, v.Grade, v.Price,
-		v.ExteriorColor, v.InteriorColor, v.SmallScratches, v.StrongScratches, v.ElectricFail, v.SuspensionFail,
+		(vin, year, odometer, brand, engine, transmission, msrp, grade, price, exterior_color, interior_color, small_scratches, strong_scratches, electric_fail, suspension_fail, version,
+		enrichment_attempts, enrichment_retry_at, enrichment_error, `+buildDataColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29)`,
+		append([]any{
+			v.VIN, v.Year, v.Odometer, v.Brand, v.Engine, v.Transmission, v.MSRP, v.Grade, v.Price,
+			v.ExteriorColor, v.InteriorColor, v.SmallScratches, v.StrongScratches, v.ElectricFail, v.SuspensionFail, v.Version,
+			attempts, retryAt, enrichmentErr,
+		}, buildDataValues(&v.BuildData)...)...,
+	)
+	if err != nil {
+		return mapWriteError(err)
+	}
+	if err := r.record(ctx, tx, domain.NewHistoryEntry(domain.HistoryCreated, v.Actor, nil, v)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// record inserts entries into the vehicle history within the transaction
+func (r *PostgresVehicleRepo) record(ctx context.Context, tx pgx.Tx, entries ...*domain.HistoryEntry) error {
+	values := make([][]any, len(entries))
+	for i, e := range entries {
+		values[i] = []any{e.VIN, e.Action, e.Actor, e.Version, e.Changes, e.CreatedAt}
+	}
+	_, err := tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"vehicle_history"},
+		[]string{"vin", "action", "actor", "version", "changes", "created_at"},
+		pgx.CopyFromRows(values),
 	)
 	return err
 }
 
-// FindByVIN retrieves a vehicle by its VIN
-func (r *PostgresVehicleRepo) FindByVIN(vin string) (*domain.Vehicle, error) {
-	row := r.db.QueryRow(context.Background(),
-		`SELECT vin, year, msrp, odometer, brand, engine, transmission, grade, price, exterior_color, interior_color, small_scratches, strong_scratches, electric_fail, suspension_fail
-		FROM vehicles WHERE vin=$1`, vin,
+// History lists the change history of a vehicle, oldest first
+func (r *PostgresVehicleRepo) History(ctx context.Context, vin string) ([]*domain.HistoryEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		`SELECT id, vin, action, actor, version, changes, created_at
+		FROM vehicle_history WHERE vin=$1 ORDER BY id`, vin,
 	)
-	var v domain.Vehicle
-	if err := row.Scan(
-		&v.VIN, &v.Year, &v.MSRP, &v.Odometer, &v.Brand, &v.Engine, &v.Transmission, &v.Grade, &v.Price, &v.ExteriorColor, &v.InteriorColor, &v.SmallScratches, &v.StrongScratches, &v.ElectricFail, &v.SuspensionFail,
-	); err != nil {
+	if err != nil {
 		return nil, err
 	}
-	return &v, nil
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*domain.HistoryEntry, error) {
+		var e domain.HistoryEntry
+		err := row.Scan(&e.ID, &e.VIN, &e.Action, &e.Actor, &e.Version, &e.Changes, &e.CreatedAt)
+		return &e, err
+	})
 }
 
-// Update updates an existing vehicle in the PostgreSQL database
-func (r *PostgresVehicleRepo) Update(v *domain.Vehicle) error {
-	_, err := r.db.Exec(context.Background(),
-		`UPDATE vehicles
-		SET year=$1, odometer=$2, brand=$3, engine=$4, transmission=$5, msrp=$6, grade=$7, price=$8, exterior_color=$9, interior_color=$10, small_scratches=$11, strong_scratches=$12, electric_fail=$13, suspension_fail=$14
-		WHERE vin=$15`,
-		v.Year, v.Odometer, v.Brand, v.Engine, v.Transmission, v.MSRP, v.Grade, v.Price, v.ExteriorColor, v.InteriorColor, v.SmallScratches, v.StrongScratches, v.ElectricFail, v.SuspensionFail, v.VIN,
-	)
+// mapWriteError maps unique violations to the domain error
+func mapWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrAlreadyExists
+	}
 	return err
 }
 
-// Delete removes a vehicle from the PostgreSQL database by its VIN
-func (r *PostgresVehicleRepo) Delete(vin string) error {
-	_, err := r.db.Exec(context.Background(),
-		`DELETE FROM vehicles WHERE vin=$1`, vin,
-	)
-	return err
+// FindByVIN retrieves a vehicle by its VIN
+func (r *PostgresVehicleRepo) FindByVIN(ctx context.Context, vin string) (*domain.Vehicle, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	return scanVehicle(r.db.QueryRow(ctx,
+		fmt.Sprintf(`SELECT %s FROM vehicles WHERE vin=$1 AND deleted_at IS NULL`, listColumns), vin,
+	))
+}
+
+// Update updates an existing vehicle in the PostgreSQL database keeping its lifecycle status and sale state and records the change
+// in the vehicle history, a non-zero version must match the stored one
+func (r *PostgresVehicleRepo) Update(ctx context.Context, v *domain.Vehicle) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	// Lock the stored vehicle and check its version
+	before, err := scanVehicle(tx.QueryRow(ctx,
+		fmt.Sprintf(`SELECT %s FROM vehicles WHERE vin=$1 AND deleted_at IS NULL FOR UPDATE`, listColumns), v.VIN,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if v.Version != 0 && v.Version != before.Version {
+		return domain.ErrVersionConflict
+	}
+	if before.Status == domain.SalePending {
+		return domain.ErrSalePending
+	}
+
+	// Update the vehicle and record the change
+	attempts, retryAt, enrichmentErr := enrichmentValues(v.Enrichment)
+	if err := tx.QueryRow(ctx,
+		`UPDATE vehicles
+		SET year=$1, odometer=$2, brand=$3, engine=$4, transmission=$5, msrp=$6, grade=$7, price=$8, exterior_color=$9, interior_color=$10, small_scratches=$11, strong_scratches=$12, electric_fail=$13, suspension_fail=$14,
+		enrichment_attempts=$15, enrichment_retry_at=$16, enrichment_error=$17, version=version+1,
+		model=$18, trim=$19, body_class=$20, drive_type=$21, fuel_type=$22, displacement=$23, cylinders=$24, doors=$25, electrification_level=$26, decode_quality=$27
+		WHERE vin=$28
+		RETURNING version`,
+		append(append([]any{
+			v.Year, v.Odometer, v.Brand, v.Engine, v.Transmission, v.MSRP, v.Grade, v.Price, v.ExteriorColor, v.InteriorColor, v.SmallScratches, v.StrongScratches, v.ElectricFail, v.SuspensionFail,
+			attempts, retryAt, enrichmentErr,
+		}, buildDataValues(&v.BuildData)...), v.VIN)...,
+	).Scan(&v.Version); err != nil {
+		return err
+	}
+	v.Lifecycle = before.Lifecycle
+	v.Sale = before.Sale
+	if err := r.record(ctx, tx, domain.NewHistoryEntry(domain.HistoryUpdated, v.Actor, before, v)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// Delete marks a vehicle in the PostgreSQL database as deleted by its VIN and records it in the vehicle history
+func (r *PostgresVehicleRepo) Delete(ctx context.Context, vin, actor string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	deleted, err := scanVehicle(tx.QueryRow(ctx,
+		fmt.Sprintf(`UPDATE vehicles SET deleted_at=now(), version=version+1
+		WHERE vin=$1 AND deleted_at IS NULL
+		RETURNING %s`, listColumns), vin,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if err := r.record(ctx, tx, domain.NewHistoryEntry(domain.HistoryDeleted, actor, deleted, nil)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
-// List lists all vehicles in the PostgreSQL database
-func (r *PostgresVehicleRepo) List() ([]*domain.Vehicle, error) {
-	rows, err := r.db.Query(context.Background(),
-		`SELECT
-		vin, year, msrp, odometer, brand, engine, transmission, grade, price, exterior_color, interior_color, small_scratches, strong_scratches, electric_fail, suspension_fail
-		FROM vehicles`,
+// Restore clears the deletion mark of a vehicle in the PostgreSQL database and records it in the vehicle history
+func (r *PostgresVehicleRepo) Restore(ctx context.Context, vin, actor string) (*domain.Vehicle, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	restored, err := scanVehicle(tx.QueryRow(ctx,
+		fmt.Sprintf(`UPDATE vehicles SET deleted_at=NULL, version=version+1
+		WHERE vin=$1 AND deleted_at IS NOT NULL
+		RETURNING %s`, listColumns), vin,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+
+		// Nothing restored, find out whether the vehicle exists at all
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM vehicles WHERE vin=$1)`, vin).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, domain.ErrNotDeleted
+		}
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := r.record(ctx, tx, domain.NewHistoryEntry(domain.HistoryRestored, actor, nil, restored)); err != nil {
+		return nil, err
+	}
+	return restored, tx.Commit(ctx)
+}
+
+// Purge permanently removes vehicles deleted before the given time from the PostgreSQL database
+func (r *PostgresVehicleRepo) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	rows, err := tx.Query(ctx,
+		fmt.Sprintf(`DELETE FROM vehicles WHERE deleted_at < $1 RETURNING %s`, listColumns), deletedBefore,
 	)
+	if err != nil {
+		return 0, err
+	}
+	entries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*domain.HistoryEntry, error) {
+		v, err := scanVehicle(row)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewHistoryEntry(domain.HistoryPurged, domain.SystemActor, v, nil), nil
+	})
+	if err != nil || len(entries) == 0 {
+		return 0, err
+	}
+	if err := r.record(ctx, tx, entries...); err != nil {
+		return 0, err
+	}
+	return len(entries), tx.Commit(ctx)
+}
+
+// sortColumns maps the sort fields of a vehicles listing to table columns
+var sortColumns = map[string]string{
+	domain.SortByVIN:      "vin",
+	domain.SortByYear:     "year",
+	domain.SortByOdometer: "odometer",
+	domain.SortByGrade:    "grade",
+	domain.SortByPrice:    "price",
+	domain.SortByMSRP:     "msrp",
+	domain.SortByBrand:    "brand",
+}
+
+// listColumns are the columns selected by vehicles listings, in the order of scanVehicle
+const listColumns = `vin, year, msrp, odometer, brand, engine, transmission, grade, price, exterior_color, interior_color, small_scratches, strong_scratches, electric_fail, suspension_fail, version, sale_status, buy_now_price, buyer, sale_price, sale_channel, sale_id, lifecycle, enrichment_attempts, enrichment_retry_at, enrichment_error, ` + buildDataColumns
+
+// buildDataColumns are the columns of the build data, in the order of buildDataValues
+const buildDataColumns = `model, trim, body_class, drive_type, fuel_type, displacement, cylinders, doors, electrification_level, decode_quality`
+
+// listFilter builds the WHERE clause and its arguments for a vehicles listing
+func listFilter(q *domain.VehicleQuery, cursor *domain.Vehicle) (string, []any) {
+	conds := []string{"deleted_at IS NULL"}
+	var args []any
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	// Filters
+	if q.Brand != "" {
+		add("lower(brand) = lower($%d)", q.Brand)
+	}
+	if q.BodyClass != "" {
+		add("lower(body_class) = lower($%d)", q.BodyClass)
+	}
+	if q.FuelType != "" {
+		add("lower(fuel_type) = lower($%d)", q.FuelType)
+	}
+	if q.YearFrom != 0 {
+		add("year >= $%d", q.YearFrom)
+	}
+	if q.YearTo != 0 {
+		add("year <= $%d", q.YearTo)
+	}
+	if q.OdometerFrom != 0 {
+		add("odometer >= $%d", q.OdometerFrom)
+	}
+	if q.OdometerTo != 0 {
+		add("odometer <= $%d", q.OdometerTo)
+	}
+	if q.GradeFrom != 0 {
+		add("grade >= $%d", q.GradeFrom)
+	}
+	if q.GradeTo != 0 {
+		add("grade <= $%d", q.GradeTo)
+	}
+	if q.PriceFrom != 0 {
+		add("price >= $%d", q.PriceFrom)
+	}
+	if q.PriceTo != 0 {
+		add("price <= $%d", q.PriceTo)
+	}
+	if q.Status != "" {
+		add("sale_status = $%d", q.Status)
+	}
+	if q.Lifecycle != "" {
+		add("lifecycle = $%d", q.Lifecycle)
+	}
+	if len(q.Colors) > 0 {
+		colors := make([]string, len(q.Colors))
+		for i, c := range q.Colors {
+			colors[i] = strings.ToLower(c)
+		}
+		add("lower(exterior_color) = ANY($%d)", colors)
+	}
+
+	// Keyset pagination
+	if cursor != nil {
+		op := ">"
+		if q.Order == domain.OrderDesc {
+			op = "<"
+		}
+		col := sortColumns[q.SortBy]
+		args = append(args, q.SortValue(cursor), cursor.VIN)
+		conds = append(conds, fmt.Sprintf("(%s, vin) %s ($%d, $%d)", col, op, len(args)-1, len(args)))
+	}
+
+	return "WHERE " + strings.Join(conds, " AND "), args
+}
+
+// List lists vehicles matching the query in the PostgreSQL database
+func (r *PostgresVehicleRepo) List(ctx context.Context, q *domain.VehicleQuery) ([]*domain.Vehicle, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	cursor, err := q.Cursor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the query
+	where, args := listFilter(q, cursor)
+	order := "ASC"
+	if q.Order == domain.OrderDesc {
+		order = "DESC"
+	}
+	col := sortColumns[q.SortBy]
+	args = append(args, q.Limit)
+	sql := fmt.Sprintf(`SELECT %s
+		FROM vehicles %s ORDER BY %s %s, vin %s LIMIT $%d`, listColumns, where, col, order, order, len(args))
+
+	// Fetch the rows
+	rows, err := r.db.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var vehicles []*domain.Vehicle
+	vehicles := make([]*domain.Vehicle, 0, q.Limit)
 	for rows.Next() {
-		var v domain.Vehicle
-		if err := rows.Scan(
-			&v.VIN, &v.Year, &v.MSRP, &v.Odometer, &v.Brand, &v.Engine, &v.Transmission, &v.Grade, &v.Price, &v.ExteriorColor, &v.InteriorColor, &v.SmallScratches, &v.StrongScratches, &v.ElectricFail, &v.SuspensionFail,
-		); err != nil {
+		v, err := scanVehicle(rows)
+		if err != nil {
 			return nil, err
 		}
-		vehicles = append(vehicles, &v)
+		vehicles = append(vehicles, v)
+	}
+	return vehicles, rows.Err()
+}
+
+// scanVehicle scans a vehicle row selected with listColumns
+func scanVehicle(row pgx.Row) (*domain.Vehicle, error) {
+	var v domain.Vehicle
+	var e domain.Enrichment
+	var retryAt *time.Time
+	if err := row.Scan(
+		&v.VIN, &v.Year, &v.MSRP, &v.Odometer, &v.Brand, &v.Engine, &v.Transmission, &v.Grade, &v.Price, &v.ExteriorColor, &v.InteriorColor, &v.SmallScratches, &v.StrongScratches, &v.ElectricFail, &v.SuspensionFail, &v.Version,
+		&v.Status, &v.BuyNowPrice, &v.Buyer, &v.SalePrice, &v.Channel, &v.SaleID, &v.Lifecycle, &e.Attempts, &retryAt, &e.Error,
+		&v.Model, &v.Trim, &v.BodyClass, &v.DriveType, &v.FuelType, &v.Displacement, &v.Cylinders, &v.Doors, &v.ElectrificationLevel, &v.DecodeQuality,
+	); err != nil {
+		return nil, err
+	}
+	if retryAt != nil {
+		e.RetryAt = retryAt.UTC()
+		v.Enrichment = &e
+	}
+	return &v, nil
+}
+
+// enrichmentValues returns the column values of the pending enrichment, a nil enrichment clears the columns
+func enrichmentValues(e *domain.Enrichment) (int, *time.Time, string) {
+	if e == nil {
+		return 0, nil, ""
+	}
+	return e.Attempts, &e.RetryAt, e.Error
+}
+
+// buildDataValues returns the column values of the build data
+func buildDataValues(b *domain.BuildData) []any {
+	return []any{b.Model, b.Trim, b.BodyClass, b.DriveType, b.FuelType, b.Displacement, b.Cylinders, b.Doors, b.ElectrificationLevel, b.DecodeQuality}
+}
+
+// Export streams vehicles matching the query through a server-side cursor,
+// the cursor runs in a read-only repeatable read transaction so the export is a consistent snapshot
+func (r *PostgresVehicleRepo) Export(ctx context.Context, q *domain.VehicleQuery, fn func(*domain.Vehicle) error) error {
+
+	// Begin a snapshot transaction
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	// Declare the cursor
+	where, args := listFilter(q, nil)
+	order := "ASC"
+	if q.Order == domain.OrderDesc {
+		order = "DESC"
+	}
+	sql := fmt.Sprintf(`DECLARE vehicles_export NO SCROLL CURSOR FOR SELECT %s
+		FROM vehicles %s ORDER BY %s %s, vin %s`, listColumns, where, sortColumns[q.SortBy], order, order)
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	// Fetch the rows batch by batch
+	for {
+		rows, err := tx.Query(ctx, `FETCH 500 FROM vehicles_export`)
+		if err != nil {
+			return err
+		}
+		n := 0
+		for rows.Next() {
+			n++
+			v, err := scanVehicle(rows)
+			if err == nil {
+				err = fn(v)
+			}
+			if err != nil {
+				rows.Close()
+				return err
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if n == 0 {
+			return tx.Commit(ctx)
+		}
 	}
-	return vehicles, nil
 }
 
 // copy copies a batch of vehicles to the PostgreSQL database
@@ -100,14 +477,19 @@ func (r *PostgresVehicleRepo) copy(ctx context.Context, tx pgx.Tx, table string,
 	// Prepare data for bulk insert
 	values := make([][]any, len(vehicles))
 	for i, v := range vehicles {
-		values[i] = []any{v.VIN, v.Year, v.Odometer, v.Brand, v.Engine, v.Transmission, v.MSRP, v.Grade, v.Price, v.ExteriorColor, v.InteriorColor, v.SmallScratches, v.StrongScratches, v.ElectricFail, v.SuspensionFail}
+		attempts, retryAt, enrichmentErr := enrichmentValues(v.Enrichment)
+		values[i] = append([]any{v.VIN, v.Year, v.Odometer, v.Brand, v.Engine, v.Transmission, v.MSRP, v.Grade, v.Price, v.ExteriorColor, v.InteriorColor, v.SmallScratches, v.StrongScratches, v.ElectricFail, v.SuspensionFail, v.Version,
+			attempts, retryAt, enrichmentErr},
+			buildDataValues(&v.BuildData)...)
 	}
 
 	// Perform bulk insert using CopyFrom
 	_, err := tx.CopyFrom(
 		ctx,
 		pgx.Identifier{table},
-		[]string{"vin", "year", "odometer", "brand", "engine", "transmission", "msrp", "grade", "price", "exterior_color", "interior_color", "small_scratches", "strong_scratches", "electric_fail", "suspension_fail"},
+		[]string{"vin", "year", "odometer", "brand", "engine", "transmission", "msrp", "grade", "price", "exterior_color", "interior_color", "small_scratches", "strong_scratches", "electric_fail", "suspension_fail", "version",
+			"enrichment_attempts", "enrichment_retry_at", "enrichment_error",
+			"model", "trim", "body_class", "drive_type", "fuel_type", "displacement", "cylinders", "doors", "electrification_level", "decode_quality"},
 		pgx.CopyFromRows(values),
 	)
 	return err
@@ -115,10 +497,10 @@ func (r *PostgresVehicleRepo) copy(ctx context.Context, tx pgx.Tx, table string,
 }
 
 // SaveBulk saves a vehicles bulk to the PostgreSQL database
-func (r *PostgresVehicleRepo) SaveBulk(vb *domain.VehiclesBulk) error {
+func (r *PostgresVehicleRepo) SaveBulk(ctx context.Context, vb *domain.VehiclesBulk) error {
 
 	// Begin a transaction
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
@@ -127,23 +509,38 @@ func (r *PostgresVehicleRepo) SaveBulk(vb *domain.VehiclesBulk) error {
 	defer tx.Rollback(ctx) // nolint:errcheck
 
 	// Insert vehicles in batches
+	for _, v := range vb.Vehicles {
+		v.Version = 1
+		v.Lifecycle = domain.LifecycleDraft
+		v.Sale = domain.Sale{}
+	}
 	size := 200
 	for i := 0; i < len(vb.Vehicles); i += size {
 		end := min(i+size, len(vb.Vehicles))
 		if err := r.copy(ctx, tx, "vehicles", vb.Vehicles[i:end]); err != nil {
-			return err
+			return mapWriteError(err)
 		}
 	}
 
+	// Record the created vehicles in the history
+	entries := make([]*domain.HistoryEntry, len(vb.Vehicles))
+	for i, v := range vb.Vehicles {
+		entries[i] = domain.NewHistoryEntry(domain.HistoryCreated, v.Actor, nil, v)
+	}
+	if err := r.record(ctx, tx, entries...); err != nil {
+		return err
+	}
+
 	// Commit the transaction
 	return tx.Commit(ctx)
 }
 
-// UpdateBulk updates a vehicles bulk in the PostgreSQL database
-func (r *PostgresVehicleRepo) UpdateBulk(vb *domain.VehiclesBulk) error {
+// UpdateBulk updates a vehicles bulk in the PostgreSQL database keeping their sale state,
+// the pending enrichment follows the fetched vehicles
+func (r *PostgresVehicleRepo) UpdateBulk(ctx context.Context, vb *domain.VehiclesBulk) error {
 
 	// Begin a transaction
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
@@ -168,7 +565,21 @@ func (r *PostgresVehicleRepo) UpdateBulk(vb *domain.VehiclesBulk) error {
 			small_scratches BOOLEAN,
 			strong_scratches BOOLEAN,
 			electric_fail BOOLEAN,
-			suspension_fail BOOLEAN
+			suspension_fail BOOLEAN,
+			version BIGINT NOT NULL,
+			enrichment_attempts INT,
+			enrichment_retry_at TIMESTAMPTZ,
+			enrichment_error TEXT,
+			model VARCHAR(100),
+			trim VARCHAR(100),
+			body_class VARCHAR(100),
+			drive_type VARCHAR(50),
+			fuel_type VARCHAR(50),
+			displacement DOUBLE PRECISION,
+			cylinders INT,
+			doors INT,
+			electrification_level VARCHAR(100),
+			decode_quality JSONB
         ) ON COMMIT DROP;
     `)
 	if err != nil {
@@ -184,8 +595,47 @@ func (r *PostgresVehicleRepo) UpdateBulk(vb *domain.VehiclesBulk) error {
 		}
 	}
 
+	// Lock the stored vehicles and check their versions
+	vins := make([]string, len(vb.Vehicles))
+	for i, v := range vb.Vehicles {
+		vins[i] = v.VIN
+	}
+	rows, err := tx.Query(ctx,
+		fmt.Sprintf(`SELECT %s FROM vehicles WHERE vin = ANY($1) AND deleted_at IS NULL ORDER BY vin FOR UPDATE`, listColumns), vins,
+	)
+	if err != nil {
+		return err
+	}
+	stored, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*domain.Vehicle, error) {
+		return scanVehicle(row)
+	})
+	if err != nil {
+		return err
+	}
+	before := make(map[string]*domain.Vehicle, len(stored))
+	for _, v := range stored {
+		before[v.VIN] = v
+	}
+	var conflicts []string
+	for _, v := range vb.Vehicles {
+		b, ok := before[v.VIN]
+		if !ok {
+			return fmt.Errorf("%w: %s", domain.ErrNotFound, v.VIN)
+		}
+		if b.Status == domain.SalePending {
+			return fmt.Errorf("%w: %s", domain.ErrSalePending, v.VIN)
+		}
+		if v.Version != 0 && v.Version != b.Version {
+			conflicts = append(conflicts, v.VIN)
+		}
+	}
+	if len(conflicts) > 0 {
+		slices.Sort(conflicts)
+		return &domain.VersionConflictError{VINs: conflicts}
+	}
+
 	// Update the main vehicles table using the data from the temporary table
-	_, err = tx.Exec(ctx, `
+	rows, err = tx.Query(ctx, `
         UPDATE vehicles v
         SET price = t.price,
             year  = t.year,
@@ -200,14 +650,287 @@ func (r *PostgresVehicleRepo) UpdateBulk(vb *domain.VehiclesBulk) error {
 			small_scratches = t.small_scratches,
 			strong_scratches = t.strong_scratches,
 			electric_fail = t.electric_fail,
-			suspension_fail = t.suspension_fail
+			suspension_fail = t.suspension_fail,
+			model = t.model,
+			trim = t.trim,
+			body_class = t.body_class,
+			drive_type = t.drive_type,
+			fuel_type = t.fuel_type,
+			displacement = t.displacement,
+			cylinders = t.cylinders,
+			doors = t.doors,
+			electrification_level = t.electrification_level,
+			decode_quality = t.decode_quality,
+			enrichment_attempts = t.enrichment_attempts,
+			enrichment_retry_at = t.enrichment_retry_at,
+			enrichment_error = t.enrichment_error,
+			version = v.version + 1
         FROM tmp_vehicles t
-        WHERE v.vin = t.vin;
-    `)
+        WHERE v.vin = t.vin
+        RETURNING v.`+strings.ReplaceAll(listColumns, ", ", ", v."))
 	if err != nil {
 		return err
 	}
+	updated, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*domain.Vehicle, error) {
+		return scanVehicle(row)
+	})
+	if err != nil {
+		return err
+	}
+	after := make(map[string]*domain.Vehicle, len(updated))
+	for _, v := range updated {
+		after[v.VIN] = v
+	}
+	for _, v := range vb.Vehicles {
+		a := after[v.VIN]
+		v.Version = a.Version
+		v.Lifecycle = a.Lifecycle
+		v.Sale = a.Sale
+		v.Enrichment = a.Enrichment
+	}
+
+	// Record the changes in the history
+	entries := make([]*domain.HistoryEntry, len(vb.Vehicles))
+	for i, v := range vb.Vehicles {
+		entries[i] = domain.NewHistoryEntry(domain.HistoryUpdated, v.Actor, before[v.VIN], v)
+	}
+	if err := r.record(ctx, tx, entries...); err != nil {
+		return err
+	}
 
 	// Commit the transaction
 	return tx.Commit(ctx)
 }
+
+// UpdateSale updates the sale state and the lifecycle status of the vehicle and the given offers in the PostgreSQL database within a transaction,
+// the vehicle may be nil and all versions must match the stored ones
+func (r *PostgresVehicleRepo) UpdateSale(ctx context.Context, v *domain.Vehicle, offers ...*domain.Offer) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	// Update the sale state of the vehicle and record the change
+	if v != nil {
+		before, err := scanVehicle(tx.QueryRow(ctx,
+			fmt.Sprintf(`SELECT %s FROM vehicles WHERE vin=$1 AND deleted_at IS NULL FOR UPDATE`, listColumns), v.VIN,
+		))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if v.Version != before.Version {
+			return domain.ErrVersionConflict
+		}
+		after, err := scanVehicle(tx.QueryRow(ctx,
+			fmt.Sprintf(`UPDATE vehicles
+			SET sale_status=$1, buy_now_price=$2, buyer=$3, sale_price=$4, sale_channel=$5, sale_id=$6, lifecycle=$7, version=version+1
+			WHERE vin=$8
+			RETURNING %s`, listColumns),
+			v.Status, v.BuyNowPrice, v.Buyer, v.SalePrice, v.Channel, v.SaleID, v.Lifecycle, v.VIN,
+		))
+		if err != nil {
+			return err
+		}
+		if err := r.record(ctx, tx, domain.NewHistoryEntry(domain.HistoryUpdated, v.Actor, before, after)); err != nil {
+			return err
+		}
+		v.Version = after.Version
+	}
+
+	// Update the offers guarded by their versions
+	for _, o := range offers {
+		err := tx.QueryRow(ctx,
+			`UPDATE vehicle_offers SET status=$1, counter=$2, updated_at=$3, version=version+1
+			WHERE vin=$4 AND id=$5 AND version=$6
+			RETURNING version`,
+			o.Status, o.Counter, o.UpdatedAt, o.VIN, o.ID, o.Version,
+		).Scan(&o.Version)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrVersionConflict
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// UpdateLifecycle updates the lifecycle status of the vehicle in the PostgreSQL database and records the change
+// in the vehicle history, the version must match the stored one
+func (r *PostgresVehicleRepo) UpdateLifecycle(ctx context.Context, v *domain.Vehicle) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	// Lock the stored vehicle and check its version
+	before, err := scanVehicle(tx.QueryRow(ctx,
+		fmt.Sprintf(`SELECT %s FROM vehicles WHERE vin=$1 AND deleted_at IS NULL FOR UPDATE`, listColumns), v.VIN,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if v.Version != before.Version {
+		return domain.ErrVersionConflict
+	}
+
+	// Update the lifecycle status and record the change
+	after, err := scanVehicle(tx.QueryRow(ctx,
+		fmt.Sprintf(`UPDATE vehicles SET lifecycle=$1, version=version+1 WHERE vin=$2 RETURNING %s`, listColumns),
+		v.Lifecycle, v.VIN,
+	))
+	if err != nil {
+		return err
+	}
+	if err := r.record(ctx, tx, domain.NewHistoryEntry(domain.HistoryUpdated, v.Actor, before, after)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	v.Version = after.Version
+	return nil
+}
+
+// UpdateEnrichment updates the enriched data and the pending enrichment of the vehicle in the PostgreSQL database,
+// the version must match the stored one and only changes of the data bump it and are recorded in the vehicle history
+func (r *PostgresVehicleRepo) UpdateEnrichment(ctx context.Context, v *domain.Vehicle) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	// Lock the stored vehicle and check its version
+	before, err := scanVehicle(tx.QueryRow(ctx,
+		fmt.Sprintf(`SELECT %s FROM vehicles WHERE vin=$1 AND deleted_at IS NULL FOR UPDATE`, listColumns), v.VIN,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if v.Version != before.Version {
+		return domain.ErrVersionConflict
+	}
+
+	// Update the enriched data and record the change, if any
+	after := before.Enriched(v)
+	e := domain.NewHistoryEntry(domain.HistoryUpdated, v.Actor, before, after)
+	if len(e.Changes) > 0 {
+		after.Version++
+		e.Version = after.Version
+	}
+	attempts, retryAt, enrichmentErr := enrichmentValues(after.Enrichment)
+	if _, err := tx.Exec(ctx,
+		`UPDATE vehicles
+		SET brand=$1, engine=$2, transmission=$3, msrp=$4, grade=$5, price=$6, enrichment_attempts=$7, enrichment_retry_at=$8, enrichment_error=$9, version=$10,
+		model=$11, trim=$12, body_class=$13, drive_type=$14, fuel_type=$15, displacement=$16, cylinders=$17, doors=$18, electrification_level=$19, decode_quality=$20
+		WHERE vin=$21`,
+		append(append([]any{
+			after.Brand, after.Engine, after.Transmission, after.MSRP, after.Grade, after.Price, attempts, retryAt, enrichmentErr, after.Version,
+		}, buildDataValues(&after.BuildData)...), v.VIN)...,
+	); err != nil {
+		return err
+	}
+	if len(e.Changes) > 0 {
+		if err := r.record(ctx, tx, e); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	v.Version = after.Version
+	return nil
+}
+
+// ListPendingEnrichment lists up to limit vehicles pending enrichment from the PostgreSQL database, the earliest retry first
+func (r *PostgresVehicleRepo) ListPendingEnrichment(ctx context.Context, limit int) ([]*domain.Vehicle, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		fmt.Sprintf(`SELECT %s FROM vehicles
+		WHERE enrichment_retry_at IS NOT NULL AND deleted_at IS NULL
+		ORDER BY enrichment_retry_at, vin LIMIT $1`, listColumns), limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*domain.Vehicle, error) {
+		return scanVehicle(row)
+	})
+}
+
+// offerColumns are the columns selected by offer queries, in the order of scanOffer
+const offerColumns = `id, vin, buyer, amount, counter, status, version, created_at, updated_at`
+
+// scanOffer scans an offer row selected with offerColumns
+func scanOffer(row pgx.Row) (*domain.Offer, error) {
+	var o domain.Offer
+	if err := row.Scan(&o.ID, &o.VIN, &o.Buyer, &o.Amount, &o.Counter, &o.Status, &o.Version, &o.CreatedAt, &o.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// SaveOffer saves an offer of an existing vehicle to the PostgreSQL database
+func (r *PostgresVehicleRepo) SaveOffer(ctx context.Context, o *domain.Offer) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	o.Version = 1
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO vehicle_offers (vin, buyer, amount, counter, status, version, created_at, updated_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8
+		WHERE EXISTS (SELECT 1 FROM vehicles WHERE vin=$1 AND deleted_at IS NULL)
+		RETURNING id`,
+		o.VIN, o.Buyer, o.Amount, o.Counter, o.Status, o.Version, o.CreatedAt, o.UpdatedAt,
+	).Scan(&o.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	return err
+}
+
+// FindOffer retrieves an offer of the vehicle by its ID
+func (r *PostgresVehicleRepo) FindOffer(ctx context.Context, vin string, id int64) (*domain.Offer, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	o, err := scanOffer(r.db.QueryRow(ctx,
+		fmt.Sprintf(`SELECT %s FROM vehicle_offers WHERE vin=$1 AND id=$2`, offerColumns), vin, id,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrOfferNotFound
+	}
+	return o, err
+}
+
+// ListOffers lists the offers of the vehicle, oldest first
+func (r *PostgresVehicleRepo) ListOffers(ctx context.Context, vin string) ([]*domain.Offer, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	rows, err := r.db.Query(ctx,
+		fmt.Sprintf(`SELECT %s FROM vehicle_offers WHERE vin=$1 ORDER BY id`, offerColumns), vin,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*domain.Offer, error) {
+		return scanOffer(row)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/alechekz/online-car-auction/pkg/resilience"
 	pb "github.com/alechekz/online-car-auction/services/pricing/delivery/grpc/proto"
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
 	"google.golang.org/grpc"
@@ -12,18 +13,24 @@ import (
 
 // PricingGRPCClient is a gRPC client for the Pricing Service
 type PricingGRPCClient struct {
-	client pb.PricingServiceClient
-	conn   *grpc.ClientConn
+	client  pb.PricingServiceClient
+	conn    *grpc.ClientConn
+	timeout time.Duration
 }
 
-// NewPricingGRPCClient creates a new PricingGRPCClient instance
-func NewPricingGRPCClient(address string) (*PricingGRPCClient, error) {
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewPricingGRPCClient creates a new PricingGRPCClient instance, the timeout limits every call with all of its retries
+// and the breaker, if any, guards the Pricing Service
+func NewPricingGRPCClient(address string, timeout time.Duration, policy resilience.RetryPolicy, breaker *resilience.Breaker) (*PricingGRPCClient, error) {
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		resilience.DialOption(policy, breaker, pb.PricingService_GetRecommendedPrice_FullMethodName),
+	)
 	if err != nil {
 		return nil, err
 	}
 	client := pb.NewPricingServiceClient(conn)
-	return &PricingGRPCClient{client: client, conn: conn}, nil
+	return &PricingGRPCClient{client: client, conn: conn, timeout: timeout}, nil
 }
 
 // Close closes the gRPC connection
@@ -32,8 +39,8 @@ func (c *PricingGRPCClient) Close() error {
 }
 
 // GetRecommendedPrice sends price request to the Pricing Service
-func (c *PricingGRPCClient) GetRecommendedPrice(v *domain.Vehicle) (uint64, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+func (c *PricingGRPCClient) GetRecommendedPrice(ctx context.Context, v *domain.Vehicle) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	req := &pb.PriceRequest{
@@ -45,7 +52,7 @@ func (c *PricingGRPCClient) GetRecommendedPrice(v *domain.Vehicle) (uint64, erro
 	}
 	resp, err := c.client.GetRecommendedPrice(ctx, req)
 	if err != nil {
-		return 0, err
+		return 0, mapClientError(err)
 	}
 	return resp.Price, nil
 }
@@ -2,29 +2,74 @@ package server
 
 import (
 	"os"
+	"strconv"
+	"time"
+
+	"github.com/alechekz/online-car-auction/pkg/resilience"
 )
 
 // config holds the configuration for the Vehicle Service server
 type config struct {
 	Address       string
+	GrpcAddress   string
 	InspectionURL string
 	PricingURL    string
 	DatabaseURL   string
 	Repo          string // "postgres" or "inmemory"
+	Retention     time.Duration
+	PurgeInterval time.Duration
+	BuyNowMarkup  uint64 // percent added to the recommended price
+
+	// EnrichInterval is how often vehicles saved while a dependency was unavailable are enriched
+	EnrichInterval time.Duration
+
+	// BulkJobLease is how long a bulk job stays claimed by an instance that stopped renewing it,
+	// unfinished jobs are taken over by the other instances as often
+	BulkJobLease time.Duration
+
+	// Deadlines of the calls to each dependency
+	InspectionTimeout time.Duration
+	PricingTimeout    time.Duration
+	DatabaseTimeout   time.Duration
+
+	// Retries and circuit breakers of the calls to each dependency
+	InspectionRetry   resilience.RetryPolicy
+	PricingRetry      resilience.RetryPolicy
+	InspectionBreaker resilience.BreakerConfig
+	PricingBreaker    resilience.BreakerConfig
 }
 
 // NewConfig creates a new server configuration with default values
 func NewConfig() *config {
 	cfg := &config{
 		Address:       ":6061",
+		GrpcAddress:   ":6066",
 		InspectionURL: ":6063",
 		PricingURL:    ":6065",
 
-		Repo: "inmemory",
+		Repo:          "inmemory",
+		Retention:     30 * 24 * time.Hour,
+		PurgeInterval: time.Hour,
+		BuyNowMarkup:  10,
+
+		EnrichInterval: 30 * time.Second,
+		BulkJobLease:   time.Minute,
+
+		InspectionTimeout: 15 * time.Second,
+		PricingTimeout:    15 * time.Second,
+		DatabaseTimeout:   15 * time.Second,
+
+		InspectionRetry:   resilience.RetryPolicyFromEnv("INSPECTION"),
+		PricingRetry:      resilience.RetryPolicyFromEnv("PRICING"),
+		InspectionBreaker: resilience.BreakerConfigFromEnv("INSPECTION"),
+		PricingBreaker:    resilience.BreakerConfigFromEnv("PRICING"),
 	}
 	if os.Getenv("VEHICLE_URL") != "" {
 		cfg.Address = os.Getenv("VEHICLE_URL")
 	}
+	if os.Getenv("VEHICLE_GRPC") != "" {
+		cfg.GrpcAddress = os.Getenv("VEHICLE_GRPC")
+	}
 	if os.Getenv("INSPECTION_URL") != "" {
 		cfg.InspectionURL = os.Getenv("INSPECTION_URL")
 	}
@@ -35,5 +80,29 @@ func NewConfig() *config {
 		cfg.DatabaseURL = os.Getenv("VEHICLE_DB")
 		cfg.Repo = "postgres"
 	}
+	if d, err := time.ParseDuration(os.Getenv("VEHICLE_RETENTION")); err == nil && d > 0 {
+		cfg.Retention = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("VEHICLE_PURGE_INTERVAL")); err == nil && d > 0 {
+		cfg.PurgeInterval = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("VEHICLE_ENRICH_INTERVAL")); err == nil && d > 0 {
+		cfg.EnrichInterval = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("VEHICLE_BULK_JOB_LEASE")); err == nil && d > 0 {
+		cfg.BulkJobLease = d
+	}
+	if n, err := strconv.ParseUint(os.Getenv("VEHICLE_BUY_NOW_MARKUP"), 10, 64); err == nil {
+		cfg.BuyNowMarkup = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("INSPECTION_TIMEOUT")); err == nil && d > 0 {
+		cfg.InspectionTimeout = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("PRICING_TIMEOUT")); err == nil && d > 0 {
+		cfg.PricingTimeout = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("VEHICLE_DB_TIMEOUT")); err == nil && d > 0 {
+		cfg.DatabaseTimeout = d
+	}
 	return cfg
 }
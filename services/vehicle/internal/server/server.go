@@ -1,10 +1,19 @@
 package server
 
 import (
+	"context"
+	"expvar"
 	"log/slog"
+	"net"
 	"net/http"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/alechekz/online-car-auction/pkg/resilience"
+	vehiclegrpc "github.com/alechekz/online-car-auction/services/vehicle/delivery/grpc"
+	pb "github.com/alechekz/online-car-auction/services/vehicle/delivery/grpc/proto"
 	vehiclehttp "github.com/alechekz/online-car-auction/services/vehicle/delivery/http"
 	"github.com/alechekz/online-car-auction/services/vehicle/infrastructure"
 	"github.com/alechekz/online-car-auction/services/vehicle/internal/logger"
@@ -12,9 +21,18 @@ import (
 	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
 )
 
-// Server represents the HTTP server for the Vehicle Service
+// Server represents both HTTP and gRPC servers for the Vehicle Service
 type Server struct {
-	httpServer *http.Server
+	httpServer     *http.Server
+	grpcServer     *grpc.Server
+	grpcLis        net.Listener
+	jobs           usecase.BulkJobUsecase
+	vehicles       usecase.VehicleUsecase
+	retention      time.Duration
+	purgeInterval  time.Duration
+	enrichInterval time.Duration
+	jobLease       time.Duration
+	stop           chan struct{}
 }
 
 // NewServer creates and configures a new Server instance with PostgreSQL repository
@@ -22,36 +40,60 @@ func NewServer(cfg *config) (*Server, error) {
 
 	// dependencies
 	var repo repository.VehicleRepository
+	var jobRepo repository.BulkJobRepository
 	switch cfg.Repo {
 	case "postgres":
 		logger.Log.Info("using postgres vehicle repository")
 		var err error
-		repo, err = infrastructure.NewPostgresVehicleRepo(cfg.DatabaseURL)
+		repo, err = infrastructure.NewPostgresVehicleRepo(cfg.DatabaseURL, cfg.DatabaseTimeout)
 		if err != nil {
 			logger.Log.Error("failed to connect to postgres", slog.String("error", err.Error()))
 		}
+		jobRepo, err = infrastructure.NewPostgresBulkJobRepo(cfg.DatabaseURL, cfg.DatabaseTimeout)
+		if err != nil {
+			logger.Log.Error("failed to connect to postgres for bulk jobs", slog.String("error", err.Error()))
+		}
 	default:
 		logger.Log.Info("using in-memory vehicle repository")
 		repo = infrastructure.NewMemoryVehicleRepo()
+		jobRepo = infrastructure.NewMemoryBulkJobRepo()
 	}
-	inspectionProvider, err := infrastructure.NewInspectionGRPCClient(cfg.InspectionURL)
+	cfg.InspectionBreaker.OnStateChange = logBreakerState
+	cfg.PricingBreaker.OnStateChange = logBreakerState
+	inspectionBreaker := resilience.NewBreaker("inspection", cfg.InspectionBreaker)
+	pricingBreaker := resilience.NewBreaker("pricing", cfg.PricingBreaker)
+	inspectionProvider, err := infrastructure.NewInspectionGRPCClient(cfg.InspectionURL, cfg.InspectionTimeout, cfg.InspectionRetry, inspectionBreaker)
 	if err != nil {
 		logger.Log.Error("failed to create inspection gRPC client", slog.String("error", err.Error()))
 	}
-	pricingProvider, err := infrastructure.NewPricingGRPCClient(cfg.PricingURL)
+	pricingProvider, err := infrastructure.NewPricingGRPCClient(cfg.PricingURL, cfg.PricingTimeout, cfg.PricingRetry, pricingBreaker)
 	if err != nil {
 		logger.Log.Error("failed to create pricing gRPC client", slog.String("error", err.Error()))
 	}
 	logger.Log.Info("connected to dependencies", slog.String("inspection_url", cfg.InspectionURL), slog.String("pricing_url", cfg.PricingURL))
-	uc := usecase.NewVehicleUC(repo, inspectionProvider, pricingProvider)
+	uc := usecase.NewVehicleUC(repo, inspectionProvider, pricingProvider, cfg.BuyNowMarkup)
 	if err != nil {
 		logger.Log.Error("failed to connect to postgres for bulk repo", slog.String("error", err.Error()))
 	}
 	bulkUc := usecase.NewVehiclesBulkUC(repo, uc)
+	jobUc := usecase.NewBulkJobUC(jobRepo, bulkUc, cfg.BulkJobLease)
 	handler := &vehiclehttp.VehicleHandler{UC: uc}
 	bulkHandler := &vehiclehttp.VehiclesBulkHandler{UC: bulkUc}
+	jobHandler := &vehiclehttp.BulkJobHandler{UC: jobUc}
+	importHandler := &vehiclehttp.VehicleImportHandler{UC: usecase.NewVehicleImportUC(repo, bulkUc)}
 
-	mux := vehiclehttp.NewRouter(handler, bulkHandler)
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.Handle("/", vehiclehttp.NewRouter(handler, bulkHandler, jobHandler, importHandler))
+
+	// gRPC handler
+	grpcSrv := grpc.NewServer()
+	pb.RegisterVehicleServiceServer(grpcSrv, vehiclegrpc.NewVehicleServer(uc, bulkUc))
+	reflection.Register(grpcSrv)
+	lis, err := net.Listen("tcp", cfg.GrpcAddress)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Server{
 		httpServer: &http.Server{
@@ -59,19 +101,116 @@ func NewServer(cfg *config) (*Server, error) {
 			Handler:           mux,
 			ReadHeaderTimeout: 15 * time.Second,
 		},
+		grpcServer:     grpcSrv,
+		grpcLis:        lis,
+		jobs:           jobUc,
+		vehicles:       uc,
+		retention:      cfg.Retention,
+		purgeInterval:  cfg.PurgeInterval,
+		enrichInterval: cfg.EnrichInterval,
+		jobLease:       cfg.BulkJobLease,
+		stop:           make(chan struct{}),
 	}, nil
 }
 
-// Start runs the HTTP server
+// logBreakerState logs the state changes of the circuit breakers of the dependencies
+func logBreakerState(name, from, to string) {
+	logger.Log.Warn("circuit breaker state changed", slog.String("dependency", name), slog.String("from", from), slog.String("to", to))
+}
+
+// Start resumes unfinished bulk jobs, starts the purge of deleted vehicles and the enrichment of the pending ones
+// and runs both HTTP and gRPC servers
 func (s *Server) Start() error {
+	s.resumeJobs()
+	go s.takeOverJobs()
+	go s.purge()
+	go s.enrich()
+
+	// gRPC
+	go func() {
+		logger.Log.Info("starting gRPC server", slog.String("addr", s.grpcLis.Addr().String()))
+		if err := s.grpcServer.Serve(s.grpcLis); err != nil {
+			logger.Log.Error("grpc server error", slog.String("err", err.Error()))
+		}
+	}()
+
+	// HTTP
 	logger.Log.Info("starting server", slog.String("addr", s.httpServer.Addr))
 	return s.httpServer.ListenAndServe()
 }
 
-// Stop gracefully shuts down the HTTP server
+// resumeJobs resumes the unfinished bulk jobs no other instance is processing
+func (s *Server) resumeJobs() {
+	if err := s.jobs.Resume(context.Background()); err != nil {
+		logger.Log.Error("failed to resume bulk jobs", slog.String("error", err.Error()))
+	}
+}
+
+// takeOverJobs periodically resumes the bulk jobs whose instance stopped renewing its claim
+func (s *Server) takeOverJobs() {
+	ticker := time.NewTicker(s.jobLease)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.resumeJobs()
+		}
+	}
+}
+
+// purge periodically removes vehicles deleted longer than the retention period ago
+func (s *Server) purge() {
+	ticker := time.NewTicker(s.purgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			n, err := s.vehicles.Purge(context.Background(), s.retention)
+			if err != nil {
+				logger.Log.Error("failed to purge deleted vehicles", slog.String("error", err.Error()))
+				continue
+			}
+			if n > 0 {
+				logger.Log.Info("purged deleted vehicles", slog.Int("count", n))
+			}
+		}
+	}
+}
+
+// enrich periodically retries the enrichment of vehicles saved while a dependency was unavailable
+func (s *Server) enrich() {
+	ticker := time.NewTicker(s.enrichInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			n, err := s.vehicles.Reconcile(context.Background(), time.Now())
+			if err != nil {
+				logger.Log.Error("failed to enrich pending vehicles", slog.String("error", err.Error()))
+				continue
+			}
+			if n > 0 {
+				logger.Log.Info("enriched pending vehicles", slog.Int("count", n))
+			}
+		}
+	}
+}
+
+// Stop gracefully shuts down both servers
 func (s *Server) Stop() error {
-	logger.Log.Info("shutting down server")
-	return s.httpServer.Close()
+	logger.Log.Info("shutting down servers")
+	close(s.stop)
+	if err := s.httpServer.Close(); err != nil {
+		return err
+	}
+	s.grpcServer.GracefulStop()
+	return nil
 }
 
 // Handler returns the HTTP handler of the server
@@ -20,7 +20,9 @@ func TestMain(m *testing.M) {
 // TestNewServer_HandlerResponds checks that the demo server's handler responds to requests
 func TestNewServer_HandlerResponds(t *testing.T) {
 	cfg := server.NewConfig()
-	srv, _ := server.NewServer(cfg)
+	cfg.GrpcAddress = "127.0.0.1:0"
+	srv, err := server.NewServer(cfg)
+	assert.NoError(t, err)
 
 	req := httptest.NewRequest(http.MethodGet, "/health", nil)
 	rec := httptest.NewRecorder()
@@ -29,3 +31,20 @@ func TestNewServer_HandlerResponds(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
+
+// TestNewServer_BreakerMetrics checks that the circuit breakers of the dependencies are published
+func TestNewServer_BreakerMetrics(t *testing.T) {
+	cfg := server.NewConfig()
+	cfg.GrpcAddress = "127.0.0.1:0"
+	srv, err := server.NewServer(cfg)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/debug/vars", nil)
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"inspection":{"name":"inspection","state":"closed"`)
+	assert.Contains(t, rec.Body.String(), `"pricing":{"name":"pricing","state":"closed"`)
+}
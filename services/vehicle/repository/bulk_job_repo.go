@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// BulkJobRepository defines the interface for bulk job data operations, an unfinished job is processed
+// by the instance owning it as long as the owner keeps its lease alive
+type BulkJobRepository interface {
+	Save(ctx context.Context, job *domain.BulkJob) error
+	FindByID(ctx context.Context, id string) (*domain.BulkJob, error)
+	Update(ctx context.Context, job *domain.BulkJob) error
+	Cancel(ctx context.Context, id string) error
+	Claim(ctx context.Context, id, owner string, lease time.Duration) error
+	Heartbeat(ctx context.Context, id, owner string) error
+	ListUnfinished(ctx context.Context) ([]*domain.BulkJob, error)
+}
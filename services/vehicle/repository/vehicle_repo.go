@@ -1,15 +1,33 @@
 package repository
 
-import "github.com/alechekz/online-car-auction/services/vehicle/domain"
+import (
+	"context"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
 
 // VehicleRepository defines the interface for vehicle data operations
 type VehicleRepository interface {
-	Save(v *domain.Vehicle) error
-	FindByVIN(vin string) (*domain.Vehicle, error)
-	Update(v *domain.Vehicle) error
-	Delete(vin string) error
-	List() ([]*domain.Vehicle, error)
-
-	SaveBulk(vb *domain.VehiclesBulk) error
-	UpdateBulk(vb *domain.VehiclesBulk) error
+	Save(ctx context.Context, v *domain.Vehicle) error
+	FindByVIN(ctx context.Context, vin string) (*domain.Vehicle, error)
+	Update(ctx context.Context, v *domain.Vehicle) error
+	Delete(ctx context.Context, vin, actor string) error
+	Restore(ctx context.Context, vin, actor string) (*domain.Vehicle, error)
+	Purge(ctx context.Context, deletedBefore time.Time) (int, error)
+	List(ctx context.Context, q *domain.VehicleQuery) ([]*domain.Vehicle, error)
+	Export(ctx context.Context, q *domain.VehicleQuery, fn func(*domain.Vehicle) error) error
+
+	SaveBulk(ctx context.Context, vb *domain.VehiclesBulk) error
+	UpdateBulk(ctx context.Context, vb *domain.VehiclesBulk) error
+
+	History(ctx context.Context, vin string) ([]*domain.HistoryEntry, error)
+
+	UpdateSale(ctx context.Context, v *domain.Vehicle, offers ...*domain.Offer) error
+	UpdateLifecycle(ctx context.Context, v *domain.Vehicle) error
+	UpdateEnrichment(ctx context.Context, v *domain.Vehicle) error
+	ListPendingEnrichment(ctx context.Context, limit int) ([]*domain.Vehicle, error)
+	SaveOffer(ctx context.Context, o *domain.Offer) error
+	FindOffer(ctx context.Context, vin string, id int64) (*domain.Offer, error)
+	ListOffers(ctx context.Context, vin string) ([]*domain.Offer, error)
 }
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/repository"
+)
+
+// BulkJobUsecase defines the interface for asynchronous bulk vehicle operations
+type BulkJobUsecase interface {
+	Submit(ctx context.Context, operation string, vb *domain.VehiclesBulk) (*domain.BulkJob, error)
+	Get(ctx context.Context, id string) (*domain.BulkJob, error)
+	Cancel(ctx context.Context, id string) (*domain.BulkJob, error)
+	Resume(ctx context.Context) error
+}
+
+// runningJob is a bulk job processed in the background by this instance
+type runningJob struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// bulkJobUsecase is the implementation of BulkJobUsecase interface
+type bulkJobUsecase struct {
+	repo      repository.BulkJobRepository
+	bulkUC    VehiclesBulkUsecase
+	chunkSize int
+	owner     string        // identifies this instance as the owner of the jobs it processes
+	lease     time.Duration // how long a job stays claimed by an owner that stopped renewing it
+
+	mu      sync.Mutex
+	running map[string]*runningJob
+}
+
+// NewBulkJobUC is the constructor for bulkJobUsecase, the jobs processed by this instance are claimed for the lease
+// and the claim is renewed until they finish
+func NewBulkJobUC(r repository.BulkJobRepository, bulkUC VehiclesBulkUsecase, lease time.Duration) *bulkJobUsecase {
+	return &bulkJobUsecase{
+		repo:      r,
+		bulkUC:    bulkUC,
+		chunkSize: 20,
+		owner:     rand.Text(),
+		lease:     lease,
+		running:   make(map[string]*runningJob),
+	}
+}
+
+// newJobID generates a random bulk job ID
+func newJobID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Submit saves a new bulk job and starts processing it in the background
+func (uc *bulkJobUsecase) Submit(ctx context.Context, operation string, vb *domain.VehiclesBulk) (*domain.BulkJob, error) {
+
+	// Prepare and validate the job
+	id, err := newJobID()
+	if err != nil {
+		return nil, err
+	}
+	job := domain.NewBulkJob(id, operation, vb.Vehicles)
+	if err := job.Validate(); err != nil {
+		return nil, domain.ErrValidation
+	}
+
+	// Save the job claimed by this instance and start processing
+	job.Owner = uc.owner
+	if err := uc.repo.Save(ctx, job); err != nil {
+		return nil, err
+	}
+	submitted := job.Clone()
+	uc.start(job)
+	return submitted, nil
+}
+
+// Get retrieves a bulk job by its ID
+func (uc *bulkJobUsecase) Get(ctx context.Context, id string) (*domain.BulkJob, error) {
+	return uc.repo.FindByID(ctx, id)
+}
+
+// Cancel stops processing of a bulk job, vehicles processed so far are kept,
+// the job is cancelled in the store, so the instance processing it stops after its current chunk
+func (uc *bulkJobUsecase) Cancel(ctx context.Context, id string) (*domain.BulkJob, error) {
+	if err := uc.repo.Cancel(ctx, id); err != nil {
+		return nil, err
+	}
+
+	// Stop the job processed by this instance right away and wait for its last progress
+	uc.mu.Lock()
+	rj := uc.running[id]
+	uc.mu.Unlock()
+	if rj != nil {
+		rj.cancel()
+		<-rj.done
+	}
+	return uc.repo.FindByID(ctx, id)
+}
+
+// Resume restarts processing of unfinished bulk jobs, e.g. after a restart, a job is taken over only
+// when no other instance has renewed its claim within the lease
+func (uc *bulkJobUsecase) Resume(ctx context.Context) error {
+	jobs, err := uc.repo.ListUnfinished(ctx)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		uc.mu.Lock()
+		_, ok := uc.running[job.ID]
+		uc.mu.Unlock()
+		if ok {
+			continue
+		}
+		err := uc.repo.Claim(ctx, job.ID, uc.owner, uc.lease)
+		if errors.Is(err, domain.ErrJobClaimed) || errors.Is(err, domain.ErrJobFinished) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		job.Owner = uc.owner
+		uc.start(job)
+	}
+	return nil
+}
+
+// start processes the job in the background and renews its claim while it runs,
+// the job stops when another instance has taken it over
+func (uc *bulkJobUsecase) start(job *domain.BulkJob) {
+	ctx, cancel := context.WithCancel(context.Background())
+	rj := &runningJob{cancel: cancel, done: make(chan struct{})}
+	uc.mu.Lock()
+	uc.running[job.ID] = rj
+	uc.mu.Unlock()
+
+	go func() {
+		defer close(rj.done)
+		defer cancel()
+		defer func() {
+			uc.mu.Lock()
+			delete(uc.running, job.ID)
+			uc.mu.Unlock()
+		}()
+		go uc.heartbeat(ctx, job.ID, cancel)
+		_ = uc.run(ctx, job)
+	}()
+}
+
+// heartbeat renews the claim on the job until the context is done and stops the job once the claim is lost
+func (uc *bulkJobUsecase) heartbeat(ctx context.Context, id string, stop context.CancelFunc) {
+	ticker := time.NewTicker(uc.lease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := uc.repo.Heartbeat(ctx, id, uc.owner)
+			if errors.Is(err, domain.ErrJobClaimed) || errors.Is(err, domain.ErrJobFinished) {
+				stop()
+				return
+			}
+		}
+	}
+}
+
+// run processes the remaining vehicles of the job chunk by chunk and saves the progress after each chunk,
+// if the progress cannot be saved the job stays unfinished and is resumed later,
+// each save reads back the stored status, so a job cancelled by any instance stops after the current chunk;
+// the progress is saved even when the job is stopped during the chunk
+func (uc *bulkJobUsecase) run(ctx context.Context, job *domain.BulkJob) error {
+	saveCtx := context.WithoutCancel(ctx)
+	job.SetStatus(domain.BulkJobRunning)
+	if err := uc.repo.Update(saveCtx, job); err != nil {
+		return err
+	}
+
+	for remaining := job.Remaining(); len(remaining) > 0; remaining = job.Remaining() {
+
+		// Stop on cancellation
+		if ctx.Err() != nil || job.IsFinished() {
+			return nil
+		}
+
+		// Process the next chunk, vehicles left when the job is cancelled are reported as failed
+		vb := &domain.VehiclesBulk{
+			Mode:     domain.BulkModeBestEffort,
+			Vehicles: remaining[:min(uc.chunkSize, len(remaining))],
+		}
+		process := uc.bulkUC.CreateBestEffort
+		if job.Operation == domain.BulkJobUpdate {
+			process = uc.bulkUC.UpdateBestEffort
+		}
+		res, err := process(ctx, vb)
+		if err != nil {
+			return err
+		}
+
+		// Save the progress
+		job.Record(res.Results)
+		if err := uc.repo.Update(saveCtx, job); err != nil {
+			return err
+		}
+	}
+
+	if job.IsFinished() {
+		return nil
+	}
+	job.SetStatus(domain.BulkJobCompleted)
+	return uc.repo.Update(saveCtx, job)
+}
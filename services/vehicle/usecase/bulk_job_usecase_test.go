@@ -0,0 +1,210 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/pkg/vin"
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/infrastructure"
+	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+)
+
+// newTestBulkJobUC is a helper function to create a BulkJobUsecase instance for testing
+func newTestBulkJobUC(jobRepo *infrastructure.MemoryBulkJobRepo, lease time.Duration) (usecase.BulkJobUsecase, *infrastructure.MemoryVehicleRepo) {
+	repo := infrastructure.NewMemoryVehicleRepo()
+	vehicleUC := usecase.NewVehicleUC(repo, &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{}}, &infrastructure.MockPricingProvider{}, 10)
+	bulkUC := usecase.NewVehiclesBulkUC(repo, vehicleUC)
+	return usecase.NewBulkJobUC(jobRepo, bulkUC, lease), repo
+}
+
+// newTestJobVehicles creates n distinct valid vehicles
+func newTestJobVehicles(n int) []*domain.Vehicle {
+	vehicles := make([]*domain.Vehicle, n)
+	for i := range vehicles {
+		v := newTestVehicle()
+		v.VIN = vin.WithCheckDigit(fmt.Sprintf("1HGCM826XLA%06d", i))
+		vehicles[i] = v
+	}
+	return vehicles
+}
+
+// waitFinished polls the job until it is finished
+func waitFinished(t *testing.T, uc usecase.BulkJobUsecase, id string) *domain.BulkJob {
+	var job *domain.BulkJob
+	assert.Eventually(t, func() bool {
+		var err error
+		job, err = uc.Get(t.Context(), id)
+		return err == nil && job.IsFinished()
+	}, 5*time.Second, 10*time.Millisecond)
+	return job
+}
+
+// TestBulkJobUsecase_Submit tests the Submit method of BulkJobUsecase
+func TestBulkJobUsecase_Submit(t *testing.T) {
+	uc, repo := newTestBulkJobUC(infrastructure.NewMemoryBulkJobRepo(), time.Minute)
+
+	// Valid job is processed in the background
+	t.Run("valid job", func(t *testing.T) {
+		vehicles := newTestJobVehicles(45)
+		vehicles[10].VIN = "123"
+		job, err := uc.Submit(t.Context(), domain.BulkJobCreate, &domain.VehiclesBulk{Vehicles: vehicles})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.BulkJobPending, job.Status)
+		assert.Equal(t, 45, job.Total)
+
+		job = waitFinished(t, uc, job.ID)
+		assert.Equal(t, domain.BulkJobCompleted, job.Status)
+		assert.Equal(t, 45, job.Processed)
+		assert.Equal(t, 44, job.Succeeded)
+		assert.Equal(t, 1, job.Failed)
+		assert.Equal(t, domain.BulkStatusFailed, job.Results[10].Status)
+
+		_, err = repo.FindByVIN(t.Context(), vehicles[44].VIN)
+		assert.NoError(t, err)
+	})
+
+	// Invalid jobs are rejected
+	t.Run("unknown operation", func(t *testing.T) {
+		_, err := uc.Submit(t.Context(), "delete", &domain.VehiclesBulk{Vehicles: newTestJobVehicles(1)})
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("no vehicles", func(t *testing.T) {
+		_, err := uc.Submit(t.Context(), domain.BulkJobCreate, &domain.VehiclesBulk{})
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	// Unknown job
+	t.Run("get unknown job", func(t *testing.T) {
+		_, err := uc.Get(t.Context(), "unknown")
+		assert.ErrorIs(t, err, domain.ErrJobNotFound)
+	})
+}
+
+// TestBulkJobUsecase_Cancel tests the Cancel method of BulkJobUsecase
+func TestBulkJobUsecase_Cancel(t *testing.T) {
+	jobRepo := infrastructure.NewMemoryBulkJobRepo()
+	uc, _ := newTestBulkJobUC(jobRepo, time.Minute)
+
+	// Job that is not processed right now is cancelled directly
+	job := domain.NewBulkJob("pending-job", domain.BulkJobCreate, newTestJobVehicles(3))
+	assert.NoError(t, jobRepo.Save(t.Context(), job))
+	got, err := uc.Cancel(t.Context(), job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BulkJobCancelled, got.Status)
+
+	// Finished job cannot be cancelled
+	_, err = uc.Cancel(t.Context(), job.ID)
+	assert.ErrorIs(t, err, domain.ErrJobFinished)
+
+	// Completed job is not overwritten
+	done, err := uc.Submit(t.Context(), domain.BulkJobCreate, &domain.VehiclesBulk{Vehicles: newTestJobVehicles(3)})
+	assert.NoError(t, err)
+	waitFinished(t, uc, done.ID)
+	_, err = uc.Cancel(t.Context(), done.ID)
+	assert.ErrorIs(t, err, domain.ErrJobFinished)
+	got, err = uc.Get(t.Context(), done.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BulkJobCompleted, got.Status)
+
+	// Unknown job
+	_, err = uc.Cancel(t.Context(), "unknown")
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+}
+
+// cancellingJobRepo cancels the job through another instance once its first chunk is processed
+type cancellingJobRepo struct {
+	*infrastructure.MemoryBulkJobRepo
+	other     usecase.BulkJobUsecase
+	cancelled bool
+}
+
+// Update cancels the job through the other instance before saving the first progress
+func (r *cancellingJobRepo) Update(ctx context.Context, job *domain.BulkJob) error {
+	if job.Processed > 0 && !r.cancelled {
+		r.cancelled = true
+		if _, err := r.other.Cancel(ctx, job.ID); err != nil {
+			return err
+		}
+	}
+	return r.MemoryBulkJobRepo.Update(ctx, job)
+}
+
+// TestBulkJobUsecase_CancelElsewhere tests that a job cancelled by another instance stops after its current chunk
+func TestBulkJobUsecase_CancelElsewhere(t *testing.T) {
+	jobRepo := infrastructure.NewMemoryBulkJobRepo()
+	other, _ := newTestBulkJobUC(jobRepo, time.Minute)
+	repo := infrastructure.NewMemoryVehicleRepo()
+	vehicleUC := usecase.NewVehicleUC(repo, &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{}}, &infrastructure.MockPricingProvider{}, 10)
+	uc := usecase.NewBulkJobUC(&cancellingJobRepo{MemoryBulkJobRepo: jobRepo, other: other}, usecase.NewVehiclesBulkUC(repo, vehicleUC), time.Minute)
+
+	vehicles := newTestJobVehicles(45)
+	job, err := uc.Submit(t.Context(), domain.BulkJobCreate, &domain.VehiclesBulk{Vehicles: vehicles})
+	assert.NoError(t, err)
+	got := waitFinished(t, uc, job.ID)
+	assert.Equal(t, domain.BulkJobCancelled, got.Status)
+	assert.Equal(t, 20, got.Processed, "the progress of the current chunk is kept")
+
+	_, err = repo.FindByVIN(t.Context(), vehicles[19].VIN)
+	assert.NoError(t, err)
+	_, err = repo.FindByVIN(t.Context(), vehicles[20].VIN)
+	assert.Error(t, err)
+}
+
+// TestBulkJobUsecase_Resume tests the Resume method of BulkJobUsecase
+func TestBulkJobUsecase_Resume(t *testing.T) {
+	jobRepo := infrastructure.NewMemoryBulkJobRepo()
+	uc, repo := newTestBulkJobUC(jobRepo, time.Minute)
+
+	// Job interrupted after the first vehicle
+	vehicles := newTestJobVehicles(3)
+	job := domain.NewBulkJob("interrupted-job", domain.BulkJobCreate, vehicles)
+	job.SetStatus(domain.BulkJobRunning)
+	job.Record([]*domain.BulkItemResult{{VIN: vehicles[0].VIN, Status: domain.BulkStatusCreated}})
+	assert.NoError(t, jobRepo.Save(t.Context(), job))
+
+	// Only the remaining vehicles are processed
+	assert.NoError(t, uc.Resume(t.Context()))
+	got := waitFinished(t, uc, job.ID)
+	assert.Equal(t, domain.BulkJobCompleted, got.Status)
+	assert.Equal(t, 3, got.Processed)
+	assert.Equal(t, 3, got.Succeeded)
+
+	_, err := repo.FindByVIN(t.Context(), vehicles[0].VIN)
+	assert.Error(t, err)
+	_, err = repo.FindByVIN(t.Context(), vehicles[2].VIN)
+	assert.NoError(t, err)
+}
+
+// TestBulkJobUsecase_ResumeClaimed tests that a job claimed by another instance is resumed only after its lease expires
+func TestBulkJobUsecase_ResumeClaimed(t *testing.T) {
+	lease := 50 * time.Millisecond
+	jobRepo := infrastructure.NewMemoryBulkJobRepo()
+	uc, repo := newTestBulkJobUC(jobRepo, lease)
+
+	vehicles := newTestJobVehicles(3)
+	job := domain.NewBulkJob("claimed-job", domain.BulkJobCreate, vehicles)
+	assert.NoError(t, jobRepo.Save(t.Context(), job))
+	assert.NoError(t, jobRepo.Claim(t.Context(), job.ID, "other", lease))
+
+	// The claim of the other instance is still renewed
+	assert.NoError(t, uc.Resume(t.Context()))
+	got, err := uc.Get(t.Context(), job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BulkJobPending, got.Status)
+
+	// The other instance stopped renewing its claim
+	time.Sleep(2 * lease)
+	assert.NoError(t, uc.Resume(t.Context()))
+	got = waitFinished(t, uc, job.ID)
+	assert.Equal(t, domain.BulkJobCompleted, got.Status)
+	assert.Equal(t, 3, got.Succeeded)
+
+	_, err = repo.FindByVIN(t.Context(), vehicles[2].VIN)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// enrichmentBatch limits the vehicles enriched by a single reconciliation
+const enrichmentBatch = 100
+
+// enrichmentActor is recorded as the actor of the changes made by the reconciliation
+const enrichmentActor = "enrichment"
+
+// fetchOrDefer fetches all necessary data for a new vehicle with fetch, the vehicle is saved pending enrichment
+// while a dependency is unavailable
+func fetchOrDefer(ctx context.Context, fetch func(context.Context, *domain.Vehicle) error, v *domain.Vehicle) error {
+	if err := fetch(ctx, v); err != nil {
+		if !errors.Is(err, domain.ErrUnavailable) {
+			return err
+		}
+		v.DeferEnrichment(err, time.Now())
+	}
+	return nil
+}
+
+// PendingEnrichment lists up to limit vehicles pending enrichment, the earliest retry first
+func (uc *vehicleUsecase) PendingEnrichment(ctx context.Context, limit int) ([]*domain.Vehicle, error) {
+	if limit <= 0 || limit > domain.MaxPageSize {
+		return nil, domain.ErrValidation
+	}
+	return uc.repo.ListPendingEnrichment(ctx, limit)
+}
+
+// Reconcile retries the enrichment of the vehicles due at the given time and reports how many were enriched,
+// the failed ones are deferred by the backoff and vehicles changed meanwhile are left to the next reconciliation
+func (uc *vehicleUsecase) Reconcile(ctx context.Context, now time.Time) (int, error) {
+	pending, err := uc.repo.ListPendingEnrichment(ctx, enrichmentBatch)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, v := range pending {
+		if !v.IsEnrichmentDue(now) {
+			break
+		}
+
+		// Fetch the missing data, any failure defers the enrichment
+		if err := uc.Fetch(ctx, v); err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return n, ctxErr
+			}
+			v.DeferEnrichment(err, now)
+		}
+
+		// Store the outcome unless the vehicle has changed or gone
+		v.Actor = enrichmentActor
+		err := uc.repo.UpdateEnrichment(ctx, v)
+		if errors.Is(err, domain.ErrVersionConflict) || errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return n, err
+		}
+		if v.Enrichment == nil {
+			n++
+		}
+	}
+	return n, nil
+}
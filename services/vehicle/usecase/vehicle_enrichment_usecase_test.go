@@ -0,0 +1,150 @@
+package usecase_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/infrastructure"
+	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+)
+
+// TestVehicleUsecase_DegradedCreate tests saving vehicles pending enrichment and reconciling them later
+func TestVehicleUsecase_DegradedCreate(t *testing.T) {
+
+	// Prepare a usecase with the inspection service down
+	inspection := &infrastructure.MockInspectionProvider{
+		Data: &domain.Vehicle{Brand: "Kia", Engine: "1.8L", Transmission: "Automatic", MSRP: 25_000},
+		Err:  fmt.Errorf("%w: inspection is down", domain.ErrUnavailable),
+	}
+	uc := usecase.NewVehicleUC(infrastructure.NewMemoryVehicleRepo(), inspection, &infrastructure.MockPricingProvider{}, 10)
+	v := newTestVehicle()
+	start := time.Now()
+
+	t.Run("saved pending enrichment", func(t *testing.T) {
+		assert.NoError(t, uc.Create(t.Context(), v))
+		got, err := uc.Get(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Empty(t, got.Brand)
+		assert.Zero(t, got.Price)
+		assert.Equal(t, 1, got.Enrichment.Attempts)
+		assert.Contains(t, got.Enrichment.Error, "inspection is down")
+
+		pending, err := uc.PendingEnrichment(t.Context(), 10)
+		assert.NoError(t, err)
+		assert.Len(t, pending, 1)
+		_, err = uc.PendingEnrichment(t.Context(), 0)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("not due yet", func(t *testing.T) {
+		n, err := uc.Reconcile(t.Context(), start)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("failed retry backs off", func(t *testing.T) {
+		now := start.Add(2 * time.Minute)
+		n, err := uc.Reconcile(t.Context(), now)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+		got, _ := uc.Get(t.Context(), v.VIN)
+		assert.Equal(t, 2, got.Enrichment.Attempts)
+		assert.Equal(t, now.Add(2*time.Minute).UTC(), got.Enrichment.RetryAt)
+		assert.Equal(t, int64(1), got.Version, "bookkeeping does not bump the version")
+	})
+
+	t.Run("enriched once the dependency is back", func(t *testing.T) {
+		inspection.Err = nil
+		n, err := uc.Reconcile(t.Context(), start.Add(10*time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		got, _ := uc.Get(t.Context(), v.VIN)
+		assert.Nil(t, got.Enrichment)
+		assert.Equal(t, "Kia", got.Brand)
+		assert.Equal(t, uint64(25_000), got.MSRP)
+		assert.Equal(t, uint64(99_000), got.Price)
+		assert.Equal(t, int64(2), got.Version)
+
+		entries, err := uc.History(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, "enrichment", entries[1].Actor)
+		assert.JSONEq(t, `"Kia"`, string(entries[1].Changes["brand"].To))
+
+		pending, _ := uc.PendingEnrichment(t.Context(), 10)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("other failures still fail the creation", func(t *testing.T) {
+		inspection.Err = errors.New("invalid vin")
+		u := newTestVehicle()
+		u.VIN = "1HGCM8269LA654321"
+		assert.EqualError(t, uc.Create(t.Context(), u), "invalid vin")
+		_, err := uc.Get(t.Context(), u.VIN)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+// TestVehicleUsecase_ReconcileConflict tests that a vehicle changed during the reconciliation is left for the next one
+func TestVehicleUsecase_ReconcileConflict(t *testing.T) {
+	now := time.Now()
+	pending := newTestVehicle()
+	pending.Version = 3
+	pending.Enrichment = &domain.Enrichment{Attempts: 1, RetryAt: now}
+	repo := new(infrastructure.MockVehiclesRepository)
+	repo.On("ListPendingEnrichment", t.Context(), 100).Return([]*domain.Vehicle{pending}, nil)
+	repo.On("UpdateEnrichment", t.Context(), pending).Return(domain.ErrVersionConflict)
+	inspection := &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{Brand: "Kia"}}
+	uc := usecase.NewVehicleUC(repo, inspection, &infrastructure.MockPricingProvider{}, 10)
+
+	n, err := uc.Reconcile(t.Context(), now)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	repo.AssertExpectations(t)
+}
+
+// TestVehicleUsecase_OfflineDecode tests that vehicles with build data decoded offline stay pending enrichment
+// until the build data is decoded by NHTSA
+func TestVehicleUsecase_OfflineDecode(t *testing.T) {
+	inspection := &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{
+		Brand:     "Kia",
+		BuildData: domain.BuildData{DecodeQuality: &domain.DecodeQuality{Status: domain.DecodeOffline}},
+	}}
+	uc := usecase.NewVehicleUC(infrastructure.NewMemoryVehicleRepo(), inspection, &infrastructure.MockPricingProvider{}, 10)
+	v := newTestVehicle()
+	start := time.Now()
+
+	// Saved with the offline brand, grade and price, pending enrichment of the build data
+	assert.NoError(t, uc.Create(t.Context(), v))
+	got, err := uc.Get(t.Context(), v.VIN)
+	assert.NoError(t, err)
+	assert.Equal(t, "Kia", got.Brand)
+	assert.Equal(t, uint64(99_000), got.Price)
+	assert.Equal(t, domain.ErrDecodedOffline.Error(), got.Enrichment.Error)
+
+	// Still decoded offline, the enrichment backs off
+	n, err := uc.Reconcile(t.Context(), start.Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	got, _ = uc.Get(t.Context(), v.VIN)
+	assert.Equal(t, 2, got.Enrichment.Attempts)
+
+	// Decoded by NHTSA
+	inspection.Data = &domain.Vehicle{
+		Brand:     "Kia",
+		BuildData: domain.BuildData{Model: "Rio", DecodeQuality: &domain.DecodeQuality{Status: domain.DecodeClean}},
+	}
+	n, err = uc.Reconcile(t.Context(), start.Add(10*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	got, _ = uc.Get(t.Context(), v.VIN)
+	assert.Nil(t, got.Enrichment)
+	assert.Equal(t, "Rio", got.Model)
+	assert.Equal(t, domain.DecodeClean, got.DecodeQuality.Status)
+}
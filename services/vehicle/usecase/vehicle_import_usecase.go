@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/repository"
+)
+
+// VehicleImportUsecase defines the interface for importing vehicles from CSV files
+type VehicleImportUsecase interface {
+	Import(ctx context.Context, r io.Reader, operation, actor string, mapping domain.ColumnMapping) (*domain.ImportResult, error)
+}
+
+// vehicleImportUsecase is the implementation of VehicleImportUsecase interface
+type vehicleImportUsecase struct {
+	repo      repository.VehicleRepository
+	bulkUC    VehiclesBulkUsecase
+	chunkSize int
+}
+
+// importRow is a parsed CSV row waiting to be processed
+type importRow struct {
+	line    int
+	record  []string
+	vehicle *domain.Vehicle
+}
+
+// NewVehicleImportUC is the constructor for vehicleImportUsecase
+func NewVehicleImportUC(repo repository.VehicleRepository, bulkUC VehiclesBulkUsecase) *vehicleImportUsecase {
+	return &vehicleImportUsecase{
+		repo:      repo,
+		bulkUC:    bulkUC,
+		chunkSize: 100,
+	}
+}
+
+// Import reads the CSV stream row by row and creates or updates vehicles chunk by chunk,
+// an update changes only the mapped columns of the stored vehicle and
+// rows that cannot be parsed or processed are reported as rejected
+func (uc *vehicleImportUsecase) Import(ctx context.Context, r io.Reader, operation, actor string, mapping domain.ColumnMapping) (*domain.ImportResult, error) {
+
+	// Choose the bulk operation
+	process := uc.bulkUC.CreateBestEffort
+	update := false
+	switch operation {
+	case "", domain.BulkJobCreate:
+	case domain.BulkJobUpdate:
+		process = uc.bulkUC.UpdateBestEffort
+		update = true
+	default:
+		return nil, fmt.Errorf("%w: unknown operation %q", domain.ErrValidation, operation)
+	}
+
+	// Read the header and resolve the columns
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot read csv header: %v", domain.ErrValidation, err)
+	}
+	columns, err := mapping.Columns(header)
+	if err != nil {
+		return nil, err
+	}
+	res := &domain.ImportResult{Header: header, RejectedRows: []*domain.ImportRejectedRow{}}
+
+	// Process the collected rows through the bulk usecase
+	chunk := make([]*importRow, 0, uc.chunkSize)
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		vb := &domain.VehiclesBulk{Mode: domain.BulkModeBestEffort, Vehicles: make([]*domain.Vehicle, len(chunk))}
+		for i, row := range chunk {
+			vb.Vehicles[i] = row.vehicle
+		}
+		br, err := process(ctx, vb)
+		if err != nil {
+			return err
+		}
+		for i, item := range br.Results {
+			if item.Status == domain.BulkStatusFailed {
+				res.Reject(chunk[i].line, item.VIN, item.Error, chunk[i].record)
+			} else {
+				res.Imported++
+			}
+		}
+		chunk = chunk[:0]
+		return nil
+	}
+
+	// Stream the rows
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var perr *csv.ParseError
+		if errors.As(err, &perr) {
+			res.Total++
+			res.Reject(perr.StartLine, "", perr.Err.Error(), record)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Total++
+		line, _ := cr.FieldPos(0)
+		v, err := domain.ParseVehicleRow(record, columns)
+		if err != nil {
+			res.Reject(line, "", err.Error(), record)
+			continue
+		}
+		if update {
+			merged, err := uc.merge(ctx, v.VIN, record, columns)
+			if err != nil {
+				res.Reject(line, domain.NormalizeVIN(v.VIN), err.Error(), record)
+				continue
+			}
+			v = merged
+		}
+		v.Actor = actor
+		chunk = append(chunk, &importRow{line: line, record: record, vehicle: v})
+		if len(chunk) == uc.chunkSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(res.RejectedRows, func(a, b *domain.ImportRejectedRow) int {
+		return a.Line - b.Line
+	})
+	return res, nil
+}
+
+// merge applies the CSV record to a copy of the stored vehicle, the update then expects the stored version
+func (uc *vehicleImportUsecase) merge(ctx context.Context, vin string, record []string, columns map[string]int) (*domain.Vehicle, error) {
+	stored, err := uc.repo.FindByVIN(ctx, domain.NormalizeVIN(vin))
+	if err != nil {
+		return nil, err
+	}
+	v := *stored
+	if err := v.ApplyRow(record, columns); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
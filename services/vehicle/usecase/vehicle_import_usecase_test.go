@@ -0,0 +1,100 @@
+package usecase_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/pkg/vin"
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/infrastructure"
+	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+)
+
+// newTestImportUC is a helper function to create a VehicleImportUsecase instance for testing
+func newTestImportUC() (usecase.VehicleImportUsecase, *infrastructure.MemoryVehicleRepo) {
+	repo := infrastructure.NewMemoryVehicleRepo()
+	vehicleUC := usecase.NewVehicleUC(repo, &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{}}, &infrastructure.MockPricingProvider{}, 10)
+	return usecase.NewVehicleImportUC(repo, usecase.NewVehiclesBulkUC(repo, vehicleUC)), repo
+}
+
+// TestVehicleImportUsecase_Import tests the Import method of VehicleImportUsecase
+func TestVehicleImportUsecase_Import(t *testing.T) {
+
+	// Large file with rejected rows is imported in chunks
+	t.Run("mapped columns", func(t *testing.T) {
+		uc, repo := newTestImportUC()
+		var sb strings.Builder
+		sb.WriteString("Stock VIN,Model Year,Mileage\n")
+		for i := range 250 {
+			fmt.Fprintf(&sb, "%s,2020,%d\n", vin.WithCheckDigit(fmt.Sprintf("1HGCM826XLA%06d", i)), i*100)
+		}
+		sb.WriteString("123,2020,100\n")
+		sb.WriteString("1HGCM8268LA999999,twenty,100\n")
+
+		mapping := domain.ColumnMapping{"vin": "Stock VIN", "year": "Model Year", "odometer": "Mileage"}
+		res, err := uc.Import(t.Context(), strings.NewReader(sb.String()), domain.BulkJobCreate, "", mapping)
+		assert.NoError(t, err)
+		assert.Equal(t, 252, res.Total)
+		assert.Equal(t, 250, res.Imported)
+		assert.Equal(t, 2, res.Rejected)
+		assert.Equal(t, 252, res.RejectedRows[0].Line)
+		assert.Equal(t, "123", res.RejectedRows[0].VIN)
+		assert.Equal(t, 253, res.RejectedRows[1].Line)
+		assert.Equal(t, []string{"1HGCM8268LA999999", "twenty", "100"}, res.RejectedRows[1].Record)
+
+		v, err := repo.FindByVIN(t.Context(), "1HGCM8261LA000249")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(24900), v.Odometer)
+	})
+
+	// Update changes only the mapped columns of the stored vehicle
+	t.Run("update mapped columns", func(t *testing.T) {
+		uc, repo := newTestImportUC()
+		stored := &domain.Vehicle{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 1000, Brand: "Honda", ExteriorColor: "Red", InteriorColor: "Black", StrongScratches: true}
+		assert.NoError(t, repo.Save(t.Context(), stored))
+
+		res, err := uc.Import(t.Context(), strings.NewReader("vin,odometer,exteriorColor\n1hgcm8267la123456,5000,\n"), domain.BulkJobUpdate, "alice", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Imported)
+		v, err := repo.FindByVIN(t.Context(), "1HGCM8267LA123456")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(5000), v.Odometer)
+		assert.Equal(t, int32(2020), v.Year)
+		assert.Equal(t, "Honda", v.Brand)
+		assert.Equal(t, "Red", v.ExteriorColor)
+		assert.Equal(t, "Black", v.InteriorColor)
+		assert.True(t, v.StrongScratches)
+		assert.Equal(t, stored.Version+1, v.Version)
+	})
+
+	// Update of vehicles that do not exist rejects the rows
+	t.Run("update unknown vehicles", func(t *testing.T) {
+		uc, _ := newTestImportUC()
+		res, err := uc.Import(t.Context(), strings.NewReader("vin,year\n1HGCM8267LA123456,2020\n"), domain.BulkJobUpdate, "", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Rejected)
+		assert.Equal(t, "1HGCM8267LA123456", res.RejectedRows[0].VIN)
+	})
+
+	// Invalid imports
+	t.Run("missing vin column", func(t *testing.T) {
+		uc, _ := newTestImportUC()
+		_, err := uc.Import(t.Context(), strings.NewReader("year\n2020\n"), domain.BulkJobCreate, "", nil)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		uc, _ := newTestImportUC()
+		_, err := uc.Import(t.Context(), strings.NewReader(""), domain.BulkJobCreate, "", nil)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("unknown operation", func(t *testing.T) {
+		uc, _ := newTestImportUC()
+		_, err := uc.Import(t.Context(), strings.NewReader("vin\n"), "delete", "", nil)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+}
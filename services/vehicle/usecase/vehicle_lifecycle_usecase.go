@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// Transition moves the vehicle to the lifecycle status, the transition is validated against the current state
+// and retried when the vehicle changes concurrently; the offers of an ended buy-now listing are rejected with it
+func (uc *vehicleUsecase) Transition(ctx context.Context, vin, to, actor string) (*domain.Vehicle, error) {
+	for attempt := 1; ; attempt++ {
+
+		// Apply the transition to the current state
+		v, err := uc.Get(ctx, vin)
+		if err != nil {
+			return nil, err
+		}
+		available := v.Status == domain.SaleAvailable
+		if err := v.Transition(to); err != nil {
+			return nil, err
+		}
+
+		// Store the new status, retrying on a concurrent change
+		v.Actor = actor
+		if available {
+			var offers []*domain.Offer
+			if offers, err = uc.repo.ListOffers(ctx, v.VIN); err != nil {
+				return nil, err
+			}
+			err = uc.repo.UpdateSale(ctx, v, domain.CloseOffers(offers, time.Now().UTC())...)
+		} else {
+			err = uc.repo.UpdateLifecycle(ctx, v)
+		}
+		if errors.Is(err, domain.ErrVersionConflict) && attempt < saleRetries {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
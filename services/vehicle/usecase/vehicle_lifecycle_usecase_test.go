@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// TestVehicleUsecase_Transition tests moving a vehicle through the remarketing pipeline
+func TestVehicleUsecase_Transition(t *testing.T) {
+	uc := newTestUC()
+	v := newTestVehicle()
+	v.Lifecycle = domain.LifecycleSold
+	assert.NoError(t, uc.Create(t.Context(), v))
+	assert.Equal(t, domain.LifecycleDraft, v.Lifecycle, "new vehicles are drafts")
+
+	t.Run("through the pipeline", func(t *testing.T) {
+		for _, to := range []string{domain.LifecycleInspected, domain.LifecyclePriced, domain.LifecycleListed} {
+			got, err := uc.Transition(t.Context(), v.VIN, to, "dealer")
+			assert.NoError(t, err)
+			assert.Equal(t, to, got.Lifecycle)
+		}
+	})
+
+	t.Run("invalid transition", func(t *testing.T) {
+		_, err := uc.Transition(t.Context(), v.VIN, domain.LifecycleDelivered, "dealer")
+		assert.ErrorIs(t, err, domain.ErrInvalidTransition)
+	})
+
+	t.Run("updates keep the status", func(t *testing.T) {
+		u := newTestVehicle()
+		u.Odometer = 20_000
+		assert.NoError(t, uc.Update(t.Context(), u))
+		assert.Equal(t, domain.LifecycleListed, u.Lifecycle)
+
+		_, err := uc.Patch(t.Context(), v.VIN, 0, "", []byte(`{"lifecycle":"sold"}`))
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("filter and history", func(t *testing.T) {
+		page, err := uc.List(t.Context(), &domain.VehicleQuery{Lifecycle: domain.LifecycleListed, SortBy: domain.SortByVIN, Order: domain.OrderAsc, Limit: 10})
+		assert.NoError(t, err)
+		assert.Len(t, page.Vehicles, 1)
+		page, err = uc.List(t.Context(), &domain.VehicleQuery{Lifecycle: domain.LifecycleDraft, SortBy: domain.SortByVIN, Order: domain.OrderAsc, Limit: 10})
+		assert.NoError(t, err)
+		assert.Empty(t, page.Vehicles)
+		_, err = uc.List(t.Context(), &domain.VehicleQuery{Lifecycle: "auctioned", SortBy: domain.SortByVIN, Order: domain.OrderAsc, Limit: 10})
+		assert.ErrorIs(t, err, domain.ErrValidation)
+
+		entries, err := uc.History(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Equal(t, "dealer", entries[3].Actor)
+		assert.JSONEq(t, `"listed"`, string(entries[3].Changes["lifecycle"].To))
+	})
+
+	t.Run("withdraw ends the buy-now listing", func(t *testing.T) {
+		_, err := uc.ListForSale(t.Context(), v.VIN, 30_000, "dealer")
+		assert.NoError(t, err)
+		_, err = uc.MakeOffer(t.Context(), v.VIN, "alice", 20_000)
+		assert.NoError(t, err)
+
+		got, err := uc.Transition(t.Context(), v.VIN, domain.LifecycleWithdrawn, "dealer")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Sale{}, got.Sale)
+		offers, err := uc.Offers(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OfferRejected, offers[0].Status)
+		_, err = uc.Purchase(t.Context(), v.VIN, "bob")
+		assert.ErrorIs(t, err, domain.ErrNotForSale)
+	})
+
+	t.Run("unknown vehicle", func(t *testing.T) {
+		_, err := uc.Transition(t.Context(), "NONEXISTENTVIN12345", domain.LifecycleInspected, "dealer")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+)
+
+// saleRetries limits the attempts of a sale or lifecycle transition losing the race against concurrent changes
+const saleRetries = 5
+
+// sale applies the transition to the current state of the vehicle and of its offer with the given ID,
+// if any, and stores the changes; the transition reports whether it has changed the vehicle
+func (uc *vehicleUsecase) sale(ctx context.Context, vin string, offerID int64, fn func(v *domain.Vehicle, o *domain.Offer) (bool, error)) (*domain.Vehicle, *domain.Offer, error) {
+	for attempt := 1; ; attempt++ {
+
+		// Read the current state
+		v, err := uc.Get(ctx, vin)
+		if err != nil {
+			return nil, nil, err
+		}
+		var o *domain.Offer
+		var offers []*domain.Offer
+		if offerID != 0 {
+			if o, err = uc.repo.FindOffer(ctx, v.VIN, offerID); err != nil {
+				return nil, nil, err
+			}
+			offers = append(offers, o)
+		}
+
+		// Apply the transition
+		changed, err := fn(v, o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Store the changes, retrying on a concurrent change
+		var updated *domain.Vehicle
+		if changed {
+			updated = v
+		}
+		err = uc.repo.UpdateSale(ctx, updated, offers...)
+		if errors.Is(err, domain.ErrVersionConflict) && attempt < saleRetries {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return v, o, nil
+	}
+}
+
+// ListForSale makes the vehicle available at the buy-now price,
+// a zero price defaults to the recommended price raised by the markup
+func (uc *vehicleUsecase) ListForSale(ctx context.Context, vin string, price uint64, actor string) (*domain.Vehicle, error) {
+	v, _, err := uc.sale(ctx, vin, 0, func(v *domain.Vehicle, _ *domain.Offer) (bool, error) {
+		buyNow := price
+		if buyNow == 0 {
+			buyNow = domain.BuyNowPrice(v.Price, uc.buyNowMarkup)
+		}
+		v.Actor = actor
+		return true, v.ListForSale(buyNow)
+	})
+	return v, err
+}
+
+// Purchase reserves an available vehicle for the buyer at the buy-now price
+func (uc *vehicleUsecase) Purchase(ctx context.Context, vin, buyer string) (*domain.Vehicle, error) {
+	v, _, err := uc.sale(ctx, vin, 0, func(v *domain.Vehicle, _ *domain.Offer) (bool, error) {
+		v.Actor = buyer
+		return true, v.Purchase(buyer)
+	})
+	return v, err
+}
+
+// CompleteSale marks the pending deal of the vehicle as sold
+func (uc *vehicleUsecase) CompleteSale(ctx context.Context, vin, actor string) (*domain.Vehicle, error) {
+	v, _, err := uc.sale(ctx, vin, 0, func(v *domain.Vehicle, _ *domain.Offer) (bool, error) {
+		v.Actor = actor
+		return true, v.CompleteSale()
+	})
+	return v, err
+}
+
+// CancelSale drops the pending deal of the vehicle and makes it available again
+func (uc *vehicleUsecase) CancelSale(ctx context.Context, vin, actor string) (*domain.Vehicle, error) {
+	v, _, err := uc.sale(ctx, vin, 0, func(v *domain.Vehicle, _ *domain.Offer) (bool, error) {
+		v.Actor = actor
+		return true, v.CancelSale()
+	})
+	return v, err
+}
+
+// MakeOffer submits an offer of the buyer for an available vehicle
+func (uc *vehicleUsecase) MakeOffer(ctx context.Context, vin, buyer string, amount uint64) (*domain.Offer, error) {
+	v, err := uc.Get(ctx, vin)
+	if err != nil {
+		return nil, err
+	}
+	o, err := v.MakeOffer(buyer, amount, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.SaveOffer(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Offers lists the offers of the vehicle, oldest first
+func (uc *vehicleUsecase) Offers(ctx context.Context, vin string) ([]*domain.Offer, error) {
+	v, err := uc.Get(ctx, vin)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.ListOffers(ctx, v.VIN)
+}
+
+// AcceptOffer accepts an open offer and reserves the vehicle for its buyer
+func (uc *vehicleUsecase) AcceptOffer(ctx context.Context, vin string, id int64, actor string) (*domain.Vehicle, *domain.Offer, error) {
+	return uc.sale(ctx, vin, id, func(v *domain.Vehicle, o *domain.Offer) (bool, error) {
+		v.Actor = actor
+		return true, v.AcceptOffer(o, time.Now().UTC())
+	})
+}
+
+// RejectOffer rejects an open or countered offer
+func (uc *vehicleUsecase) RejectOffer(ctx context.Context, vin string, id int64) (*domain.Offer, error) {
+	_, o, err := uc.sale(ctx, vin, id, func(_ *domain.Vehicle, o *domain.Offer) (bool, error) {
+		return false, o.Reject(time.Now().UTC())
+	})
+	return o, err
+}
+
+// CounterOffer answers an open offer with a higher amount
+func (uc *vehicleUsecase) CounterOffer(ctx context.Context, vin string, id int64, amount uint64) (*domain.Offer, error) {
+	_, o, err := uc.sale(ctx, vin, id, func(v *domain.Vehicle, o *domain.Offer) (bool, error) {
+		return false, v.CounterOffer(o, amount, time.Now().UTC())
+	})
+	return o, err
+}
+
+// AcceptCounterOffer lets the buyer accept the counter amount and reserves the vehicle for them
+func (uc *vehicleUsecase) AcceptCounterOffer(ctx context.Context, vin string, id int64, buyer string) (*domain.Vehicle, *domain.Offer, error) {
+	return uc.sale(ctx, vin, id, func(v *domain.Vehicle, o *domain.Offer) (bool, error) {
+		v.Actor = buyer
+		return true, v.AcceptCounterOffer(o, buyer, time.Now().UTC())
+	})
+}
+
+// ClaimSale reserves the listed vehicle for the sale of another channel, e.g. an auction
+func (uc *vehicleUsecase) ClaimSale(ctx context.Context, vin, channel, saleID string) (*domain.Vehicle, error) {
+	v, _, err := uc.sale(ctx, vin, 0, func(v *domain.Vehicle, _ *domain.Offer) (bool, error) {
+		v.Actor = channel
+		return v.ClaimSale(channel, saleID)
+	})
+	return v, err
+}
+
+// SettleSale records the outcome of the sale that claimed the vehicle, an empty buyer settles it unsold
+func (uc *vehicleUsecase) SettleSale(ctx context.Context, vin, channel, saleID, buyer string, price uint64) (*domain.Vehicle, error) {
+	v, _, err := uc.sale(ctx, vin, 0, func(v *domain.Vehicle, _ *domain.Offer) (bool, error) {
+		v.Actor = channel
+		return v.SettleSale(channel, saleID, buyer, price)
+	})
+	return v, err
+}
+
+// ReleaseSale drops the claim of a sale that was never opened
+func (uc *vehicleUsecase) ReleaseSale(ctx context.Context, vin, saleID string) (*domain.Vehicle, error) {
+	v, _, err := uc.sale(ctx, vin, 0, func(v *domain.Vehicle, _ *domain.Offer) (bool, error) {
+		v.Actor = v.Channel
+		return v.ReleaseSale(saleID), nil
+	})
+	return v, err
+}
@@ -0,0 +1,253 @@
+package usecase_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alechekz/online-car-auction/services/vehicle/domain"
+	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
+)
+
+// listTestVehicle moves the created vehicle through the lifecycle up to listed
+func listTestVehicle(t *testing.T, uc usecase.VehicleUsecase, vin string) {
+	for _, to := range []string{domain.LifecycleInspected, domain.LifecyclePriced, domain.LifecycleListed} {
+		_, err := uc.Transition(t.Context(), vin, to, "seller")
+		assert.NoError(t, err)
+	}
+}
+
+// TestVehicleUsecase_BuyNow tests listing a vehicle for sale and purchasing it
+func TestVehicleUsecase_BuyNow(t *testing.T) {
+	uc := newTestUC()
+	v := newTestVehicle()
+	v.Status = domain.SaleSold
+	assert.NoError(t, uc.Create(t.Context(), v))
+	assert.Empty(t, v.Status, "new vehicles are not listed")
+	_, err := uc.ListForSale(t.Context(), v.VIN, 0, "seller")
+	assert.ErrorIs(t, err, domain.ErrNotForSale, "drafts are not for sale")
+	listTestVehicle(t, uc, v.VIN)
+	v, err = uc.Get(t.Context(), v.VIN)
+	assert.NoError(t, err)
+
+	t.Run("list at the default price", func(t *testing.T) {
+		listed, err := uc.ListForSale(t.Context(), v.VIN, 0, "seller")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SaleAvailable, listed.Status)
+		assert.Equal(t, uint64(108_900), listed.BuyNowPrice, "recommended price plus 10%")
+		assert.Equal(t, v.Version+1, listed.Version)
+	})
+
+	t.Run("updates keep the sale state", func(t *testing.T) {
+		u := newTestVehicle()
+		u.Odometer = 20_000
+		assert.NoError(t, uc.Update(t.Context(), u))
+		assert.Equal(t, domain.SaleAvailable, u.Status)
+
+		res, err := uc.Patch(t.Context(), v.VIN, 0, "", []byte(`{"odometer":21000}`))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(108_900), res.Vehicle.BuyNowPrice)
+
+		page, err := uc.List(t.Context(), &domain.VehicleQuery{Status: domain.SaleAvailable, SortBy: domain.SortByVIN, Order: domain.OrderAsc, Limit: 10})
+		assert.NoError(t, err)
+		assert.Len(t, page.Vehicles, 1)
+	})
+
+	t.Run("only one concurrent purchase wins", func(t *testing.T) {
+		var wg sync.WaitGroup
+		errs := make([]error, 8)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = uc.Purchase(t.Context(), v.VIN, fmt.Sprintf("buyer-%d", i))
+			}()
+		}
+		wg.Wait()
+		won := 0
+		for _, err := range errs {
+			if err == nil {
+				won++
+				continue
+			}
+			assert.ErrorIs(t, err, domain.ErrSalePending)
+		}
+		assert.Equal(t, 1, won)
+
+		got, err := uc.Get(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SalePending, got.Status)
+		assert.Equal(t, uint64(108_900), got.SalePrice)
+	})
+
+	t.Run("pending vehicles cannot be deleted, updated, relisted or withdrawn", func(t *testing.T) {
+		assert.ErrorIs(t, uc.Delete(t.Context(), v.VIN, ""), domain.ErrSalePending)
+		u := newTestVehicle()
+		u.Odometer = 90_000
+		assert.ErrorIs(t, uc.Update(t.Context(), u), domain.ErrSalePending)
+		_, err := uc.Patch(t.Context(), v.VIN, 0, "", []byte(`{"odometer":90000}`))
+		assert.ErrorIs(t, err, domain.ErrSalePending)
+		got, err := uc.Get(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(21_000), got.Odometer)
+		assert.Equal(t, uint64(108_900), got.SalePrice)
+
+		_, err = uc.ListForSale(t.Context(), v.VIN, 100_000, "seller")
+		assert.ErrorIs(t, err, domain.ErrSalePending)
+		_, err = uc.Transition(t.Context(), v.VIN, domain.LifecycleWithdrawn, "seller")
+		assert.ErrorIs(t, err, domain.ErrSalePending)
+	})
+
+	t.Run("complete sale", func(t *testing.T) {
+		sold, err := uc.CompleteSale(t.Context(), v.VIN, "seller")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SaleSold, sold.Status)
+		assert.Equal(t, domain.LifecycleSold, sold.Lifecycle)
+		_, err = uc.CancelSale(t.Context(), v.VIN, "seller")
+		assert.ErrorIs(t, err, domain.ErrNoPendingSale)
+
+		entries, err := uc.History(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		last := entries[len(entries)-1]
+		assert.Equal(t, "seller", last.Actor)
+		assert.JSONEq(t, `"sold"`, string(last.Changes["status"].To))
+		assert.JSONEq(t, `"sold"`, string(last.Changes["lifecycle"].To))
+	})
+
+	t.Run("unknown vehicle", func(t *testing.T) {
+		_, err := uc.Purchase(t.Context(), "NONEXISTENTVIN12345", "alice")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+// TestVehicleUsecase_Offers tests the make-offer flow
+func TestVehicleUsecase_Offers(t *testing.T) {
+	uc := newTestUC()
+	v := newTestVehicle()
+	assert.NoError(t, uc.Create(t.Context(), v))
+
+	// Offers need a vehicle available for sale
+	_, err := uc.MakeOffer(t.Context(), v.VIN, "alice", 90_000)
+	assert.ErrorIs(t, err, domain.ErrNotForSale)
+	listTestVehicle(t, uc, v.VIN)
+	_, err = uc.ListForSale(t.Context(), v.VIN, 100_000, "seller")
+	assert.NoError(t, err)
+
+	alice, err := uc.MakeOffer(t.Context(), v.VIN, "alice", 90_000)
+	assert.NoError(t, err)
+	bob, err := uc.MakeOffer(t.Context(), v.VIN, "bob", 85_000)
+	assert.NoError(t, err)
+	carol, err := uc.MakeOffer(t.Context(), v.VIN, "carol", 80_000)
+	assert.NoError(t, err)
+
+	t.Run("reject", func(t *testing.T) {
+		o, err := uc.RejectOffer(t.Context(), v.VIN, carol.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OfferRejected, o.Status)
+		_, err = uc.RejectOffer(t.Context(), v.VIN, carol.ID)
+		assert.ErrorIs(t, err, domain.ErrOfferClosed)
+		_, err = uc.RejectOffer(t.Context(), v.VIN, 999)
+		assert.ErrorIs(t, err, domain.ErrOfferNotFound)
+	})
+
+	t.Run("counter", func(t *testing.T) {
+		o, err := uc.CounterOffer(t.Context(), v.VIN, bob.ID, 95_000)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OfferCountered, o.Status)
+		assert.Equal(t, uint64(95_000), o.Counter)
+
+		_, _, err = uc.AcceptCounterOffer(t.Context(), v.VIN, bob.ID, "alice")
+		assert.ErrorIs(t, err, domain.ErrNotBuyer)
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		got, o, err := uc.AcceptOffer(t.Context(), v.VIN, alice.ID, "seller")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OfferAccepted, o.Status)
+		assert.Equal(t, domain.SalePending, got.Status)
+		assert.Equal(t, "alice", got.Buyer)
+		assert.Equal(t, uint64(90_000), got.SalePrice)
+
+		// The vehicle is reserved, so the counter can no longer be accepted
+		_, _, err = uc.AcceptCounterOffer(t.Context(), v.VIN, bob.ID, "bob")
+		assert.ErrorIs(t, err, domain.ErrSalePending)
+	})
+
+	t.Run("accept counter after a cancelled sale", func(t *testing.T) {
+		_, err := uc.CancelSale(t.Context(), v.VIN, "seller")
+		assert.NoError(t, err)
+		got, o, err := uc.AcceptCounterOffer(t.Context(), v.VIN, bob.ID, "bob")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OfferAccepted, o.Status)
+		assert.Equal(t, "bob", got.Buyer)
+		assert.Equal(t, uint64(95_000), got.SalePrice)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		offers, err := uc.Offers(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		statuses := make([]string, len(offers))
+		for i, o := range offers {
+			statuses[i] = o.Status
+		}
+		assert.Equal(t, []string{domain.OfferAccepted, domain.OfferAccepted, domain.OfferRejected}, statuses)
+
+		_, err = uc.Offers(t.Context(), "NONEXISTENTVIN12345")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+// TestVehicleUsecase_ClaimSale tests that a vehicle claimed by a sale of another channel is not sold buy-now
+func TestVehicleUsecase_ClaimSale(t *testing.T) {
+	uc := newTestUC()
+	v := newTestVehicle()
+	assert.NoError(t, uc.Create(t.Context(), v))
+	_, err := uc.ClaimSale(t.Context(), v.VIN, "auction", "a1")
+	assert.ErrorIs(t, err, domain.ErrNotForSale, "drafts are not for sale")
+	listTestVehicle(t, uc, v.VIN)
+
+	t.Run("auction open", func(t *testing.T) {
+		got, err := uc.ClaimSale(t.Context(), v.VIN, "auction", "a1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SaleClaimed, got.Status)
+
+		_, err = uc.ListForSale(t.Context(), v.VIN, 0, "seller")
+		assert.ErrorIs(t, err, domain.ErrSaleClaimed)
+		_, err = uc.MakeOffer(t.Context(), v.VIN, "alice", 50_000)
+		assert.ErrorIs(t, err, domain.ErrSaleClaimed)
+		assert.ErrorIs(t, uc.Delete(t.Context(), v.VIN, "seller"), domain.ErrSaleClaimed)
+		_, err = uc.Transition(t.Context(), v.VIN, domain.LifecycleWithdrawn, "seller")
+		assert.ErrorIs(t, err, domain.ErrSaleClaimed)
+	})
+
+	t.Run("auction never saved", func(t *testing.T) {
+		got, err := uc.ReleaseSale(t.Context(), v.VIN, "a1")
+		assert.NoError(t, err)
+		assert.Empty(t, got.Status)
+		_, err = uc.ClaimSale(t.Context(), v.VIN, "auction", "a2")
+		assert.NoError(t, err)
+	})
+
+	t.Run("auction closed unsold", func(t *testing.T) {
+		got, err := uc.SettleSale(t.Context(), v.VIN, "auction", "a2", "", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LifecycleUnsold, got.Lifecycle)
+		again, err := uc.SettleSale(t.Context(), v.VIN, "auction", "a2", "", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, got.Version, again.Version, "settling again changes nothing")
+	})
+
+	t.Run("auction closed sold", func(t *testing.T) {
+		_, err := uc.Transition(t.Context(), v.VIN, domain.LifecycleListed, "seller")
+		assert.NoError(t, err)
+		_, err = uc.ClaimSale(t.Context(), v.VIN, "tender", "t1")
+		assert.NoError(t, err)
+		got, err := uc.SettleSale(t.Context(), v.VIN, "tender", "t1", "alice", 80_000)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SaleSold, got.Status)
+		assert.Equal(t, domain.LifecycleSold, got.Lifecycle)
+		assert.Equal(t, uint64(80_000), got.SalePrice)
+	})
+}
@@ -1,18 +1,45 @@
 package usecase
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
 	"github.com/alechekz/online-car-auction/services/vehicle/repository"
 )
 
 // VehicleUsecase defines the interface for vehicle-related business logic
 type VehicleUsecase interface {
-	Create(v *domain.Vehicle) error
-	Get(vin string) (*domain.Vehicle, error)
-	Update(v *domain.Vehicle) error
-	Delete(vin string) error
-	List() ([]*domain.Vehicle, error)
-	Fetch(v *domain.Vehicle) error
+	Create(ctx context.Context, v *domain.Vehicle) error
+	Get(ctx context.Context, vin string) (*domain.Vehicle, error)
+	Update(ctx context.Context, v *domain.Vehicle) error
+	Patch(ctx context.Context, vin string, version int64, actor string, patch []byte) (*domain.PatchResult, error)
+	Delete(ctx context.Context, vin, actor string) error
+	Restore(ctx context.Context, vin, actor string) (*domain.Vehicle, error)
+	Purge(ctx context.Context, retention time.Duration) (int, error)
+	List(ctx context.Context, q *domain.VehicleQuery) (*domain.VehiclesPage, error)
+	Export(ctx context.Context, e *domain.VehicleExport, fn func(*domain.Vehicle) error) error
+	Fetch(ctx context.Context, v *domain.Vehicle) error
+	History(ctx context.Context, vin string) ([]*domain.HistoryEntry, error)
+	Transition(ctx context.Context, vin, to, actor string) (*domain.Vehicle, error)
+	PendingEnrichment(ctx context.Context, limit int) ([]*domain.Vehicle, error)
+	Reconcile(ctx context.Context, now time.Time) (int, error)
+
+	ListForSale(ctx context.Context, vin string, price uint64, actor string) (*domain.Vehicle, error)
+	Purchase(ctx context.Context, vin, buyer string) (*domain.Vehicle, error)
+	CompleteSale(ctx context.Context, vin, actor string) (*domain.Vehicle, error)
+	CancelSale(ctx context.Context, vin, actor string) (*domain.Vehicle, error)
+	MakeOffer(ctx context.Context, vin, buyer string, amount uint64) (*domain.Offer, error)
+	Offers(ctx context.Context, vin string) ([]*domain.Offer, error)
+	AcceptOffer(ctx context.Context, vin string, id int64, actor string) (*domain.Vehicle, *domain.Offer, error)
+	RejectOffer(ctx context.Context, vin string, id int64) (*domain.Offer, error)
+	CounterOffer(ctx context.Context, vin string, id int64, amount uint64) (*domain.Offer, error)
+	AcceptCounterOffer(ctx context.Context, vin string, id int64, buyer string) (*domain.Vehicle, *domain.Offer, error)
+
+	ClaimSale(ctx context.Context, vin, channel, saleID string) (*domain.Vehicle, error)
+	SettleSale(ctx context.Context, vin, channel, saleID, buyer string, price uint64) (*domain.Vehicle, error)
+	ReleaseSale(ctx context.Context, vin, saleID string) (*domain.Vehicle, error)
 }
 
 // vehicleUsecase is the implementation of VehicleUsecase interface
@@ -20,22 +47,25 @@ type vehicleUsecase struct {
 	repo               repository.VehicleRepository
 	inspectionProvider InspectionProvider
 	pricingProvider    PricingProvider
+	buyNowMarkup       uint64
 }
 
-// NewVehicleUC is the constructor for vehicleUsecase
-func NewVehicleUC(r repository.VehicleRepository, inspectionProvider InspectionProvider, pricingProvider PricingProvider) *vehicleUsecase {
+// NewVehicleUC is the constructor for vehicleUsecase, the buy-now markup is a percent of the recommended price
+func NewVehicleUC(r repository.VehicleRepository, inspectionProvider InspectionProvider, pricingProvider PricingProvider, buyNowMarkup uint64) *vehicleUsecase {
 	return &vehicleUsecase{
 		repo:               r,
 		inspectionProvider: inspectionProvider,
 		pricingProvider:    pricingProvider,
+		buyNowMarkup:       buyNowMarkup,
 	}
 }
 
-// Fetch fetches all necessary data for vehicle processing
-func (uc *vehicleUsecase) Fetch(v *domain.Vehicle) error {
+// Fetch fetches all necessary data for vehicle processing, a fully fetched vehicle has no pending enrichment
+// unless its build data was decoded offline, then the build data is fetched again later
+func (uc *vehicleUsecase) Fetch(ctx context.Context, v *domain.Vehicle) error {
 
 	// Fetch build data and merge with user's vehicle data
-	bd, err := uc.inspectionProvider.GetBuildData(v.VIN)
+	bd, err := uc.inspectionProvider.GetBuildData(ctx, v.VIN)
 	if err != nil {
 		return err
 	}
@@ -48,42 +78,49 @@ func (uc *vehicleUsecase) Fetch(v *domain.Vehicle) error {
 	if v.Transmission == "" {
 		v.Transmission = bd.Transmission
 	}
+	v.BuildData.Fill(bd.BuildData)
 	v.MSRP = bd.MSRP
 
 	// Perform vehicle inspection to get the grade
-	if err := uc.inspectionProvider.InspectVehicle(v); err != nil {
+	if err := uc.inspectionProvider.InspectVehicle(ctx, v); err != nil {
 		return err
 	}
 
 	// Calculate the price based on MSRP and grade
-	price, err := uc.pricingProvider.GetRecommendedPrice(v)
+	price, err := uc.pricingProvider.GetRecommendedPrice(ctx, v)
 	if err != nil {
 		return err
 	}
 	v.Price = price
+	if v.DecodedOffline() {
+		v.DeferEnrichment(domain.ErrDecodedOffline, time.Now())
+		return nil
+	}
+	v.Enrichment = nil
 	return nil
 }
 
 // Create creates a new vehicle record
-func (uc *vehicleUsecase) Create(v *domain.Vehicle) error {
+func (uc *vehicleUsecase) Create(ctx context.Context, v *domain.Vehicle) error {
 
 	// Validate the vehicle data
 	if err := v.Validate(); err != nil {
 		return domain.ErrValidation
 	}
 
-	// Fetch all necessary data for vehicle processing
-	if err := uc.Fetch(v); err != nil {
+	// Fetch all necessary data for vehicle processing, the vehicle is saved pending enrichment
+	// while a dependency is unavailable
+	if err := fetchOrDefer(ctx, uc.Fetch, v); err != nil {
 		return err
 	}
 
 	// Save the vehicle record
-	return uc.repo.Save(v)
+	return uc.repo.Save(ctx, v)
 }
 
 // Get retrieves a vehicle by its VIN
-func (uc *vehicleUsecase) Get(vin string) (*domain.Vehicle, error) {
-	v, err := uc.repo.FindByVIN(vin)
+func (uc *vehicleUsecase) Get(ctx context.Context, vin string) (*domain.Vehicle, error) {
+	v, err := uc.repo.FindByVIN(ctx, domain.NormalizeVIN(vin))
 	if err != nil {
 		return nil, domain.ErrNotFound
 	}
@@ -94,7 +131,7 @@ func (uc *vehicleUsecase) Get(vin string) (*domain.Vehicle, error) {
 }
 
 // Update updates an existing vehicle record
-func (uc *vehicleUsecase) Update(v *domain.Vehicle) error {
+func (uc *vehicleUsecase) Update(ctx context.Context, v *domain.Vehicle) error {
 
 	// Validate the vehicle data
 	if err := v.Validate(); err != nil {
@@ -102,26 +139,136 @@ func (uc *vehicleUsecase) Update(v *domain.Vehicle) error {
 	}
 
 	// Fetch all necessary data for vehicle processing
-	if err := uc.Fetch(v); err != nil {
+	if err := uc.Fetch(ctx, v); err != nil {
 		return err
 	}
 
-	// Update the vehicle record
-	if err := uc.repo.Update(v); err != nil {
+	// Update the vehicle record, the price of a vehicle reserved for a buyer cannot change
+	if err := uc.repo.Update(ctx, v); err != nil {
+		if errors.Is(err, domain.ErrVersionConflict) || errors.Is(err, domain.ErrSalePending) {
+			return err
+		}
 		return domain.ErrNotFound
 	}
 	return nil
 }
 
-// Delete deletes a vehicle by its VIN
-func (uc *vehicleUsecase) Delete(vin string) error {
-	if err := uc.repo.Delete(vin); err != nil {
+// Patch applies a JSON merge patch to an existing vehicle record,
+// a non-zero version must match the stored one
+func (uc *vehicleUsecase) Patch(ctx context.Context, vin string, version int64, actor string, patch []byte) (*domain.PatchResult, error) {
+
+	// Apply the patch to a copy of the stored vehicle
+	stored, err := uc.Get(ctx, vin)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 && version != stored.Version {
+		return nil, domain.ErrVersionConflict
+	}
+	if stored.Status == domain.SalePending {
+		return nil, domain.ErrSalePending
+	}
+	v := *stored
+	v.Actor = actor
+	changed, err := v.ApplyMergePatch(patch)
+	if err != nil {
+		return nil, err
+	}
+	if len(changed) == 0 {
+		return &domain.PatchResult{Vehicle: &v, Changed: changed}, nil
+	}
+
+	// Validate the patched vehicle data
+	if err := v.Validate(); err != nil {
+		return nil, domain.ErrValidation
+	}
+
+	// Re-fetch grade and price only when fields affecting them have changed
+	if domain.NeedsFetch(changed) {
+		if err := uc.Fetch(ctx, &v); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update the vehicle record, guarded by the version it was read with
+	changed = stored.Diff(&v)
+	if err := uc.repo.Update(ctx, &v); err != nil {
+		if errors.Is(err, domain.ErrVersionConflict) || errors.Is(err, domain.ErrSalePending) {
+			return nil, err
+		}
+		return nil, domain.ErrNotFound
+	}
+	return &domain.PatchResult{Vehicle: &v, Changed: changed}, nil
+}
+
+// Delete deletes a vehicle by its VIN unless it is reserved for a buyer or claimed by a sale
+func (uc *vehicleUsecase) Delete(ctx context.Context, vin, actor string) error {
+	vin = domain.NormalizeVIN(vin)
+	if v, err := uc.Get(ctx, vin); err == nil {
+		switch v.Status {
+		case domain.SalePending:
+			return domain.ErrSalePending
+		case domain.SaleClaimed:
+			return domain.ErrSaleClaimed
+		}
+	}
+	if err := uc.repo.Delete(ctx, vin, actor); err != nil {
 		return domain.ErrNotFound
 	}
 	return nil
 }
 
-// List lists all vehicles
-func (uc *vehicleUsecase) List() ([]*domain.Vehicle, error) {
-	return uc.repo.List()
+// Restore restores a deleted vehicle by its VIN
+func (uc *vehicleUsecase) Restore(ctx context.Context, vin, actor string) (*domain.Vehicle, error) {
+	return uc.repo.Restore(ctx, domain.NormalizeVIN(vin), actor)
+}
+
+// Purge permanently removes vehicles deleted longer than the retention period ago
+func (uc *vehicleUsecase) Purge(ctx context.Context, retention time.Duration) (int, error) {
+	return uc.repo.Purge(ctx, time.Now().Add(-retention))
+}
+
+// History returns the change history of a vehicle, including deleted ones
+func (uc *vehicleUsecase) History(ctx context.Context, vin string) ([]*domain.HistoryEntry, error) {
+	entries, err := uc.repo.History(ctx, domain.NormalizeVIN(vin))
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return entries, nil
+}
+
+// List lists vehicles matching the query, one page at a time
+func (uc *vehicleUsecase) List(ctx context.Context, q *domain.VehicleQuery) (*domain.VehiclesPage, error) {
+
+	// Validate the query
+	if err := q.Validate(); err != nil {
+		return nil, domain.ErrValidation
+	}
+
+	// Request one extra vehicle to find out whether there is a next page
+	pq := *q
+	pq.Limit = q.Limit + 1
+	vehicles, err := uc.repo.List(ctx, &pq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the page
+	page := &domain.VehiclesPage{Vehicles: vehicles}
+	if len(vehicles) > q.Limit {
+		page.Vehicles = vehicles[:q.Limit]
+		page.NextPageToken = q.NextPageToken(page.Vehicles[q.Limit-1])
+	}
+	return page, nil
+}
+
+// Export streams all vehicles matching the export query to fn
+func (uc *vehicleUsecase) Export(ctx context.Context, e *domain.VehicleExport, fn func(*domain.Vehicle) error) error {
+	if err := e.Validate(); err != nil {
+		return domain.ErrValidation
+	}
+	return uc.repo.Export(ctx, e.Query, fn)
 }
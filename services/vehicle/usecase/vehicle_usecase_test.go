@@ -1,7 +1,11 @@
 package usecase_test
 
 import (
+	"errors"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -20,7 +24,7 @@ type test struct {
 // newTestVehicle is a test valid vehicle instance
 func newTestVehicle() *domain.Vehicle {
 	return &domain.Vehicle{
-		VIN:      "1HGCM82633A123456",
+		VIN:      "1HGCM8267LA123456",
 		Year:     2020,
 		Odometer: 15000,
 		MSRP:     25000.00,
@@ -32,14 +36,20 @@ func newTestUC() usecase.VehicleUsecase {
 	repo := infrastructure.NewMemoryVehicleRepo()
 	inspectionProvider := &infrastructure.MockInspectionProvider{
 		Data: &domain.Vehicle{
-			VIN:          "1HGCM82633A004352",
+			VIN:          "1HGCM8263LA004352",
 			Brand:        "Kia",
 			Engine:       "1.8L",
 			Transmission: "Automatic",
+			BuildData: domain.BuildData{
+				Model:         "Rio",
+				BodyClass:     "Sedan",
+				Doors:         4,
+				DecodeQuality: &domain.DecodeQuality{Status: domain.DecodeClean},
+			},
 		},
 	}
 	pricingProvider := &infrastructure.MockPricingProvider{}
-	return usecase.NewVehicleUC(repo, inspectionProvider, pricingProvider)
+	return usecase.NewVehicleUC(repo, inspectionProvider, pricingProvider, 10)
 }
 
 // TestVehicleUsecase_CreateVehicle tests the CreateVehicle method of the VehicleUsecase struct
@@ -70,7 +80,7 @@ func TestVehicleUsecase_CreateVehicle(t *testing.T) {
 	// Run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := uc.Create(test.data())
+			err := uc.Create(t.Context(), test.data())
 			if test.isValid {
 				assert.NoError(t, err)
 			} else {
@@ -86,19 +96,33 @@ func TestVehicleUsecase_GetVehicle(t *testing.T) {
 	// Prepare
 	uc := newTestUC()
 	v := newTestVehicle()
-	err := uc.Create(v)
+	err := uc.Create(t.Context(), v)
 	assert.NoError(t, err)
 
 	// Valid case
 	t.Run("existing vehicle", func(t *testing.T) {
-		got, err := uc.Get(v.VIN)
+		got, err := uc.Get(t.Context(), v.VIN)
 		assert.NoError(t, err)
 		assert.Equal(t, v.VIN, got.VIN)
+		assert.Equal(t, "Rio", got.Model)
+		assert.Equal(t, int32(4), got.Doors)
+		assert.Equal(t, domain.DecodeClean, got.DecodeQuality.Status)
+	})
+
+	// Lower case VIN is the same vehicle
+	t.Run("lower case vin", func(t *testing.T) {
+		got, err := uc.Get(t.Context(), strings.ToLower(v.VIN))
+		assert.NoError(t, err)
+		assert.Equal(t, v.VIN, got.VIN)
+
+		dup := newTestVehicle()
+		dup.VIN = strings.ToLower(dup.VIN)
+		assert.ErrorIs(t, uc.Create(t.Context(), dup), domain.ErrAlreadyExists)
 	})
 
 	// Invalid case
 	t.Run("non-existing vehicle", func(t *testing.T) {
-		_, err := uc.Get("NONEXISTENTVIN12345")
+		_, err := uc.Get(t.Context(), "NONEXISTENTVIN12345")
 		assert.Error(t, err)
 	})
 }
@@ -129,13 +153,13 @@ func TestVehicleUsecase_UpdateVehicle(t *testing.T) {
 	// Prepare
 	uc := newTestUC()
 	v := newTestVehicle()
-	err := uc.Create(v)
+	err := uc.Create(t.Context(), v)
 	assert.NoError(t, err)
 
 	// Run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := uc.Update(test.data())
+			err := uc.Update(t.Context(), test.data())
 			if test.isValid {
 				assert.NoError(t, err)
 			} else {
@@ -151,26 +175,85 @@ func TestVehicleUsecase_DeleteVehicle(t *testing.T) {
 	// Prepare
 	uc := newTestUC()
 	v := newTestVehicle()
-	err := uc.Create(v)
+	err := uc.Create(t.Context(), v)
 	assert.NoError(t, err)
 
 	// Valid case
 	t.Run("delete existing vehicle", func(t *testing.T) {
-		err := uc.Delete(v.VIN)
+		err := uc.Delete(t.Context(), v.VIN, "")
 		assert.NoError(t, err)
 
 		// Verify deletion
-		_, err = uc.Get(v.VIN)
+		_, err = uc.Get(t.Context(), v.VIN)
 		assert.Error(t, err)
 	})
 
 	// Invalid case
 	t.Run("delete non-existing vehicle", func(t *testing.T) {
-		err := uc.Delete("NONEXISTENTVIN12345")
+		err := uc.Delete(t.Context(), "NONEXISTENTVIN12345", "")
 		assert.Error(t, err)
 	})
 }
 
+// TestVehicleUsecase_RestoreVehicle tests the Restore and Purge methods of the VehicleUsecase struct
+func TestVehicleUsecase_RestoreVehicle(t *testing.T) {
+
+	// Prepare a deleted vehicle
+	uc := newTestUC()
+	v := newTestVehicle()
+	assert.NoError(t, uc.Create(t.Context(), v))
+	assert.NoError(t, uc.Delete(t.Context(), v.VIN, ""))
+
+	// Deleted vehicle is hidden from listing
+	page, err := uc.List(t.Context(), domain.NewVehicleQuery())
+	assert.NoError(t, err)
+	assert.Empty(t, page.Vehicles)
+
+	// Deleted vehicle cannot be created again, only restored
+	dup := newTestVehicle()
+	assert.ErrorIs(t, uc.Create(t.Context(), dup), domain.ErrAlreadyExists)
+
+	// Valid case
+	t.Run("restore deleted vehicle", func(t *testing.T) {
+		restored, err := uc.Restore(t.Context(), v.VIN, "")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), restored.Version)
+
+		got, err := uc.Get(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Equal(t, v.Price, got.Price)
+	})
+
+	// Invalid cases
+	t.Run("restore active vehicle", func(t *testing.T) {
+		_, err := uc.Restore(t.Context(), v.VIN, "")
+		assert.ErrorIs(t, err, domain.ErrNotDeleted)
+	})
+
+	t.Run("restore non-existing vehicle", func(t *testing.T) {
+		_, err := uc.Restore(t.Context(), "NONEXISTENTVIN12345", "")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	// Purge removes only vehicles deleted before the retention period
+	t.Run("purge", func(t *testing.T) {
+		assert.NoError(t, uc.Delete(t.Context(), v.VIN, ""))
+		n, err := uc.Purge(t.Context(), time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+
+		n, err = uc.Purge(t.Context(), 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		_, err = uc.Restore(t.Context(), v.VIN, "")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		entries, err := uc.History(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.HistoryPurged, entries[len(entries)-1].Action)
+	})
+}
+
 // TestVehicleUsecase_ListVehicles tests the ListVehicles method of the VehicleUsecase struct
 func TestVehicleUsecase_ListVehicles(t *testing.T) {
 
@@ -179,31 +262,259 @@ func TestVehicleUsecase_ListVehicles(t *testing.T) {
 
 	// Empty list case
 	t.Run("empty list", func(t *testing.T) {
-		vehicles, err := uc.List()
+		page, err := uc.List(t.Context(), domain.NewVehicleQuery())
 		assert.NoError(t, err)
-		assert.Len(t, vehicles, 0)
+		assert.Len(t, page.Vehicles, 0)
+		assert.Empty(t, page.NextPageToken)
 	})
 
 	// List with vehicles case
 	t.Run("list with multiple vehicles", func(t *testing.T) {
 		v1 := newTestVehicle()
 		v2 := &domain.Vehicle{
-			VIN:      "2HGCM82633A654321",
+			VIN:      "2HGCM8264MA654321",
 			Year:     2021,
 			Odometer: 5000,
 			MSRP:     30000,
 		}
-		assert.NoError(t, uc.Create(v1))
-		assert.NoError(t, uc.Create(v2))
+		assert.NoError(t, uc.Create(t.Context(), v1))
+		assert.NoError(t, uc.Create(t.Context(), v2))
 
 		// Retrieve and verify list
-		vehicles, err := uc.List()
+		page, err := uc.List(t.Context(), domain.NewVehicleQuery())
 		assert.NoError(t, err)
-		assert.Len(t, vehicles, 2)
+		assert.Len(t, page.Vehicles, 2)
 
 		// Verify that both vehicles are in the list
-		vins := []string{vehicles[0].VIN, vehicles[1].VIN}
+		vins := []string{page.Vehicles[0].VIN, page.Vehicles[1].VIN}
 		assert.Contains(t, vins, v1.VIN)
 		assert.Contains(t, vins, v2.VIN)
 	})
+
+	// Filtered list case
+	t.Run("list filtered by year", func(t *testing.T) {
+		q := domain.NewVehicleQuery()
+		q.YearFrom = 2021
+		page, err := uc.List(t.Context(), q)
+		assert.NoError(t, err)
+		assert.Len(t, page.Vehicles, 1)
+		assert.Equal(t, "2HGCM8264MA654321", page.Vehicles[0].VIN)
+	})
+
+	// Invalid query case
+	t.Run("invalid query", func(t *testing.T) {
+		q := domain.NewVehicleQuery()
+		q.SortBy = "color"
+		_, err := uc.List(t.Context(), q)
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+}
+
+// TestVehicleUsecase_ListVehiclesPagination tests paging through vehicles with page tokens
+func TestVehicleUsecase_ListVehiclesPagination(t *testing.T) {
+
+	// Prepare
+	uc := newTestUC()
+	vins := []string{
+		"1HGCM8269LA000001",
+		"1HGCM8260LA000002",
+		"1HGCM8262LA000003",
+		"1HGCM8264LA000004",
+		"1HGCM8266LA000005",
+	}
+	for i, vin := range vins {
+		v := newTestVehicle()
+		v.VIN = vin
+		v.Odometer = int32(50_000 - i*1000) // nolint:gosec
+		assert.NoError(t, uc.Create(t.Context(), v))
+	}
+
+	// Walk through all pages sorted by odometer
+	q := domain.NewVehicleQuery()
+	q.SortBy = domain.SortByOdometer
+	q.Limit = 2
+	var got []string
+	for {
+		page, err := uc.List(t.Context(), q)
+		assert.NoError(t, err)
+		for _, v := range page.Vehicles {
+			got = append(got, v.VIN)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		q.PageToken = page.NextPageToken
+	}
+	assert.Equal(t, []string{vins[4], vins[3], vins[2], vins[1], vins[0]}, got)
+}
+
+// TestVehicleUsecase_ExportVehicles tests the Export method of the VehicleUsecase struct
+func TestVehicleUsecase_ExportVehicles(t *testing.T) {
+
+	// Prepare
+	uc := newTestUC()
+	vins := []string{"1HGCM8262JA000001", "1HGCM8262KA000002", "1HGCM8262LA000003"}
+	for i, vin := range vins {
+		v := newTestVehicle()
+		v.VIN = vin
+		v.Year = int32(2018 + i) // nolint:gosec
+		assert.NoError(t, uc.Create(t.Context(), v))
+	}
+
+	// Export streams all matching vehicles regardless of the page size
+	t.Run("filtered export", func(t *testing.T) {
+		q := domain.NewVehicleQuery()
+		q.YearFrom = 2019
+		q.Order = domain.OrderDesc
+		q.Limit = 1
+		var got []string
+		err := uc.Export(t.Context(), domain.NewVehicleExport(q), func(v *domain.Vehicle) error {
+			got = append(got, v.VIN)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{vins[2], vins[1]}, got)
+	})
+
+	// Errors of the consumer stop the export
+	t.Run("consumer error", func(t *testing.T) {
+		stop := errors.New("stop")
+		n := 0
+		err := uc.Export(t.Context(), domain.NewVehicleExport(domain.NewVehicleQuery()), func(v *domain.Vehicle) error {
+			n++
+			return stop
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, n)
+	})
+
+	// Invalid export
+	t.Run("unknown format", func(t *testing.T) {
+		e := domain.NewVehicleExport(domain.NewVehicleQuery())
+		e.Format = "xml"
+		err := uc.Export(t.Context(), e, func(v *domain.Vehicle) error { return nil })
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+}
+
+// TestVehicleUsecase_History tests the History method of the VehicleUsecase struct
+func TestVehicleUsecase_History(t *testing.T) {
+
+	// Prepare a vehicle timeline
+	uc := newTestUC()
+	v := newTestVehicle()
+	v.Actor = "alice"
+	assert.NoError(t, uc.Create(t.Context(), v))
+	_, err := uc.Patch(t.Context(), v.VIN, 0, "bob", []byte(`{"brand":"Honda"}`))
+	assert.NoError(t, err)
+	assert.NoError(t, uc.Delete(t.Context(), v.VIN, "carol"))
+
+	// History survives the deletion of the vehicle
+	t.Run("full timeline", func(t *testing.T) {
+		entries, err := uc.History(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 3)
+		assert.Equal(t, domain.HistoryCreated, entries[0].Action)
+		assert.Equal(t, "alice", entries[0].Actor)
+		assert.Equal(t, domain.HistoryUpdated, entries[1].Action)
+		assert.Equal(t, "bob", entries[1].Actor)
+		assert.Equal(t, []string{"brand"}, mapKeys(entries[1].Changes))
+		assert.Equal(t, int64(2), entries[1].Version)
+		assert.Equal(t, domain.HistoryDeleted, entries[2].Action)
+		assert.Equal(t, "carol", entries[2].Actor)
+	})
+
+	// Unknown vehicle
+	t.Run("unknown vehicle", func(t *testing.T) {
+		_, err := uc.History(t.Context(), "NONEXISTENTVIN12345")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+// mapKeys returns the sorted keys of the changes
+func mapKeys(changes map[string]*domain.FieldChange) []string {
+	keys := make([]string, 0, len(changes))
+	for k := range changes {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// TestVehicleUsecase_PatchVehicle tests the Patch method of the VehicleUsecase struct
+func TestVehicleUsecase_PatchVehicle(t *testing.T) {
+
+	// Prepare
+	uc := newTestUC()
+	v := newTestVehicle()
+	assert.NoError(t, uc.Create(t.Context(), v))
+
+	// Patch a field not affecting price keeps the rest of the record
+	t.Run("patch brand", func(t *testing.T) {
+		res, err := uc.Patch(t.Context(), v.VIN, 0, "", []byte(`{"brand":"Honda"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"brand"}, res.Changed)
+		assert.Equal(t, "Honda", res.Vehicle.Brand)
+		assert.Equal(t, v.Odometer, res.Vehicle.Odometer)
+
+		got, err := uc.Get(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Equal(t, "Honda", got.Brand)
+		assert.Equal(t, v.Year, got.Year)
+	})
+
+	// Patch a field affecting price
+	t.Run("patch odometer", func(t *testing.T) {
+		res, err := uc.Patch(t.Context(), v.VIN, 0, "", []byte(`{"odometer":40000}`))
+		assert.NoError(t, err)
+		assert.Contains(t, res.Changed, "odometer")
+		assert.Equal(t, int32(40000), res.Vehicle.Odometer)
+	})
+
+	// Invalid patched state
+	t.Run("patch to invalid year", func(t *testing.T) {
+		_, err := uc.Patch(t.Context(), v.VIN, 0, "", []byte(`{"year":1800}`))
+		assert.ErrorIs(t, err, domain.ErrValidation)
+
+		got, err := uc.Get(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Equal(t, v.Year, got.Year)
+	})
+
+	// Non-existing vehicle
+	t.Run("patch non-existing vehicle", func(t *testing.T) {
+		_, err := uc.Patch(t.Context(), "NONEXISTENTVIN12345", 0, "", []byte(`{"brand":"Honda"}`))
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	// Stale version
+	t.Run("patch with stale version", func(t *testing.T) {
+		_, err := uc.Patch(t.Context(), v.VIN, 1, "", []byte(`{"brand":"Kia"}`))
+		assert.ErrorIs(t, err, domain.ErrVersionConflict)
+	})
+}
+
+// TestVehicleUsecase_UpdateVehicleVersion tests optimistic concurrency of the Update method
+func TestVehicleUsecase_UpdateVehicleVersion(t *testing.T) {
+
+	// Prepare
+	uc := newTestUC()
+	v := newTestVehicle()
+	assert.NoError(t, uc.Create(t.Context(), v))
+	assert.Equal(t, int64(1), v.Version)
+
+	// Matching version is accepted and incremented
+	t.Run("matching version", func(t *testing.T) {
+		u := newTestVehicle()
+		u.Version = 1
+		assert.NoError(t, uc.Update(t.Context(), u))
+		assert.Equal(t, int64(2), u.Version)
+	})
+
+	// Stale version is rejected
+	t.Run("stale version", func(t *testing.T) {
+		u := newTestVehicle()
+		u.Version = 1
+		assert.ErrorIs(t, uc.Update(t.Context(), u), domain.ErrVersionConflict)
+	})
 }
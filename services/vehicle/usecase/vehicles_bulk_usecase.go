@@ -1,6 +1,9 @@
 package usecase
 
 import (
+	"context"
+	"fmt"
+
 	"golang.org/x/sync/errgroup"
 
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
@@ -9,8 +12,10 @@ import (
 
 // VehiclesBulkUsecase defines the interface for bulk vehicle operations
 type VehiclesBulkUsecase interface {
-	Create(vb *domain.VehiclesBulk) error
-	Update(vb *domain.VehiclesBulk) error
+	Create(ctx context.Context, vb *domain.VehiclesBulk) error
+	Update(ctx context.Context, vb *domain.VehiclesBulk) error
+	CreateBestEffort(ctx context.Context, vb *domain.VehiclesBulk) (*domain.BulkResult, error)
+	UpdateBestEffort(ctx context.Context, vb *domain.VehiclesBulk) (*domain.BulkResult, error)
 }
 
 // vehiclesBulkUsecase is the implementation of VehiclesBulkUsecase interface
@@ -27,16 +32,25 @@ func NewVehiclesBulkUC(r repository.VehicleRepository, vehicleUC VehicleUsecase)
 	}
 }
 
-// fetch fetches all necessary data for vehicle processing
-func (uc *vehiclesBulkUsecase) fetch(vb *domain.VehiclesBulk) error {
-	g := new(errgroup.Group)
+// fetchNew fetches all necessary data for a new vehicle, the vehicle is saved pending enrichment
+// while a dependency is unavailable
+func (uc *vehiclesBulkUsecase) fetchNew(ctx context.Context, v *domain.Vehicle) error {
+	return fetchOrDefer(ctx, uc.vehicleUC.Fetch, v)
+}
+
+// fetch fetches all necessary data for vehicle processing with fetchOne, the first failure cancels the rest
+func (uc *vehiclesBulkUsecase) fetch(ctx context.Context, vb *domain.VehiclesBulk, fetchOne func(context.Context, *domain.Vehicle) error) error {
+	g, ctx := errgroup.WithContext(ctx)
 	jobs := make(chan *domain.Vehicle, len(vb.Vehicles))
 	workers := 5
 
 	for range workers {
 		g.Go(func() error {
 			for v := range jobs {
-				if err := uc.vehicleUC.Fetch(v); err != nil {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				if err := fetchOne(ctx, v); err != nil {
 					return err
 				}
 			}
@@ -56,8 +70,8 @@ func (uc *vehiclesBulkUsecase) fetch(vb *domain.VehiclesBulk) error {
 	return g.Wait()
 }
 
-// Create creates multiple vehicle records in bulk
-func (uc *vehiclesBulkUsecase) Create(vb *domain.VehiclesBulk) error {
+// Create creates multiple vehicle records in bulk, vehicles are created pending enrichment while a dependency is unavailable
+func (uc *vehiclesBulkUsecase) Create(ctx context.Context, vb *domain.VehiclesBulk) error {
 
 	// Validate the bulk vehicle data
 	if err := vb.Validate(); err != nil {
@@ -65,16 +79,16 @@ func (uc *vehiclesBulkUsecase) Create(vb *domain.VehiclesBulk) error {
 	}
 
 	// Fetch all necessary data for each vehicle in bulk concurrently
-	if err := uc.fetch(vb); err != nil {
+	if err := uc.fetch(ctx, vb, uc.fetchNew); err != nil {
 		return err
 	}
 
 	// Save the bulk vehicles
-	return uc.repo.SaveBulk(vb)
+	return uc.repo.SaveBulk(ctx, vb)
 }
 
 // Update updates multiple vehicle records in bulk
-func (uc *vehiclesBulkUsecase) Update(vb *domain.VehiclesBulk) error {
+func (uc *vehiclesBulkUsecase) Update(ctx context.Context, vb *domain.VehiclesBulk) error {
 
 	// Validate the bulk vehicle data
 	if err := vb.Validate(); err != nil {
@@ -82,10 +96,93 @@ func (uc *vehiclesBulkUsecase) Update(vb *domain.VehiclesBulk) error {
 	}
 
 	// Fetch all necessary data for each vehicle in bulk concurrently
-	if err := uc.fetch(vb); err != nil {
+	if err := uc.fetch(ctx, vb, uc.vehicleUC.Fetch); err != nil {
 		return err
 	}
 
 	// Update the bulk vehicles
-	return uc.repo.UpdateBulk(vb)
+	return uc.repo.UpdateBulk(ctx, vb)
+}
+
+// processEach validates, fetches and persists each vehicle independently and collects per-vehicle results,
+// vehicles not yet processed when the context is done fail with the context error
+func (uc *vehiclesBulkUsecase) processEach(ctx context.Context, vb *domain.VehiclesBulk, status string, fetch, persist func(context.Context, *domain.Vehicle) error) (*domain.BulkResult, error) {
+
+	// Validate the bulk envelope only, vehicles are validated one by one
+	if len(vb.Vehicles) == 0 {
+		return nil, domain.ErrValidation
+	}
+
+	// Process vehicles concurrently, results keep the order of the request
+	results := make([]*domain.BulkItemResult, len(vb.Vehicles))
+	g := new(errgroup.Group)
+	jobs := make(chan int, len(vb.Vehicles))
+	workers := 5
+	for range workers {
+		g.Go(func() error {
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					results[i] = &domain.BulkItemResult{Status: domain.BulkStatusFailed, Error: err.Error()}
+					if v := vb.Vehicles[i]; v != nil {
+						results[i].VIN = v.VIN
+					}
+					continue
+				}
+				results[i] = uc.processOne(ctx, vb.Vehicles[i], status, fetch, persist)
+			}
+			return nil
+		})
+	}
+
+	// Enqueue jobs
+	for i := range vb.Vehicles {
+		jobs <- i
+	}
+	close(jobs)
+	_ = g.Wait()
+
+	// Summarize results
+	res := &domain.BulkResult{Results: results}
+	for _, r := range results {
+		if r.Status == domain.BulkStatusFailed {
+			res.Failed++
+		} else {
+			res.Succeeded++
+		}
+	}
+	return res, nil
+}
+
+// processOne validates, fetches and persists a single vehicle of the bulk
+func (uc *vehiclesBulkUsecase) processOne(ctx context.Context, v *domain.Vehicle, status string, fetch, persist func(context.Context, *domain.Vehicle) error) *domain.BulkItemResult {
+	if v == nil {
+		return &domain.BulkItemResult{Status: domain.BulkStatusFailed, Error: domain.ErrValidation.Error()}
+	}
+	res := &domain.BulkItemResult{VIN: v.VIN, Status: domain.BulkStatusFailed}
+	if err := v.Validate(); err != nil {
+		res.Error = fmt.Errorf("%w: %v", domain.ErrValidation, err).Error()
+		return res
+	}
+	if err := fetch(ctx, v); err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	if err := persist(ctx, v); err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	res.Status = status
+	res.Vehicle = v
+	return res
+}
+
+// CreateBestEffort creates each vehicle of the bulk independently and reports per-vehicle results,
+// vehicles are created pending enrichment while a dependency is unavailable
+func (uc *vehiclesBulkUsecase) CreateBestEffort(ctx context.Context, vb *domain.VehiclesBulk) (*domain.BulkResult, error) {
+	return uc.processEach(ctx, vb, domain.BulkStatusCreated, uc.fetchNew, uc.repo.Save)
+}
+
+// UpdateBestEffort updates each vehicle of the bulk independently and reports per-vehicle results
+func (uc *vehiclesBulkUsecase) UpdateBestEffort(ctx context.Context, vb *domain.VehiclesBulk) (*domain.BulkResult, error) {
+	return uc.processEach(ctx, vb, domain.BulkStatusUpdated, uc.vehicleUC.Fetch, uc.repo.Update)
 }
@@ -1,6 +1,8 @@
 package usecase_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/alechekz/online-car-auction/services/vehicle/domain"
@@ -8,6 +10,7 @@ import (
 	"github.com/alechekz/online-car-auction/services/vehicle/usecase"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // newTestVehiclesBulk is a test valid VehiclesBulk instance
@@ -34,23 +37,23 @@ func TestVehiclesBulkUsecase_Create(t *testing.T) {
 	vb := newTestVehiclesBulk()
 
 	// Successful case
-	mockRepo.On("SaveBulk", vb).Return(nil)
+	mockRepo.On("SaveBulk", mock.Anything, vb).Return(nil)
 	t.Run("valid bulk data", func(t *testing.T) {
-		assert.NoError(t, uc.Create(vb))
+		assert.NoError(t, uc.Create(t.Context(), vb))
 	})
 
 	// Return error on Validate failure
-	mockRepo.On("SaveBulk", vb).Return(nil)
+	mockRepo.On("SaveBulk", mock.Anything, vb).Return(nil)
 	v := vb.Vehicles[0]
 	v.VIN = "123"
 	t.Run("invalid bulk data", func(t *testing.T) {
-		assert.Error(t, uc.Create(vb))
+		assert.Error(t, uc.Create(t.Context(), vb))
 	})
 
 	// Return error on repository failure
-	mockRepo.On("SaveBulk", vb).Return(assert.AnError)
+	mockRepo.On("SaveBulk", mock.Anything, vb).Return(assert.AnError)
 	t.Run("repository failure", func(t *testing.T) {
-		assert.Error(t, uc.Create(vb))
+		assert.Error(t, uc.Create(t.Context(), vb))
 	})
 }
 
@@ -62,22 +65,178 @@ func TestVehiclesBulkUsecase_Update(t *testing.T) {
 	vb := newTestVehiclesBulk()
 
 	// Successful case
-	mockRepo.On("UpdateBulk", vb).Return(nil)
+	mockRepo.On("UpdateBulk", mock.Anything, vb).Return(nil)
 	t.Run("valid bulk data", func(t *testing.T) {
-		assert.NoError(t, uc.Update(vb))
+		assert.NoError(t, uc.Update(t.Context(), vb))
 	})
 
 	// Return error on Validate failure
-	mockRepo.On("UpdateBulk", vb).Return(nil)
+	mockRepo.On("UpdateBulk", mock.Anything, vb).Return(nil)
 	v := vb.Vehicles[0]
 	v.VIN = "123"
 	t.Run("invalid bulk data", func(t *testing.T) {
-		assert.Error(t, uc.Update(vb))
+		assert.Error(t, uc.Update(t.Context(), vb))
 	})
 
 	// Return error on repository failure
-	mockRepo.On("UpdateBulk", vb).Return(assert.AnError)
+	mockRepo.On("UpdateBulk", mock.Anything, vb).Return(assert.AnError)
 	t.Run("repository failure", func(t *testing.T) {
-		assert.Error(t, uc.Update(vb))
+		assert.Error(t, uc.Update(t.Context(), vb))
+	})
+}
+
+// TestVehiclesBulkUsecase_CreateBestEffort tests the CreateBestEffort method of VehiclesBulkUsecase
+func TestVehiclesBulkUsecase_CreateBestEffort(t *testing.T) {
+	repo := infrastructure.NewMemoryVehicleRepo()
+	vehicleUC := usecase.NewVehicleUC(repo, &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{}}, &infrastructure.MockPricingProvider{}, 10)
+	uc := usecase.NewVehiclesBulkUC(repo, vehicleUC)
+
+	// Existing vehicle makes its duplicate fail
+	existing := newTestVehicle()
+	existing.VIN = "3HGCM8267LA000003"
+	assert.NoError(t, repo.Save(t.Context(), existing))
+
+	vb := &domain.VehiclesBulk{
+		Mode: domain.BulkModeBestEffort,
+		Vehicles: []*domain.Vehicle{
+			{VIN: "1HGCM8269LA000001", Year: 2020, Odometer: 1000},
+			{VIN: "123", Year: 2020, Odometer: 1000},
+			{VIN: "3HGCM8267LA000003", Year: 2020, Odometer: 1000},
+			nil,
+		},
+	}
+	res, err := uc.CreateBestEffort(t.Context(), vb)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, res.Succeeded)
+	assert.Equal(t, 3, res.Failed)
+	assert.Equal(t, domain.BulkStatusCreated, res.Results[0].Status)
+	assert.Equal(t, domain.BulkStatusFailed, res.Results[1].Status)
+	assert.Contains(t, res.Results[1].Error, domain.ErrValidation.Error())
+	assert.Equal(t, domain.BulkStatusFailed, res.Results[2].Status)
+	assert.Equal(t, domain.ErrAlreadyExists.Error(), res.Results[2].Error)
+	assert.Equal(t, domain.BulkStatusFailed, res.Results[3].Status)
+
+	// Successful vehicle is persisted
+	_, err = repo.FindByVIN(t.Context(), "1HGCM8269LA000001")
+	assert.NoError(t, err)
+
+	// Empty bulk is rejected
+	_, err = uc.CreateBestEffort(t.Context(), &domain.VehiclesBulk{Mode: domain.BulkModeBestEffort})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+// TestVehiclesBulkUsecase_UpdateBestEffort tests the UpdateBestEffort method of VehiclesBulkUsecase
+func TestVehiclesBulkUsecase_UpdateBestEffort(t *testing.T) {
+	repo := infrastructure.NewMemoryVehicleRepo()
+	vehicleUC := usecase.NewVehicleUC(repo, &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{}}, &infrastructure.MockPricingProvider{}, 10)
+	uc := usecase.NewVehiclesBulkUC(repo, vehicleUC)
+	assert.NoError(t, repo.Save(t.Context(), newTestVehicle()))
+
+	// Upstream failure of one vehicle does not affect the others
+	vb := &domain.VehiclesBulk{
+		Mode: domain.BulkModeBestEffort,
+		Vehicles: []*domain.Vehicle{
+			{VIN: "1HGCM8267LA123456", Year: 2020, Odometer: 2000, Version: 1},
+			{VIN: "1HGCM8268LA999999", Year: 2020, Odometer: 2000},
+		},
+	}
+	res, err := uc.UpdateBestEffort(t.Context(), vb)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BulkStatusUpdated, res.Results[0].Status)
+	assert.Equal(t, int64(2), res.Results[0].Vehicle.Version)
+	assert.Equal(t, domain.BulkStatusFailed, res.Results[1].Status)
+	assert.Equal(t, domain.ErrNotFound.Error(), res.Results[1].Error)
+}
+
+// TestVehiclesBulkUsecase_DegradedCreate tests that bulk vehicles are created pending enrichment
+// while a dependency is unavailable and rejected on any other failure
+func TestVehiclesBulkUsecase_DegradedCreate(t *testing.T) {
+	repo := infrastructure.NewMemoryVehicleRepo()
+	inspection := &infrastructure.MockInspectionProvider{Err: fmt.Errorf("%w: inspection is down", domain.ErrUnavailable)}
+	vehicleUC := usecase.NewVehicleUC(repo, inspection, &infrastructure.MockPricingProvider{}, 10)
+	uc := usecase.NewVehiclesBulkUC(repo, vehicleUC)
+	vehicles := newTestJobVehicles(4)
+
+	t.Run("atomic", func(t *testing.T) {
+		assert.NoError(t, uc.Create(t.Context(), &domain.VehiclesBulk{Vehicles: vehicles[:2]}))
+		got, err := vehicleUC.Get(t.Context(), vehicles[0].VIN)
+		assert.NoError(t, err)
+		assert.NotNil(t, got.Enrichment)
+	})
+
+	t.Run("best effort", func(t *testing.T) {
+		res, err := uc.CreateBestEffort(t.Context(), &domain.VehiclesBulk{Mode: domain.BulkModeBestEffort, Vehicles: vehicles[2:]})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Succeeded)
+		assert.Equal(t, domain.BulkStatusCreated, res.Results[0].Status)
+		assert.NotNil(t, res.Results[0].Vehicle.Enrichment)
+	})
+
+	t.Run("pending enrichment", func(t *testing.T) {
+		pending, err := vehicleUC.PendingEnrichment(t.Context(), 10)
+		assert.NoError(t, err)
+		assert.Len(t, pending, 4)
+	})
+
+	t.Run("other failures reject", func(t *testing.T) {
+		inspection.Err = assert.AnError
+		res, err := uc.CreateBestEffort(t.Context(), &domain.VehiclesBulk{Mode: domain.BulkModeBestEffort, Vehicles: newTestJobVehicles(5)[4:]})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Failed)
+		assert.Equal(t, assert.AnError.Error(), res.Results[0].Error)
+	})
+}
+
+// TestVehiclesBulkUsecase_OfflineUpdate tests that bulk updated vehicles decoded offline are returned
+// as stored, pending enrichment
+func TestVehiclesBulkUsecase_OfflineUpdate(t *testing.T) {
+	repo := infrastructure.NewMemoryVehicleRepo()
+	inspection := &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{Brand: "Kia"}}
+	vehicleUC := usecase.NewVehicleUC(repo, inspection, &infrastructure.MockPricingProvider{}, 10)
+	uc := usecase.NewVehiclesBulkUC(repo, vehicleUC)
+	vehicles := newTestJobVehicles(2)
+	assert.NoError(t, uc.Create(t.Context(), &domain.VehiclesBulk{Vehicles: vehicles}))
+	assert.Nil(t, vehicles[0].Enrichment)
+
+	inspection.Data = &domain.Vehicle{
+		Brand:     "Kia",
+		BuildData: domain.BuildData{DecodeQuality: &domain.DecodeQuality{Status: domain.DecodeOffline}},
+	}
+	updates := newTestJobVehicles(2)
+	assert.NoError(t, uc.Update(t.Context(), &domain.VehiclesBulk{Vehicles: updates}))
+	for _, v := range updates {
+		assert.Equal(t, domain.ErrDecodedOffline.Error(), v.Enrichment.Error)
+		got, err := vehicleUC.Get(t.Context(), v.VIN)
+		assert.NoError(t, err)
+		assert.Equal(t, v.Enrichment, got.Enrichment)
+	}
+}
+
+// TestVehiclesBulkUsecase_Cancel tests that the bulk operations stop once the context is cancelled
+func TestVehiclesBulkUsecase_Cancel(t *testing.T) {
+	repo := infrastructure.NewMemoryVehicleRepo()
+	vehicleUC := usecase.NewVehicleUC(repo, &infrastructure.MockInspectionProvider{Data: &domain.Vehicle{}}, &infrastructure.MockPricingProvider{}, 10)
+	uc := usecase.NewVehiclesBulkUC(repo, vehicleUC)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	t.Run("atomic", func(t *testing.T) {
+		err := uc.Create(ctx, newTestVehiclesBulk())
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("best effort", func(t *testing.T) {
+		vb := newTestJobVehicles(12)
+		res, err := uc.CreateBestEffort(ctx, &domain.VehiclesBulk{Mode: domain.BulkModeBestEffort, Vehicles: vb})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, res.Succeeded)
+		assert.Equal(t, len(vb), res.Failed)
+		for i, r := range res.Results {
+			assert.Equal(t, vb[i].VIN, r.VIN)
+			assert.Equal(t, context.Canceled.Error(), r.Error)
+		}
+		page, err := vehicleUC.List(t.Context(), domain.NewVehicleQuery())
+		assert.NoError(t, err)
+		assert.Empty(t, page.Vehicles)
 	})
 }